@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
-	"os/exec"
 	"sort"
 	"strings"
 	"time"
@@ -16,6 +15,7 @@ import (
 	cblog "github.com/charmbracelet/log"
 	"github.com/darksworm/argonaut/pkg/api"
 	appcontext "github.com/darksworm/argonaut/pkg/context"
+	"github.com/darksworm/argonaut/pkg/diff"
 	apperrors "github.com/darksworm/argonaut/pkg/errors"
 	"github.com/darksworm/argonaut/pkg/model"
 	"github.com/darksworm/argonaut/pkg/neat"
@@ -213,7 +213,7 @@ func classifyWatchEvent(ev services.ArgoApiEvent, epoch int) eventResult {
 			if len(ev.Resources) > 0 {
 				resourcesData, _ = json.Marshal(ev.Resources)
 			}
-			return eventResult{update: &model.AppUpdatedMsg{App: *ev.App, ResourcesJSON: resourcesData}}
+			return eventResult{update: &model.AppUpdatedMsg{App: *ev.App, ResourcesJSON: resourcesData, Operation: ev.Operation}}
 		}
 	case "app-deleted":
 		if ev.AppName != "" {
@@ -418,7 +418,7 @@ func stringSlicesEqual(a, b []string) bool {
 	return true
 }
 
-// startDiffSession loads diffs and opens the diff pager
+// startDiffSession loads an app's diffs and opens the per-resource diff navigator
 func (m *Model) startDiffSession(appName string) tea.Cmd {
 	epoch := m.switchEpoch // capture at call time
 	return func() tea.Msg {
@@ -435,8 +435,7 @@ func (m *Model) startDiffSession(appName string) tea.Cmd {
 			return model.ApiErrorMsg{Message: "Failed to load diffs: " + err.Error(), SwitchEpoch: epoch}
 		}
 
-		normalizedDocs := make([]string, 0)
-		predictedDocs := make([]string, 0)
+		items := make([]model.ResourceDiffItem, 0, len(diffs))
 		for _, d := range diffs {
 			// Filter out hook resources (like ArgoCD UI does)
 			if d.Hook {
@@ -459,60 +458,31 @@ func (m *Model) startDiffSession(appName string) tea.Cmd {
 				continue
 			}
 
-			if normalizedYAML != "" {
-				normalizedDocs = append(normalizedDocs, normalizedYAML)
-			}
-			if predictedYAML != "" {
-				predictedDocs = append(predictedDocs, predictedYAML)
-			}
-		}
-
-		if len(normalizedDocs) == 0 && len(predictedDocs) == 0 {
-			// Clear loading spinner before showing no-diff modal
-			if m.state.Diff == nil {
-				m.state.Diff = &model.DiffState{}
-			}
-			m.state.Diff.Loading = false
-			return model.SetModeMsg{Mode: model.ModeNoDiff}
-		}
-
-		leftFile, _ := writeTempYAML("current-", normalizedDocs)
-		rightFile, _ := writeTempYAML("predicted-", predictedDocs)
-
-		// Build raw unified diff via git (no color so delta can format it)
-		cmd := exec.Command("git", "--no-pager", "diff", "--no-index", "--no-color", "--", leftFile, rightFile)
-		out, err := cmd.CombinedOutput()
-		if err != nil && cmd.ProcessState != nil && cmd.ProcessState.ExitCode() != 1 {
-			return model.ApiErrorMsg{Message: "Diff failed: " + err.Error(), SwitchEpoch: epoch}
-		}
-		cleaned := stripDiffHeader(string(out))
-		if strings.TrimSpace(cleaned) == "" {
-			// Clear loading spinner before showing no-diff modal
-			if m.state.Diff == nil {
-				m.state.Diff = &model.DiffState{}
+			section := diff.ComputeSection("", normalizedYAML, predictedYAML)
+			if !section.Changed() {
+				continue
 			}
-			m.state.Diff.Loading = false
-			return model.SetModeMsg{Mode: model.ModeNoDiff}
+			items = append(items, model.ResourceDiffItem{
+				Group:     d.Group,
+				Kind:      d.Kind,
+				Namespace: d.Namespace,
+				Name:      d.Name,
+				Added:     section.Added,
+				Removed:   section.Removed,
+				Live:      normalizedYAML,
+				Desired:   predictedYAML,
+			})
 		}
 
-		// Clear loading spinner before handing off to viewer/formatter
+		// Clear loading spinner before showing the navigator or the no-diff modal
 		if m.state.Diff == nil {
 			m.state.Diff = &model.DiffState{}
 		}
 		m.state.Diff.Loading = false
-
-		// 1) Interactive diff viewer: replace the terminal (e.g., vimdiff, meld)
-		if viewer := m.config.GetDiffViewer(); viewer != "" {
-			return m.openInteractiveDiffViewer(leftFile, rightFile, viewer)
-		}
-
-		// 2) Non-interactive formatter: pipe to tool (e.g., delta) and then show via pager
-		formatted := cleaned
-		if formattedOut, ferr := m.runDiffFormatterWithTitle(cleaned, appName); ferr == nil && strings.TrimSpace(formattedOut) != "" {
-			formatted = formattedOut
+		if len(items) == 0 {
+			return model.SetModeMsg{Mode: model.ModeNoDiff}
 		}
-		title := fmt.Sprintf("%s - Live vs Desired", appName)
-		return m.openTextPager(title, formatted)()
+		return model.ResourceDiffsLoadedMsg{AppName: appName, Items: items, SwitchEpoch: epoch}
 	}
 }
 
@@ -569,46 +539,31 @@ func (m *Model) startResourceDiffSession(res ResourceIdentifier) tea.Cmd {
 			return model.SetModeMsg{Mode: model.ModeNoDiff}
 		}
 
-		// Use existing diff generation infrastructure
-		leftFile, _ := writeTempYAML("current-", []string{normalizedYAML})
-		rightFile, _ := writeTempYAML("predicted-", []string{predictedYAML})
-
-		cmd := exec.Command("git", "--no-pager", "diff", "--no-index", "--no-color", "--", leftFile, rightFile)
-		out, err := cmd.CombinedOutput()
-		if err != nil && cmd.ProcessState != nil && cmd.ProcessState.ExitCode() != 1 {
-			return model.ApiErrorMsg{Message: "Diff failed: " + err.Error(), SwitchEpoch: epoch}
-		}
-		cleaned := stripDiffHeader(string(out))
-		if strings.TrimSpace(cleaned) == "" {
-			if m.state.Diff == nil {
-				m.state.Diff = &model.DiffState{}
-			}
-			m.state.Diff.Loading = false
-			return model.SetModeMsg{Mode: model.ModeNoDiff}
+		resourceTitle := fmt.Sprintf("%s/%s", res.Kind, res.Name)
+		if res.Namespace != "" {
+			resourceTitle = fmt.Sprintf("%s/%s/%s", res.Namespace, res.Kind, res.Name)
 		}
+		msg, changed := m.presentDiff(diffRequest{
+			Title:          fmt.Sprintf("%s - Live vs Desired", resourceTitle),
+			FormatterTitle: resourceTitle,
+			LeftPrefix:     "current-",
+			RightPrefix:    "predicted-",
+			Resources: []diff.Resource{{
+				Title: diffResourceTitle(res.Kind, res.Namespace, res.Name),
+				Left:  normalizedYAML,
+				Right: predictedYAML,
+			}},
+		}, epoch)
 
 		// Clear loading before showing
 		if m.state.Diff == nil {
 			m.state.Diff = &model.DiffState{}
 		}
 		m.state.Diff.Loading = false
-
-		// Support interactive diff viewer
-		if viewer := m.config.GetDiffViewer(); viewer != "" {
-			return m.openInteractiveDiffViewer(leftFile, rightFile, viewer)
-		}
-
-		// Format and display
-		resourceTitle := fmt.Sprintf("%s/%s", res.Kind, res.Name)
-		if res.Namespace != "" {
-			resourceTitle = fmt.Sprintf("%s/%s/%s", res.Namespace, res.Kind, res.Name)
-		}
-		formatted := cleaned
-		if formattedOut, ferr := m.runDiffFormatterWithTitle(cleaned, resourceTitle); ferr == nil && strings.TrimSpace(formattedOut) != "" {
-			formatted = formattedOut
+		if !changed {
+			return model.SetModeMsg{Mode: model.ModeNoDiff}
 		}
-		title := fmt.Sprintf("%s - Live vs Desired", resourceTitle)
-		return m.openTextPager(title, formatted)()
+		return msg
 	}
 }
 
@@ -744,7 +699,7 @@ func stripDiffHeader(out string) string {
 }
 
 // syncSelectedApplications syncs the currently selected applications
-func (m *Model) syncSelectedApplications(prune bool) tea.Cmd {
+func (m *Model) syncSelectedApplications(prune bool, options model.SyncOptions) tea.Cmd {
 	if m.state.Server == nil {
 		return func() tea.Msg {
 			return model.ApiErrorMsg{Message: "No server configured"}
@@ -762,13 +717,16 @@ func (m *Model) syncSelectedApplications(prune bool) tea.Cmd {
 		}
 	}
 
+	namespaces := m.appNamespaces()
+	perms, projects := m.state.Permissions, m.appProjects()
 	epoch := m.switchEpoch // capture at call time
 	return func() tea.Msg {
 		apiService := services.NewEnhancedArgoApiService(m.state.Server)
+		apiService.SetPermissions(perms, projects)
 
 		for _, appName := range selectedApps {
 			ctx, cancel := appcontext.WithAPITimeout(context.Background())
-			err := apiService.SyncApplication(ctx, m.state.Server, appName, prune)
+			err := apiService.SyncApplication(ctx, m.state.Server, appName, apiSyncOptions(prune, options, namespaces[appName]))
 			cancel()
 			if err != nil {
 				// Convert to structured error and return via TUI error handling
@@ -855,22 +813,32 @@ func (m *Model) deleteApplication(req model.AppDeleteRequestMsg) tea.Cmd {
 }
 
 // syncSingleApplication syncs a specific application
-func (m *Model) syncSingleApplication(appName string, prune bool) tea.Cmd {
+func (m *Model) syncSingleApplication(appName string, prune bool, options model.SyncOptions) tea.Cmd {
 	if m.state.Server == nil {
 		return func() tea.Msg {
 			return model.ApiErrorMsg{Message: "No server configured"}
 		}
 	}
 
+	opts := apiSyncOptions(prune, options, m.appNamespaces()[appName])
+	var previousOperationAt *time.Time
+	for _, app := range m.state.Apps {
+		if app.Name == appName {
+			previousOperationAt = app.LastSyncAt
+			break
+		}
+	}
+	perms, projects := m.state.Permissions, m.appProjects()
 	epoch := m.switchEpoch // capture at call time
 	return func() tea.Msg {
 		ctx, cancel := appcontext.WithAPITimeout(context.Background())
 		defer cancel()
 
 		apiService := services.NewEnhancedArgoApiService(m.state.Server)
+		apiService.SetPermissions(perms, projects)
 
-		cblog.With("component", "api").Info("Starting sync", "app", appName)
-		err := apiService.SyncApplication(ctx, m.state.Server, appName, prune)
+		cblog.With("component", "api").Info("Starting sync", "app", appName, "options", opts)
+		err := apiService.SyncApplication(ctx, m.state.Server, appName, opts)
 		if err != nil {
 			cblog.With("component", "api").Error("Sync failed", "app", appName, "err", err)
 			// Convert to structured error and return via TUI error handling
@@ -896,7 +864,94 @@ func (m *Model) syncSingleApplication(appName string, prune bool) tea.Cmd {
 		}
 
 		cblog.With("component", "api").Info("Sync completed", "app", appName)
-		return model.SyncCompletedMsg{AppName: appName, Success: true, SwitchEpoch: epoch}
+		return model.SyncCompletedMsg{AppName: appName, Success: true, SwitchEpoch: epoch, PreviousOperationAt: previousOperationAt}
+	}
+}
+
+// appNamespaces maps app names to their app namespace for API calls made outside the update loop
+func (m *Model) appNamespaces() map[string]string {
+	namespaces := make(map[string]string, len(m.state.Apps))
+	for _, app := range m.state.Apps {
+		if app.AppNamespace != nil {
+			namespaces[app.Name] = *app.AppNamespace
+		}
+	}
+	return namespaces
+}
+
+// appProjects maps app names to their projects
+func (m *Model) appProjects() map[string]string {
+	projects := make(map[string]string, len(m.state.Apps))
+	for _, app := range m.state.Apps {
+		if app.Project != nil {
+			projects[app.Name] = *app.Project
+		}
+	}
+	return projects
+}
+
+// apiSyncOptions converts the sync modal/command options into an API sync request
+func apiSyncOptions(prune bool, options model.SyncOptions, appNamespace string) *api.SyncOptions {
+	opts := &api.SyncOptions{
+		Prune:                    prune,
+		AppNamespace:             appNamespace,
+		Revision:                 options.Revision,
+		ApplyOutOfSyncOnly:       options.ApplyOutOfSyncOnly,
+		ServerSideApply:          options.ServerSideApply,
+		Replace:                  options.Replace,
+		PruneLast:                options.PruneLast,
+		RespectIgnoreDifferences: options.RespectIgnoreDifferences,
+		CreateNamespace:          options.CreateNamespace,
+	}
+	if options.RetryLimit != 0 {
+		opts.Retry = &api.RetryStrategy{Limit: int64(options.RetryLimit)}
+		if options.RetryBackoffDuration != "" || options.RetryBackoffFactor > 0 || options.RetryBackoffMaxDuration != "" {
+			backoff := &api.Backoff{
+				Duration:    options.RetryBackoffDuration,
+				MaxDuration: options.RetryBackoffMaxDuration,
+			}
+			if options.RetryBackoffFactor > 0 {
+				factor := int64(options.RetryBackoffFactor)
+				backoff.Factor = &factor
+			}
+			opts.Retry.Backoff = backoff
+		}
+	}
+	return opts
+}
+
+// terminateOperations terminates the running operation of each given application
+func (m *Model) terminateOperations(appNames []string) tea.Cmd {
+	if m.state.Server == nil {
+		return func() tea.Msg {
+			return model.OperationTerminatedMsg{Errors: []string{"No server configured"}}
+		}
+	}
+
+	// Resolve app namespaces up front; the cmd runs outside the update loop
+	namespaces := make(map[string]*string, len(appNames))
+	for _, app := range m.state.Apps {
+		namespaces[app.Name] = app.AppNamespace
+	}
+
+	epoch := m.switchEpoch // capture at call time
+	return func() tea.Msg {
+		appService := api.NewApplicationService(m.state.Server)
+
+		var terminated, failures []string
+		for _, name := range appNames {
+			ctx, cancel := appcontext.WithAPITimeout(context.Background())
+			err := appService.TerminateOperation(ctx, name, namespaces[name])
+			cancel()
+			if err != nil {
+				cblog.With("component", "terminate").Error("Terminate failed", "app", name, "err", err)
+				failures = append(failures, fmt.Sprintf("%s: %s", name, extractUserFriendlyError(err)))
+				continue
+			}
+			cblog.With("component", "terminate").Info("Operation terminated", "app", name)
+			terminated = append(terminated, name)
+		}
+		return model.OperationTerminatedMsg{Terminated: terminated, Errors: failures, SwitchEpoch: epoch}
 	}
 }
 
@@ -1093,6 +1148,7 @@ func (m *Model) startRollbackSession(appName string) tea.Cmd {
 			AppName:         appName,
 			Rows:            rows,
 			CurrentRevision: currentRevision,
+			AutoSync:        api.NewApplicationService(m.state.Server).ConvertToApp(*app).AutoSync,
 		}
 	}
 }
@@ -1124,9 +1180,11 @@ func (m *Model) loadRevisionMetadata(appName string, rowIndex int, revision stri
 	}
 }
 
-// executeRollback performs the actual rollback operation
+// executeRollback performs the actual rollback operation, disabling automated sync first
+// when that was chosen in the confirmation
 func (m *Model) executeRollback(request model.RollbackRequest) tea.Cmd {
 	epoch := m.switchEpoch // capture at call time
+	disableAutoSync := m.state.Rollback != nil && m.state.Rollback.AutoSync != nil && m.state.Rollback.DisableAutoSync
 	return func() tea.Msg {
 		if m.state.Server == nil {
 			return model.ApiErrorMsg{Message: "No server configured", SwitchEpoch: epoch}
@@ -1135,6 +1193,17 @@ func (m *Model) executeRollback(request model.RollbackRequest) tea.Cmd {
 		ctx, cancel := appcontext.WithMinAPITimeout(context.Background(), 60*time.Second)
 		defer cancel()
 
+		if disableAutoSync {
+			appService := api.NewApplicationService(m.state.Server)
+			if err := appService.PatchApplication(ctx, request.Name, request.AppNamespace, api.AutoSyncPatch(nil)); err != nil {
+				if isAuthenticationError(err.Error()) {
+					return model.AuthErrorMsg{Error: err, SwitchEpoch: epoch}
+				}
+				return model.ApiErrorMsg{Message: "Failed to disable automated sync: " + err.Error(), SwitchEpoch: epoch}
+			}
+			cblog.With("component", "rollback").Info("Disabled automated sync before rollback", "app", request.Name)
+		}
+
 		apiService := services.NewArgoApiService(m.state.Server)
 
 		err := apiService.RollbackApplication(ctx, m.state.Server, request)
@@ -1153,9 +1222,10 @@ func (m *Model) executeRollback(request model.RollbackRequest) tea.Cmd {
 		}
 
 		return model.RollbackExecutedMsg{
-			AppName: request.Name,
-			Success: true,
-			Watch:   watchAfter,
+			AppName:          request.Name,
+			Success:          true,
+			Watch:            watchAfter,
+			AutoSyncDisabled: disableAutoSync,
 		}
 	}
 }
@@ -1179,50 +1249,35 @@ func (m *Model) startRollbackDiffSession(appName string, revision string) tea.Cm
 			return model.ApiErrorMsg{Message: "Failed to load diffs: " + err.Error(), SwitchEpoch: epoch}
 		}
 
-		// Process diffs (same logic as regular diff)
-		desiredDocs := make([]string, 0)
-		liveDocs := make([]string, 0)
+		resources := make([]diff.Resource, 0, len(diffs))
 		for _, d := range diffs {
+			r := diff.Resource{Title: diffResourceTitle(d.Kind, d.Namespace, d.Name)}
+			if d.LiveState != "" {
+				r.Left = cleanManifestToYAML(d.LiveState)
+			}
 			if d.TargetState != "" {
-				s := cleanManifestToYAML(d.TargetState)
-				if s != "" {
-					desiredDocs = append(desiredDocs, s)
-				}
+				r.Right = cleanManifestToYAML(d.TargetState)
 			}
-			if d.LiveState != "" {
-				s := cleanManifestToYAML(d.LiveState)
-				if s != "" {
-					liveDocs = append(liveDocs, s)
-				}
+			if r.Left != "" || r.Right != "" {
+				resources = append(resources, r)
 			}
 		}
 
-		if len(desiredDocs) == 0 && len(liveDocs) == 0 {
+		if len(resources) == 0 {
 			return model.StatusChangeMsg{Status: "No diffs to show"}
 		}
 
-		leftFile, _ := writeTempYAML("live-", liveDocs)
-		rightFile, _ := writeTempYAML("rollback-", desiredDocs)
-
-		cmd := exec.Command("git", "--no-pager", "diff", "--no-index", "--color=always", "--", leftFile, rightFile)
-		out, err := cmd.CombinedOutput()
-		if err != nil && cmd.ProcessState != nil && cmd.ProcessState.ExitCode() != 1 {
-			return model.ApiErrorMsg{Message: "Diff failed: " + err.Error(), SwitchEpoch: epoch}
-		}
-
-		cleaned := stripDiffHeader(string(out))
-		if strings.TrimSpace(cleaned) == "" {
+		msg, changed := m.presentDiff(diffRequest{
+			Title:          fmt.Sprintf("Rollback %s to %s", appName, shortRevision(revision)),
+			FormatterTitle: appName,
+			LeftPrefix:     "live-",
+			RightPrefix:    "rollback-",
+			Resources:      resources,
+		}, epoch)
+		if !changed {
 			return model.StatusChangeMsg{Status: "No differences"}
 		}
-
-		lines := strings.Split(cleaned, "\n")
-		m.state.Diff = &model.DiffState{
-			Title:   fmt.Sprintf("Rollback %s to %s", appName, revision[:8]),
-			Content: lines,
-			Offset:  0,
-			Loading: false,
-		}
-		return model.SetModeMsg{Mode: model.ModeDiff}
+		return msg
 	}
 }
 
@@ -1563,3 +1618,91 @@ func (m *Model) syncSelectedResources(targets []model.ResourceSyncTarget, prune,
 		return model.ResourceSyncSuccessMsg{Count: successCount, AppNames: appNames, SwitchEpoch: epoch}
 	}
 }
+
+// loadResourceActions lists the actions ArgoCD offers for a single resource
+func (m *Model) loadResourceActions(target model.ResourceActionTarget) tea.Cmd {
+	// Actions run on one app, so check them on the app rather than its whole project
+	object, perms := model.AppRBACObject(m.appProject(target.AppName), target.AppName), m.state.Permissions
+	epoch := m.switchEpoch // capture at call time
+	return func() tea.Msg {
+		if m.state.Server == nil {
+			return model.ResourceActionsLoadedMsg{Error: "No server configured", SwitchEpoch: epoch}
+		}
+		ctx, cancel := appcontext.WithAPITimeout(context.Background())
+		defer cancel()
+
+		appService := api.NewApplicationService(m.state.Server)
+		actions, err := appService.ListResourceActions(ctx, api.ListResourceActionsParams{
+			AppName:      target.AppName,
+			AppNamespace: target.AppNamespace,
+			ResourceName: target.Name,
+			Namespace:    target.Namespace,
+			Kind:         target.Kind,
+			Group:        target.Group,
+			Version:      target.Version,
+		})
+		if err != nil {
+			cblog.With("component", "resource-actions").Error("Failed to list resource actions",
+				"kind", target.Kind, "name", target.Name, "err", err)
+			return model.ResourceActionsLoadedMsg{Error: extractUserFriendlyError(err), SwitchEpoch: epoch}
+		}
+
+		// Check the actions not checked before so the ones the user's role forbids can be disabled
+		permitted := make(map[string]bool)
+		account := api.NewAccountService(m.state.Server)
+		for _, action := range actions {
+			rbacAction := model.ResourceActionRBAC(target.Group, target.Kind, action)
+			if perms.Checked(object, rbacAction) {
+				continue
+			}
+			allowed, err := account.CanI(ctx, "applications", rbacAction, object)
+			if err != nil {
+				cblog.With("component", "resource-actions").Debug("Failed to check action permission", "action", rbacAction, "err", err)
+				break
+			}
+			permitted[rbacAction] = allowed
+		}
+		return model.ResourceActionsLoadedMsg{Actions: actions, Object: object, Permitted: permitted, SwitchEpoch: epoch}
+	}
+}
+
+// runResourceAction executes a resource action (e.g. restart) against a single resource
+func (m *Model) runResourceAction(target model.ResourceActionTarget, action string) tea.Cmd {
+	epoch := m.switchEpoch // capture at call time
+	return func() tea.Msg {
+		result := model.ResourceActionCompletedMsg{
+			AppName:     target.AppName,
+			Action:      action,
+			Kind:        target.Kind,
+			Name:        target.Name,
+			SwitchEpoch: epoch,
+		}
+		if m.state.Server == nil {
+			result.Error = "No server configured"
+			return result
+		}
+		cblog.With("component", "resource-actions").Info("Running resource action",
+			"app", target.AppName, "action", action, "kind", target.Kind, "name", target.Name)
+
+		ctx, cancel := appcontext.WithAPITimeout(context.Background())
+		defer cancel()
+
+		appService := api.NewApplicationService(m.state.Server)
+		err := appService.RunResourceAction(ctx, api.ResourceActionRequest{
+			AppName:      target.AppName,
+			AppNamespace: target.AppNamespace,
+			ResourceName: target.Name,
+			Namespace:    target.Namespace,
+			Kind:         target.Kind,
+			Group:        target.Group,
+			Version:      target.Version,
+			Action:       action,
+		})
+		if err != nil {
+			cblog.With("component", "resource-actions").Error("Resource action failed",
+				"action", action, "kind", target.Kind, "name", target.Name, "err", err)
+			result.Error = extractUserFriendlyError(err)
+		}
+		return result
+	}
+}
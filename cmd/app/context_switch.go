@@ -5,6 +5,7 @@ import (
 
 	tea "charm.land/bubbletea/v2"
 	cblog "github.com/charmbracelet/log"
+	"github.com/darksworm/argonaut/pkg/api"
 	"github.com/darksworm/argonaut/pkg/config"
 	"github.com/darksworm/argonaut/pkg/model"
 )
@@ -14,6 +15,7 @@ import (
 func (m *Model) performContextSwitch(contextName string) tea.Cmd {
 	configPath := m.argoConfigPath
 	currentCtx := m.currentContextName
+	argonautConfig := m.config
 	return func() tea.Msg {
 		// Same-context no-op
 		if contextName == currentCtx {
@@ -39,15 +41,18 @@ func (m *Model) performContextSwitch(contextName string) tea.Cmd {
 		}
 
 		// Resolve context to Server
-		server, err := cfg.ToServerConfigForContext(contextName)
-		if err != nil {
-			return model.ContextSwitchResultMsg{Error: err}
+		server := execAuthServer(cfg, argonautConfig, contextName)
+		if server == nil {
+			if server, err = cfg.ToServerConfigForContext(contextName); err != nil {
+				return model.ContextSwitchResultMsg{Error: err}
+			}
 		}
 
 		return model.ContextSwitchResultMsg{
-			Server:       server,
-			ContextName:  contextName,
-			ContextNames: cfg.GetContextNames(),
+			Server:        server,
+			ContextName:   contextName,
+			ContextNames:  cfg.GetContextNames(),
+			ContextTokens: cfg.ContextTokenStatuses(argonautConfig),
 		}
 	}
 }
@@ -71,10 +76,14 @@ func (m *Model) handleContextSwitchResult(msg model.ContextSwitchResultMsg) (tea
 	m.cleanupAppWatcher()
 	//    b. Stop tree watchers
 	_ = m.cleanupTreeWatchers()
+	_ = m.cleanupPodLogs()
+	_ = m.cleanupAppSetsWatcher()
 	//    c. Cancel HTTP SSE stream SECOND (after forwarder is stopped)
 	if m.watchCleanup != nil {
 		m.watchCleanup()
 	}
+	//    d. Drop the cached exec credential of the old server
+	api.ForgetExecAuth(m.state.Server)
 
 	// 2. Create fresh model with same config (re-applies preferences)
 	newM := NewModel(m.config)
@@ -90,6 +99,7 @@ func (m *Model) handleContextSwitchResult(msg model.ContextSwitchResultMsg) (tea
 	newM.currentContextName = msg.ContextName  // New context name
 	newM.state.Server = msg.Server             // New server config
 	newM.state.ContextNames = msg.ContextNames // From result (no 2nd config read)
+	newM.state.ContextTokens = msg.ContextTokens
 	newM.switchEpoch = m.switchEpoch + 1       // Increment epoch
 
 	// 5. Start fresh load cycle
@@ -97,5 +107,6 @@ func (m *Model) handleContextSwitchResult(msg model.ContextSwitchResultMsg) (tea
 		newM.spinner.Tick,
 		func() tea.Msg { return model.SetInitialLoadingMsg{Loading: true} },
 		newM.validateAuthentication(),
+		newM.scheduleTokenExpiry(),
 	)
 }
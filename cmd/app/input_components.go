@@ -27,6 +27,8 @@ func NewInputComponents() *InputComponentState {
 	searchInput.Placeholder = "Search..."
 	searchInput.CharLimit = 200
 	searchInput.SetWidth(50)
+	// Completes app filter queries in the apps view; Tab accepts
+	searchInput.ShowSuggestions = true
 
 	// Create command input
 	commandInput := textinput.New()
@@ -125,8 +127,20 @@ func (m *Model) renderEnhancedSearchBar() string {
 	styleWidth := maxInt(0, totalWidth-2)
 	innerWidth := maxInt(0, styleWidth-4)
 
+	// Explain why an apps filter is not applied as a query (it falls back to a plain search)
+	queryHint := ""
+	if value := m.inputComponents.GetSearchValue(); value != "" && m.state.Navigation.View == model.ViewApps && m.state.Logs == nil && m.state.Diff == nil {
+		if _, err := m.appQuery(value); err != nil {
+			queryHint = lipgloss.NewStyle().Foreground(dimColor).Render(" (" + err.Error() + ")")
+		}
+	}
+
 	// Allocate remaining width to the input field
 	baseUsed := lipgloss.Width(searchLabel) + 1 /*space*/
+	if queryHint != "" {
+		// The hint follows the input, which also renders its prompt and a cursor cell
+		baseUsed += lipgloss.Width(queryHint) + lipgloss.Width(m.inputComponents.searchInput.Prompt) + 1
+	}
 	minInput := 5
 	inputWidth := maxInt(minInput, innerWidth-baseUsed)
 	if inputWidth != m.inputComponents.searchInput.Width() {
@@ -135,7 +149,7 @@ func (m *Model) renderEnhancedSearchBar() string {
 
 	// Render
 	searchInputView := m.inputComponents.searchInput.View()
-	content := fmt.Sprintf("%s %s", searchLabel, searchInputView)
+	content := fmt.Sprintf("%s %s%s", searchLabel, searchInputView, queryHint)
 
 	return searchBarStyle.Width(styleWidth).Render(content)
 }
@@ -240,7 +254,28 @@ func (m *Model) validateCommand(input string) bool {
 				}
 			}
 			return false
-		case "app", "delete", "sync", "diff", "rollback", "resources":
+		case "repo":
+			_, ok := m.resolveRepoArg(arg)
+			return ok
+		case "label":
+			_, _, ok := m.resolveLabelArg(arg)
+			return ok
+		case "sync":
+			// :sync [app] [--flags]
+			syncArgs, err := model.ParseSyncArgs(parts[1:])
+			if err != nil {
+				return false
+			}
+			if syncArgs.App == "" {
+				return true
+			}
+			for _, a := range m.state.Apps {
+				if strings.EqualFold(a.Name, syncArgs.App) {
+					return true
+				}
+			}
+			return false
+		case "app", "delete", "diff", "rollback", "resources":
 			for _, a := range m.state.Apps {
 				if strings.EqualFold(a.Name, arg) {
 					return true
@@ -256,20 +291,12 @@ func (m *Model) validateCommand(input string) bool {
 			}
 			return false
 		case "sort":
-			// Validate sort argument format: field direction (both required)
-			// Must be exactly 2 parts after the command: e.g., "sort name asc"
-			if len(parts) != 3 {
-				return false
-			}
-			field := strings.ToLower(parts[1])
-			direction := strings.ToLower(parts[2])
-			if !model.IsValidSortField(field) {
-				return false
-			}
-			if !model.IsValidSortDirection(direction) {
-				return false
-			}
-			return true
+			// Validate sort argument format: field direction[, field direction…] (both required)
+			_, err := model.ParseSortSpec(strings.Join(parts[1:], " "))
+			return err == nil
+		case "autosync":
+			_, err := model.ParseAutoSyncArgs(parts[1:])
+			return err == nil
 		case "context":
 			// Context names are validated at execution time (re-reads config from disk)
 			// so any non-empty arg is syntactically valid here
@@ -376,6 +403,8 @@ func (m *Model) renderCommandInputWithAutocomplete(maxWidth int) string {
 			messageStyle := lipgloss.NewStyle().Foreground(dimColor) // dim gray
 			invalidMessage = messageStyle.Render(" (unknown command, see :help)")
 		}
+	} else if len(parts) >= 1 && !m.autocompleteEngine.CommandPermitted(parts[0], m.state) {
+		invalidMessage = lipgloss.NewStyle().Foreground(dimColor).Render(" (not permitted by your ArgoCD role)")
 	}
 
 	// Combine all parts
@@ -398,6 +427,7 @@ func (m *Model) handleEnhancedSearchModeKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd
 		cmd := m.inputComponents.UpdateSearchInput(msg)
 		// Sync the search query with the input value
 		m.state.UI.SearchQuery = m.inputComponents.GetSearchValue()
+		m.updateSearchSuggestions()
 		// Clamp selection within new filtered results
 		m.state.Navigation.SelectedIdx = m.navigationService.ValidateBounds(
 			m.state.Navigation.SelectedIdx,
@@ -408,7 +438,11 @@ func (m *Model) handleEnhancedSearchModeKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd
 		// Treat Ctrl+C as closing the input (do not quit app)
 		m.inputComponents.BlurInputs()
 		m.inputComponents.ClearSearchInput()
-		if m.state.Diff != nil {
+		if m.state.Logs != nil {
+			m.state.Logs.SearchQuery = ""
+			m.state.Mode = model.ModeLogs
+			m.clampLogsOffset()
+		} else if m.state.Diff != nil {
 			m.state.Mode = model.ModeDiff
 		} else {
 			m.state.Mode = model.ModeNormal
@@ -422,10 +456,14 @@ func (m *Model) handleEnhancedSearchModeKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd
 		// Navigate results while search is active
 		return m.handleNavigationDown()
 	case "esc":
-		// Exit search; if coming from diff mode, return to diff; else normal
+		// Exit search; if coming from logs or diff mode, return there; else normal
 		m.inputComponents.BlurInputs()
 		m.inputComponents.ClearSearchInput()
-		if m.state.Diff != nil {
+		if m.state.Logs != nil {
+			m.state.Logs.SearchQuery = ""
+			m.state.Mode = model.ModeLogs
+			m.clampLogsOffset()
+		} else if m.state.Diff != nil {
 			m.state.Mode = model.ModeDiff
 		} else {
 			m.state.Mode = model.ModeNormal
@@ -439,7 +477,14 @@ func (m *Model) handleEnhancedSearchModeKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd
 	case "enter":
 		// Apply search filter and exit search mode or drill down for non-app views
 		searchValue := m.inputComponents.GetSearchValue()
-		if m.state.Mode == model.ModeDiff {
+		if m.state.Logs != nil {
+			// Keep filter applied to the logs pane
+			m.state.Logs.SearchQuery = searchValue
+			m.inputComponents.BlurInputs()
+			m.state.Mode = model.ModeLogs
+			m.clampLogsOffset()
+			return m, nil
+		} else if m.state.Mode == model.ModeDiff {
 			// Apply filter to diff view
 			if m.state.Diff != nil {
 				m.state.Diff.SearchQuery = searchValue
@@ -483,8 +528,15 @@ func (m *Model) handleEnhancedSearchModeKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd
 	default:
 		// Let bubbles textinput handle the key
 		cmd := m.inputComponents.UpdateSearchInput(msg)
+		if m.state.Logs != nil {
+			// Real-time filtering of the logs pane; leaves the list filter untouched
+			m.state.Logs.SearchQuery = m.inputComponents.GetSearchValue()
+			m.clampLogsOffset()
+			return m, cmd
+		}
 		// Sync the search query with the input value
 		m.state.UI.SearchQuery = m.inputComponents.GetSearchValue()
+		m.updateSearchSuggestions()
 
 		// Handle real-time filtering for tree view
 		if m.state.Navigation.View == model.ViewTree && m.treeView != nil {
@@ -500,6 +552,15 @@ func (m *Model) handleEnhancedSearchModeKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd
 	}
 }
 
+// updateSearchSuggestions offers filter query completions while searching the apps view
+func (m *Model) updateSearchSuggestions() {
+	var suggestions []string
+	if m.state.Navigation.View == model.ViewApps && m.state.Logs == nil && m.state.Diff == nil {
+		suggestions = m.autocompleteEngine.GetQueryAutocomplete(m.inputComponents.GetSearchValue(), m.state)
+	}
+	m.inputComponents.searchInput.SetSuggestions(suggestions)
+}
+
 // handleEnhancedCommandModeKeys handles input when in command mode with bubbles textinput
 func (m *Model) handleEnhancedCommandModeKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
@@ -663,11 +724,9 @@ func (m *Model) handleEnhancedCommandModeKeys(msg tea.KeyMsg) (tea.Model, tea.Cm
 			return m, m.openTextPager("Logs", body)
 		case "sync":
 			// In tree view, sync the selected resource(s); in apps view, sync the app
-			if m.state.Navigation.View == model.ViewTree {
-				return m.handleResourceSync()
-			}
-			mdl, cmd := m.handleSyncModal()
-			return mdl, cmd
+			return m.handleSyncCommand(parts[1:])
+		case "terminate":
+			return m.handleTerminateModal(arg)
 		case "refresh":
 			return m.handleRefreshCommand(arg, false)
 		case "refresh!":
@@ -707,6 +766,9 @@ func (m *Model) handleEnhancedCommandModeKeys(msg tea.KeyMsg) (tea.Model, tea.Cm
 						return m, func() tea.Msg { return model.StatusChangeMsg{Status: "App not found: " + target} }
 					}
 
+					if cmd := m.operationDenied(model.OpDelete, targetApp.Name); cmd != nil {
+						return m, cmd
+					}
 					// Single app deletion
 					cblog.With("component", "app-delete").Debug(":delete command invoked", "app", target)
 					m.state.Mode = model.ModeConfirmAppDelete
@@ -720,6 +782,9 @@ func (m *Model) handleEnhancedCommandModeKeys(msg tea.KeyMsg) (tea.Model, tea.Cm
 					return m, nil
 				} else {
 					// Multiple apps selected - use multi-delete logic
+					if cmd := m.operationDenied(model.OpDelete, m.selectedAppNames()...); cmd != nil {
+						return m, cmd
+					}
 					cblog.With("component", "app-delete").Debug(":delete command invoked for multi-selection", "count", len(m.state.Selections.SelectedApps))
 					multiTarget := "__MULTI__"
 					m.state.Mode = model.ModeConfirmAppDelete
@@ -745,6 +810,9 @@ func (m *Model) handleEnhancedCommandModeKeys(msg tea.KeyMsg) (tea.Model, tea.Cm
 				if targetApp == nil {
 					return m, func() tea.Msg { return model.StatusChangeMsg{Status: "App not found: " + target} }
 				}
+				if cmd := m.operationDenied(model.OpDelete, targetApp.Name); cmd != nil {
+					return m, cmd
+				}
 
 				// Single app deletion
 				cblog.With("component", "app-delete").Debug(":delete command invoked", "app", target)
@@ -778,6 +846,9 @@ func (m *Model) handleEnhancedCommandModeKeys(msg tea.KeyMsg) (tea.Model, tea.Cm
 			if target == "" {
 				return m, func() tea.Msg { return model.StatusChangeMsg{Status: "No app selected for rollback"} }
 			}
+			if cmd := m.operationDenied(model.OpRollback, target); cmd != nil {
+				return m, cmd
+			}
 
 			// Use the same rollback logic as the R key
 			cblog.With("component", "rollback").Debug(":rollback command invoked", "app", target)
@@ -944,7 +1015,7 @@ func (m *Model) handleEnhancedCommandModeKeys(msg tea.KeyMsg) (tea.Model, tea.Cm
 				if !matched {
 					return m, func() tea.Msg { return model.StatusChangeMsg{Status: "Unknown cluster: " + arg} }
 				}
-				m.state.Selections.ScopeClusters = model.StringSetFromSlice([]string{arg})
+				m.state.Selections.ScopeClusters = model.StringSetFromSlice(m.clusterScopeKeys(arg))
 				m.state.Selections.ScopeNamespaces = model.NewStringSet()
 				m.state.Selections.ScopeProjects = model.NewStringSet()
 				m = m.safeChangeView(model.ViewNamespaces)
@@ -1064,8 +1135,34 @@ func (m *Model) handleEnhancedCommandModeKeys(msg tea.KeyMsg) (tea.Model, tea.Cm
 				// Show ApplicationSets list
 				m.state.Selections.ScopeApplicationSets = model.NewStringSet()
 				m = m.safeChangeView(model.ViewApplicationSets)
+				return m, m.ensureApplicationSets()
 			}
 			return m, nil
+		case "repo", "repos", "repository", "repositories":
+			m.state.UI.TreeAppName = nil
+			m.treeLoading = false
+			m.state.Navigation.SelectedIdx = 0
+			m.state.Selections.SelectedApps = model.NewStringSet()
+			if arg != "" {
+				repoURL, ok := m.resolveRepoArg(arg)
+				if !ok {
+					return m, func() tea.Msg { return model.StatusChangeMsg{Status: "Unknown repository: " + arg} }
+				}
+				// Filter apps by source repository
+				m.state.Selections.ScopeRepos = model.StringSetFromSlice([]string{repoURL})
+				m = m.safeChangeView(model.ViewApps)
+				return m, nil
+			}
+			// Show repositories list
+			m.state.Selections.ScopeRepos = model.NewStringSet()
+			m = m.safeChangeView(model.ViewRepositories)
+			return m, m.ensureRepositories()
+		case "label", "labels":
+			m.state.UI.TreeAppName = nil
+			m.treeLoading = false
+			m.state.Navigation.SelectedIdx = 0
+			m.state.Selections.SelectedApps = model.NewStringSet()
+			return m.handleLabelCommand(arg)
 		case "help":
 			// Show help modal
 			m.state.Mode = model.ModeHelp
@@ -1074,6 +1171,10 @@ func (m *Model) handleEnhancedCommandModeKeys(msg tea.KeyMsg) (tea.Model, tea.Cm
 			return m.handleThemeCommand(arg)
 		case "sort":
 			return m.handleSortCommand(allArgs)
+		case "columns", "cols":
+			return m.handleColumnsCommand(strings.ReplaceAll(allArgs, " ", ""))
+		case "autosync", "auto-sync":
+			return m.handleAutoSyncCommand(strings.Fields(allArgs))
 		case "quit", "q", "q!", "wq", "wq!", "exit":
 			// Exit the application
 			return m, func() tea.Msg { return model.QuitMsg{} }
@@ -1213,57 +1314,46 @@ func (m *Model) handleSortCommand(arg string) (*Model, tea.Cmd) {
 		current := m.state.UI.Sort
 		return m, func() tea.Msg {
 			return model.StatusChangeMsg{
-				Status: fmt.Sprintf("Current sort: %s %s. Usage: :sort field direction (e.g., :sort name asc)",
-					current.Field, current.Direction),
+				Status: fmt.Sprintf("Current sort: %s. Usage: :sort field direction[, field direction…] (e.g., :sort health desc, lastSync asc)",
+					current),
 			}
 		}
 	}
 
-	// Parse "field direction" format - both are required
-	parts := strings.Fields(arg)
-	if len(parts) != 2 {
-		return m, func() tea.Msg {
-			return model.StatusChangeMsg{Status: "Invalid format. Use: :sort field direction (e.g., :sort name asc)"}
-		}
-	}
-
-	field := strings.ToLower(parts[0])
-	direction := strings.ToLower(parts[1])
-
-	if !model.IsValidSortField(field) {
-		return m, func() tea.Msg {
-			return model.StatusChangeMsg{Status: "Invalid field. Use: name, sync, or health"}
-		}
-	}
-
-	if !model.IsValidSortDirection(direction) {
+	// Parse "field direction[, field direction…]" - both are required for every key
+	sortConfig, err := model.ParseSortSpec(arg)
+	if err != nil {
 		return m, func() tea.Msg {
-			return model.StatusChangeMsg{Status: "Invalid direction. Use: asc or desc"}
+			return model.StatusChangeMsg{Status: fmt.Sprintf("Invalid sort: %v. Fields: %s", err, sortFieldList())}
 		}
 	}
 
 	// Update state
-	m.state.UI.Sort = model.SortConfig{
-		Field:     model.SortField(field),
-		Direction: model.SortDirection(direction),
-	}
+	m.state.UI.Sort = sortConfig
 
 	// Persist to config
 	argonautConfig, err := config.LoadArgonautConfig()
 	if err != nil {
 		argonautConfig = config.GetDefaultConfig()
 	}
-	argonautConfig.Sort = config.SortConfig{
-		Field:     field,
-		Direction: direction,
-	}
+	argonautConfig.Sort = config.NewSortConfig(sortConfig)
 	if err := config.SaveArgonautConfig(argonautConfig); err != nil {
 		cblog.Warn("Failed to save sort preference", "err", err)
 	}
 
 	return m, func() tea.Msg {
-		return model.StatusChangeMsg{Status: fmt.Sprintf("Sorting by %s (%s)", field, direction)}
+		return model.StatusChangeMsg{Status: fmt.Sprintf("Sorting by %s", sortConfig)}
+	}
+}
+
+// sortFieldList lists the sort fields for help and error messages
+func sortFieldList() string {
+	fields := model.ValidSortFields()
+	names := make([]string, len(fields))
+	for i, f := range fields {
+		names[i] = string(f)
 	}
+	return strings.Join(names, ", ")
 }
 
 // local helpers
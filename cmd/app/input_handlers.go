@@ -3,6 +3,7 @@ package main
 import (
 	"fmt"
 	"os"
+	"sort"
 	"strings"
 	"time"
 
@@ -140,6 +141,16 @@ func (m *Model) handleDrillDown() (tea.Model, tea.Cmd) {
 		return m, nil
 	}
 
+	// In the labels view, enter on a label key lists that key's values
+	if m.state.Navigation.View == model.ViewLabels && m.state.Selections.LabelKey == "" {
+		m.state.Selections.LabelKey = fmt.Sprintf("%v", visibleItems[m.state.Navigation.SelectedIdx])
+		m.state.Navigation.SelectedIdx = 0
+		m.state.UI.ActiveFilter = ""
+		m.state.UI.SearchQuery = ""
+		m.listNav.Reset()
+		return m, nil
+	}
+
 	selectedItem := visibleItems[m.state.Navigation.SelectedIdx]
 
 	// Use navigation service to handle drill-down logic
@@ -163,7 +174,14 @@ func (m *Model) handleDrillDown() (tea.Model, tea.Cmd) {
 	}
 
 	if result.ScopeClusters != nil {
-		m.state.Selections.ScopeClusters = result.ScopeClusters
+		// Apps label a cluster by name or by server host depending on how they target it
+		scope := model.NewStringSet()
+		for label := range result.ScopeClusters {
+			for _, key := range m.clusterScopeKeys(label) {
+				scope = model.AddToStringSet(scope, key)
+			}
+		}
+		m.state.Selections.ScopeClusters = scope
 	}
 
 	if result.ScopeNamespaces != nil {
@@ -178,6 +196,14 @@ func (m *Model) handleDrillDown() (tea.Model, tea.Cmd) {
 		m.state.Selections.ScopeApplicationSets = result.ScopeApplicationSets
 	}
 
+	if result.ScopeRepos != nil {
+		m.state.Selections.ScopeRepos = result.ScopeRepos
+	}
+
+	if result.ScopeLabels != nil {
+		m.state.Selections.ScopeLabels = result.ScopeLabels
+	}
+
 	if result.SelectedApps != nil {
 		m.state.Selections.SelectedApps = result.SelectedApps
 	}
@@ -302,14 +328,72 @@ func (m *Model) handleSyncModal() (tea.Model, tea.Cmd) {
 		m.state.Modals.ConfirmTarget = &target
 	}
 
-	if m.state.Modals.ConfirmTarget != nil {
-		m.state.Modals.ConfirmSyncSelected = 0 // default to Yes
-		m.state.Mode = model.ModeConfirmSync
+	if target := m.state.Modals.ConfirmTarget; target != nil {
+		names := []string{*target}
+		if *target == "__MULTI__" {
+			names = m.selectedAppNames()
+		}
+		if cmd := m.operationDenied(model.OpSync, names...); cmd != nil {
+			m.state.Modals.ConfirmTarget = nil
+			return m, cmd
+		}
+		m.openConfirmSync(*target)
 	}
 
 	return m, nil
 }
 
+// openConfirmSync shows the sync confirmation for target with fresh sync options.
+// Prune and watch keep their previous values.
+func (m *Model) openConfirmSync(target string) {
+	m.state.Modals.ConfirmTarget = &target
+	m.state.Modals.ConfirmSyncSelected = 0 // default to Yes
+	m.state.Modals.ConfirmSyncOptions = model.SyncOptions{}
+	m.state.Modals.ConfirmSyncEditingRevision = false
+	m.state.Mode = model.ModeConfirmSync
+}
+
+// handleSyncCommand handles :sync [app] [flags]
+func (m *Model) handleSyncCommand(args []string) (tea.Model, tea.Cmd) {
+	syncArgs, err := model.ParseSyncArgs(args)
+	if err != nil {
+		return m, func() tea.Msg { return model.StatusChangeMsg{Status: "Invalid sync arguments: " + err.Error()} }
+	}
+
+	switch {
+	case syncArgs.App != "":
+		name := ""
+		for _, app := range m.state.Apps {
+			if strings.EqualFold(app.Name, syncArgs.App) {
+				name = app.Name
+				break
+			}
+		}
+		if name == "" {
+			return m, func() tea.Msg { return model.StatusChangeMsg{Status: "Unknown app: " + syncArgs.App} }
+		}
+		if cmd := m.operationDenied(model.OpSync, name); cmd != nil {
+			return m, cmd
+		}
+		m.openConfirmSync(name)
+	case m.state.Navigation.View == model.ViewTree:
+		// In tree view, sync the selected resource(s)
+		return m.handleResourceSync()
+	default:
+		mdl, cmd := m.handleSyncModal()
+		if m.state.Mode != model.ModeConfirmSync {
+			// Nothing to sync; handleSyncModal reports why
+			return mdl, cmd
+		}
+	}
+
+	m.state.Modals.ConfirmSyncOptions = syncArgs.Options
+	if syncArgs.Prune {
+		m.state.Modals.ConfirmSyncPrune = true
+	}
+	return m, nil
+}
+
 // handleRollback initiates rollback for selected or current app
 func (m *Model) handleRollback() (tea.Model, tea.Cmd) {
 	if m.state.Navigation.View != model.ViewApps {
@@ -344,6 +428,9 @@ func (m *Model) handleRollback() (tea.Model, tea.Cmd) {
 		m.statusService.Set("No app selected for rollback")
 		return m, nil
 	}
+	if cmd := m.operationDenied(model.OpRollback, appName); cmd != nil {
+		return m, cmd
+	}
 
 	// Set rollback app name and switch to rollback mode
 	m.state.Modals.RollbackAppName = &appName
@@ -411,6 +498,22 @@ func (m *Model) handleEscape() (tea.Model, tea.Cmd) {
 				m.state.Selections.ScopeApplicationSets = model.NewStringSet()
 				m = m.safeChangeView(model.ViewApplicationSets)
 				m.state.Navigation.SelectedIdx = 0
+				return m, m.ensureApplicationSets()
+			}
+			// Apps scoped by repository return to the repositories view
+			if len(m.state.Selections.ScopeRepos) > 0 {
+				m.state.Selections.SelectedApps = model.NewStringSet()
+				m.state.Selections.ScopeRepos = model.NewStringSet()
+				m = m.safeChangeView(model.ViewRepositories)
+				m.state.Navigation.SelectedIdx = 0
+				return m, m.ensureRepositories()
+			}
+			// Apps scoped by label return to the values of that label key
+			if len(m.state.Selections.ScopeLabels) > 0 {
+				m.state.Selections.SelectedApps = model.NewStringSet()
+				m.state.Selections.ScopeLabels = model.NewStringSet()
+				m = m.safeChangeView(model.ViewLabels)
+				m.state.Navigation.SelectedIdx = 0
 				return m, nil
 			}
 			// Clear current level (selected apps) and prior (projects), go up to Projects
@@ -422,6 +525,16 @@ func (m *Model) handleEscape() (tea.Model, tea.Cmd) {
 			// At ApplicationSets view, escape just clears the scope (stay in place)
 			m.state.Selections.ScopeApplicationSets = model.NewStringSet()
 			m.state.Navigation.SelectedIdx = 0
+		case model.ViewRepositories:
+			// Like ApplicationSets, repositories are a separate hierarchy; stay in place
+			m.state.Selections.ScopeRepos = model.NewStringSet()
+			m.state.Navigation.SelectedIdx = 0
+		case model.ViewLabels:
+			// Go back from a key's values to the label keys
+			m.state.Selections.ScopeLabels = model.NewStringSet()
+			m.state.Selections.LabelKey = ""
+			m.listNav.Reset()
+			m.state.Navigation.SelectedIdx = 0
 		case model.ViewProjects:
 			// Clear current (projects) and prior (namespaces), go up to Namespaces
 			m.state.Selections.ScopeProjects = model.NewStringSet()
@@ -573,15 +686,43 @@ func (m *Model) handleDiffModeKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	}
 	switch msg.String() {
 	case "q", "esc":
-		m.state.Mode = model.ModeNormal
+		m.state.Mode = m.resourceDiffsReturnMode()
 		m.state.Diff = nil
+		m.diffSections = nil
 		return m, nil
+	case "n", "p", "s":
+		// Next/previous resource and resource sync when opened from the diff navigator
+		if m.state.ResourceDiffs == nil {
+			return m, nil
+		}
+		switch msg.String() {
+		case "n":
+			return m, m.stepResourceDiff(1)
+		case "p":
+			return m, m.stepResourceDiff(-1)
+		}
+		return m.confirmResourceDiffSync()
 	case "/":
 		// Reuse search input for diff filtering
 		m.inputComponents.ClearSearchInput()
 		m.inputComponents.FocusSearchInput()
 		m.state.Mode = model.ModeSearch
 		return m, nil
+	case "]":
+		return m, m.jumpDiff(m.state.Diff.Hunks, 1, "hunk")
+	case "[":
+		return m, m.jumpDiff(m.state.Diff.Hunks, -1, "hunk")
+	case "}":
+		return m, m.jumpDiff(m.state.Diff.Sections, 1, "resource")
+	case "{":
+		return m, m.jumpDiff(m.state.Diff.Sections, -1, "resource")
+	case "v":
+		// Toggle unified and side-by-side layout (built-in engine only)
+		if m.diffSections != nil {
+			m.diffSideBySide = !m.diffSideBySide
+			m.renderBuiltinDiff()
+		}
+		return m, nil
 	default:
 		return m, nil
 	}
@@ -594,8 +735,19 @@ func (m *Model) diffPageSize() int {
 	return max(1, m.state.Terminal.Rows-overhead)
 }
 
+// syncRetryLimits are the retry limits cycled with "t" in the sync modal (0 = no retry)
+var syncRetryLimits = []int{0, 1, 3, 5, 10}
+
+// syncRetryBackoffs are the initial backoff durations cycled with "b" ("" = ArgoCD default)
+var syncRetryBackoffs = []string{"", "5s", "10s", "30s", "1m"}
+
 // handleConfirmSyncKeys handles input when in sync confirmation mode
 func (m *Model) handleConfirmSyncKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.state.Modals.ConfirmSyncEditingRevision {
+		return m.handleSyncRevisionInput(msg)
+	}
+
+	opts := &m.state.Modals.ConfirmSyncOptions
 	switch msg.String() {
 	case "esc", "q":
 		m.state.Mode = model.ModeNormal
@@ -623,17 +775,19 @@ func (m *Model) handleConfirmSyncKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		// Confirm sync - keep modal open and show loading overlay
 		target := m.state.Modals.ConfirmTarget
 		prune := m.state.Modals.ConfirmSyncPrune
+		options := m.state.Modals.ConfirmSyncOptions
 		m.state.Modals.ConfirmSyncLoading = true
 		m.state.Mode = model.ModeConfirmSync
 
 		if target != nil {
 			cblog.With("component", "sync").Info("Executing sync confirmation",
 				"target", *target,
-				"isMulti", *target == "__MULTI__")
+				"isMulti", *target == "__MULTI__",
+				"options", options)
 			if *target == "__MULTI__" {
-				return m, m.syncSelectedApplications(prune)
+				return m, m.syncSelectedApplications(prune, options)
 			} else {
-				return m, m.syncSingleApplication(*target, prune)
+				return m, m.syncSingleApplication(*target, prune, options)
 			}
 		}
 		return m, nil
@@ -645,10 +799,183 @@ func (m *Model) handleConfirmSyncKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		// Toggle watch option (single or multi)
 		m.state.Modals.ConfirmSyncWatch = !m.state.Modals.ConfirmSyncWatch
 		return m, nil
+	case "o":
+		opts.ApplyOutOfSyncOnly = !opts.ApplyOutOfSyncOnly
+		return m, nil
+	case "s":
+		opts.ServerSideApply = !opts.ServerSideApply
+		return m, nil
+	case "r":
+		opts.Replace = !opts.Replace
+		return m, nil
+	case "P":
+		opts.PruneLast = !opts.PruneLast
+		return m, nil
+	case "i":
+		opts.RespectIgnoreDifferences = !opts.RespectIgnoreDifferences
+		return m, nil
+	case "n":
+		opts.CreateNamespace = !opts.CreateNamespace
+		return m, nil
+	case "t":
+		opts.RetryLimit = nextInCycle(syncRetryLimits, opts.RetryLimit)
+		return m, nil
+	case "b":
+		opts.RetryBackoffDuration = nextInCycle(syncRetryBackoffs, opts.RetryBackoffDuration)
+		return m, nil
+	case "v":
+		// Edit the revision to sync to
+		m.state.Modals.ConfirmSyncEditingRevision = true
+		m.syncRevisionBeforeEdit = opts.Revision
+		return m, nil
+	}
+	return m, nil
+}
+
+// handleSyncRevisionInput edits the revision field of the sync modal.
+// Enter keeps the typed revision, esc restores the previous one.
+func (m *Model) handleSyncRevisionInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	opts := &m.state.Modals.ConfirmSyncOptions
+	switch msg.String() {
+	case "enter":
+		opts.Revision = strings.TrimSpace(opts.Revision)
+		m.state.Modals.ConfirmSyncEditingRevision = false
+	case "esc":
+		opts.Revision = m.syncRevisionBeforeEdit
+		m.state.Modals.ConfirmSyncEditingRevision = false
+	case "backspace":
+		if r := []rune(opts.Revision); len(r) > 0 {
+			opts.Revision = string(r[:len(r)-1])
+		}
+	case "ctrl+u":
+		opts.Revision = ""
+	default:
+		if key, ok := msg.(tea.KeyPressMsg); ok && key.Text != "" && key.Text != " " {
+			opts.Revision += key.Text
+		}
 	}
 	return m, nil
 }
 
+// nextInCycle returns the element after current in values, wrapping around
+func nextInCycle[T comparable](values []T, current T) T {
+	for i, v := range values {
+		if v == current {
+			return values[(i+1)%len(values)]
+		}
+	}
+	return values[0]
+}
+
+// handleTerminateModal opens the terminate confirmation for the named app, or for the
+// multi-selection / cursor app in the apps view. Only apps with a running operation are targeted.
+func (m *Model) handleTerminateModal(target string) (tea.Model, tea.Cmd) {
+	var candidates []string
+	if target != "" {
+		for _, app := range m.state.Apps {
+			if strings.EqualFold(app.Name, target) {
+				candidates = append(candidates, app.Name)
+				break
+			}
+		}
+		if len(candidates) == 0 {
+			return m, func() tea.Msg { return model.StatusChangeMsg{Status: "App not found: " + target} }
+		}
+	} else {
+		if m.state.Navigation.View != model.ViewApps {
+			return m, func() tea.Msg {
+				return model.StatusChangeMsg{Status: "Navigate to apps view to terminate operations"}
+			}
+		}
+		if len(m.state.Selections.SelectedApps) > 0 {
+			for name, ok := range m.state.Selections.SelectedApps {
+				if ok {
+					candidates = append(candidates, name)
+				}
+			}
+			sort.Strings(candidates)
+		} else {
+			items := m.getVisibleItemsForCurrentView()
+			if len(items) > 0 && m.state.Navigation.SelectedIdx < len(items) {
+				if app, ok := items[m.state.Navigation.SelectedIdx].(model.App); ok {
+					candidates = append(candidates, app.Name)
+				}
+			}
+			if len(candidates) == 0 {
+				return m, func() tea.Msg { return model.StatusChangeMsg{Status: "No app selected to terminate"} }
+			}
+		}
+	}
+
+	running := make([]string, 0, len(candidates))
+	for _, name := range candidates {
+		for _, app := range m.state.Apps {
+			if app.Name == name && app.HasRunningOperation() {
+				running = append(running, name)
+				break
+			}
+		}
+	}
+	if len(running) == 0 {
+		status := "No running operation for " + candidates[0]
+		if len(candidates) > 1 {
+			status = "No running operations in selection"
+		}
+		return m, func() tea.Msg { return model.StatusChangeMsg{Status: status} }
+	}
+
+	cblog.With("component", "terminate").Debug("Opening terminate confirmation", "apps", running)
+	m.state.Modals.TerminateApps = running
+	m.state.Modals.TerminateSelected = 0 // default to Yes
+	m.state.Modals.TerminateLoading = false
+	m.state.Modals.TerminateError = nil
+	m.state.Mode = model.ModeConfirmTerminate
+	return m, nil
+}
+
+// handleConfirmTerminateKeys handles input when in terminate confirmation mode
+func (m *Model) handleConfirmTerminateKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.state.Modals.TerminateLoading {
+		return m, nil
+	}
+	switch msg.String() {
+	case "esc", "q":
+		m.clearTerminateModal()
+		return m, nil
+	case "left", "h":
+		m.state.Modals.TerminateSelected = 0
+		return m, nil
+	case "right", "l":
+		m.state.Modals.TerminateSelected = 1
+		return m, nil
+	case "enter":
+		if m.state.Modals.TerminateSelected == 1 {
+			// Cancel
+			m.clearTerminateModal()
+			return m, nil
+		}
+		fallthrough
+	case "y":
+		if len(m.state.Modals.TerminateApps) == 0 {
+			return m, nil
+		}
+		m.state.Modals.TerminateLoading = true
+		m.state.Modals.TerminateError = nil
+		cblog.With("component", "terminate").Info("Executing terminate confirmation", "apps", m.state.Modals.TerminateApps)
+		return m, m.terminateOperations(m.state.Modals.TerminateApps)
+	}
+	return m, nil
+}
+
+// clearTerminateModal closes the terminate confirmation and resets its state
+func (m *Model) clearTerminateModal() {
+	m.state.Mode = model.ModeNormal
+	m.state.Modals.TerminateApps = nil
+	m.state.Modals.TerminateSelected = 0
+	m.state.Modals.TerminateLoading = false
+	m.state.Modals.TerminateError = nil
+}
+
 // rollbackPageSize returns the number of visible rows for page scrolling in rollback mode
 func (m *Model) rollbackPageSize() int {
 	// Approximate: modal takes ~60% of terminal height, minus header/footer
@@ -687,6 +1014,12 @@ func (m *Model) handleRollbackModeKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.state.Rollback.Watch = !m.state.Rollback.Watch
 		}
 		return m, nil
+	case "a":
+		// Toggle disabling automated sync first (only offered while it is enabled)
+		if m.state.Rollback.Mode == "confirm" && m.state.Rollback.AutoSync != nil {
+			m.state.Rollback.DisableAutoSync = !m.state.Rollback.DisableAutoSync
+		}
+		return m, nil
 	case "left", "h":
 		if m.state.Rollback.Mode == "confirm" {
 			m.state.Rollback.ConfirmSelected = 0
@@ -728,12 +1061,25 @@ func (m *Model) handleRollbackModeKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			}
 		}
 		return m, nil
+	case " ", "space":
+		// Mark or unmark the selected revision for comparison
+		if m.state.Rollback.Mode == "list" {
+			m.toggleRollbackMark()
+		}
+		return m, nil
 	case "d":
-		// Show diff for selected revision (if we want to implement this later)
-		if m.state.Rollback.Mode == "list" && len(m.state.Rollback.Rows) > 0 && m.state.Rollback.SelectedIdx < len(m.state.Rollback.Rows) {
-			selectedRow := m.state.Rollback.Rows[m.state.Rollback.SelectedIdx]
-			// Could implement diff viewing here later
-			_ = selectedRow
+		// Diff the marked revisions (or the selection against the current revision)
+		if m.state.Rollback.Mode == "list" {
+			from, to, ok := m.historyDiffRevisions()
+			if !ok {
+				return m, func() tea.Msg { return model.StatusChangeMsg{Status: "Mark another revision to compare"} }
+			}
+			return m, tea.Batch(
+				func() tea.Msg {
+					return model.StatusChangeMsg{Status: fmt.Sprintf("Loading manifests for %s and %s…", from.Label, to.Label)}
+				},
+				m.startHistoryDiffSession(m.state.Rollback.AppName, m.state.Rollback.AppNamespace, from, to),
+			)
 		}
 		return m, nil
 	}
@@ -806,6 +1152,9 @@ func (m *Model) handleAppDelete() (tea.Model, tea.Cmd) {
 		visibleItems := m.getVisibleItemsForCurrentView()
 		if len(visibleItems) > 0 && m.state.Navigation.SelectedIdx < len(visibleItems) {
 			if app, ok := visibleItems[m.state.Navigation.SelectedIdx].(model.App); ok {
+				if cmd := m.operationDenied(model.OpDelete, app.Name); cmd != nil {
+					return m, cmd
+				}
 				// Single app deletion
 				m.state.Mode = model.ModeConfirmAppDelete
 				m.state.Modals.DeleteAppName = &app.Name
@@ -820,6 +1169,9 @@ func (m *Model) handleAppDelete() (tea.Model, tea.Cmd) {
 			}
 		}
 	} else {
+		if cmd := m.operationDenied(model.OpDelete, m.selectedAppNames()...); cmd != nil {
+			return m, cmd
+		}
 		// Multiple apps selected
 		multiTarget := "__MULTI__"
 		m.state.Mode = model.ModeConfirmAppDelete
@@ -878,6 +1230,9 @@ func (m *Model) handleResourceDelete() (tea.Model, tea.Cmd) {
 	if !m.treeView.HasSelection() && m.treeView.CurrentResourceIsMissing() {
 		return m, nil
 	}
+	if cmd := m.operationDenied(model.OpDelete, m.treeView.GetAppName()); cmd != nil {
+		return m, cmd
+	}
 
 	// Get selected resources
 	selections := m.treeView.GetSelectedResources()
@@ -1017,6 +1372,10 @@ func (m *Model) handleResourceSync() (tea.Model, tea.Cmd) {
 		return m, nil
 	}
 
+	if cmd := m.operationDenied(model.OpSync, m.treeView.GetAppName()); cmd != nil {
+		return m, cmd
+	}
+
 	// Get selected resources
 	selections := m.treeView.GetSelectedResources()
 
@@ -1099,6 +1458,9 @@ func (m *Model) handleRefreshCommand(arg string, hard bool) (tea.Model, tea.Cmd)
 			}
 		}
 
+		if cmd := m.operationDenied(model.OpRefresh, appName); cmd != nil {
+			return m, cmd
+		}
 		cblog.With("component", refreshType).Debug(":refresh command invoked from tree view", "app", appName, "hard", hard)
 		return m, m.refreshSingleApplication(appName, appNamespace, hard)
 	}
@@ -1118,6 +1480,9 @@ func (m *Model) handleRefreshCommand(arg string, hard bool) (tea.Model, tea.Cmd)
 
 		if len(names) > 1 {
 			// Multiple apps selected - refresh all
+			if cmd := m.operationDenied(model.OpRefresh, names...); cmd != nil {
+				return m, cmd
+			}
 			cblog.With("component", refreshType).Debug(":refresh command invoked for multi-selection", "count", len(names), "hard", hard)
 			return m, m.refreshMultipleApplications(hard)
 		} else if len(names) == 1 {
@@ -1161,6 +1526,9 @@ func (m *Model) handleRefreshCommand(arg string, hard bool) (tea.Model, tea.Cmd)
 		}
 	}
 
+	if cmd := m.operationDenied(model.OpRefresh, targetApp.Name); cmd != nil {
+		return m, cmd
+	}
 	cblog.With("component", refreshType).Debug(":refresh command invoked", "app", target, "hard", hard)
 	return m, m.refreshSingleApplication(targetApp.Name, targetApp.AppNamespace, hard)
 }
@@ -1169,8 +1537,8 @@ func (m *Model) handleRefreshCommand(arg string, hard bool) (tea.Model, tea.Cmd)
 func (m *Model) handleConfirmResourceSyncKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	switch msg.String() {
 	case "q", "esc", "ctrl+c":
-		// Cancel sync and return to normal mode
-		m.state.Mode = model.ModeNormal
+		// Cancel sync and return to normal mode (or the diff navigator it was opened from)
+		m.state.Mode = m.resourceDiffsReturnMode()
 		m.state.Modals.ResourceSyncAppName = nil
 		m.state.Modals.ResourceSyncTargets = nil
 		m.state.Modals.ResourceSyncError = nil
@@ -1189,7 +1557,7 @@ func (m *Model) handleConfirmResourceSyncKeys(msg tea.KeyMsg) (tea.Model, tea.Cm
 	case "enter":
 		if m.state.Modals.ResourceSyncConfirmSelected == 1 {
 			// Cancel
-			m.state.Mode = model.ModeNormal
+			m.state.Mode = m.resourceDiffsReturnMode()
 			m.state.Modals.ResourceSyncAppName = nil
 			m.state.Modals.ResourceSyncTargets = nil
 			return m, nil
@@ -1226,9 +1594,156 @@ func (m *Model) executeResourceSync() (tea.Model, tea.Cmd) {
 	)
 }
 
+// handleResourceActions opens the actions picker for the resource under the tree cursor
+func (m *Model) handleResourceActions() (tea.Model, tea.Cmd) {
+	if m.state.Navigation.View != model.ViewTree || m.treeView == nil {
+		return m, nil
+	}
+	res, ok := m.treeView.CurrentResource()
+	if !ok {
+		return m, func() tea.Msg {
+			return model.StatusChangeMsg{Status: "Select a resource to run actions on"}
+		}
+	}
+	if res.IsMissing() {
+		return m, func() tea.Msg {
+			return model.StatusChangeMsg{Status: "Cannot run actions: resource is missing"}
+		}
+	}
+
+	target := model.ResourceActionTarget{
+		AppName:   res.AppName,
+		Group:     res.Group,
+		Version:   res.Version,
+		Kind:      res.Kind,
+		Namespace: res.Namespace,
+		Name:      res.Name,
+	}
+	for i := range m.state.Apps {
+		if m.state.Apps[i].Name == res.AppName {
+			target.AppNamespace = m.state.Apps[i].AppNamespace
+			break
+		}
+	}
+
+	m.state.Mode = model.ModeResourceActions
+	m.state.Modals.ResourceActionTarget = &target
+	m.state.Modals.ResourceActions = nil
+	m.state.Modals.ResourceActionsLoading = true
+	m.state.Modals.ResourceActionSelected = 0
+	m.state.Modals.ResourceActionConfirm = false
+	m.state.Modals.ResourceActionConfirmSelected = 0
+	m.state.Modals.ResourceActionRunning = false
+	m.state.Modals.ResourceActionError = nil
+
+	cblog.With("component", "resource-actions").Debug("Opening resource actions", "kind", res.Kind, "name", res.Name)
+
+	return m, m.loadResourceActions(target)
+}
+
+// handleResourceActionsKeys handles input in the resource actions modal
+func (m *Model) handleResourceActionsKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.state.Modals.ResourceActionRunning {
+		// Ignore input while the action is running
+		return m, nil
+	}
+	if m.state.Modals.ResourceActionConfirm {
+		switch msg.String() {
+		case "q", "esc":
+			// Back to the action list
+			m.state.Modals.ResourceActionConfirm = false
+			m.state.Modals.ResourceActionError = nil
+			return m, nil
+		case "left", "h":
+			m.state.Modals.ResourceActionConfirmSelected = 0
+			return m, nil
+		case "right", "l":
+			m.state.Modals.ResourceActionConfirmSelected = 1
+			return m, nil
+		case "enter":
+			if m.state.Modals.ResourceActionConfirmSelected == 1 {
+				m.state.Modals.ResourceActionConfirm = false
+				m.state.Modals.ResourceActionError = nil
+				return m, nil
+			}
+			return m.executeResourceAction()
+		case "y":
+			return m.executeResourceAction()
+		}
+		return m, nil
+	}
+
+	actions := m.state.Modals.ResourceActions
+	switch msg.String() {
+	case "q", "esc":
+		m.clearResourceActionsModal()
+		return m, nil
+	case "up", "k":
+		if m.state.Modals.ResourceActionSelected > 0 {
+			m.state.Modals.ResourceActionSelected--
+		}
+		return m, nil
+	case "down", "j":
+		if m.state.Modals.ResourceActionSelected < len(actions)-1 {
+			m.state.Modals.ResourceActionSelected++
+		}
+		return m, nil
+	case "enter":
+		if m.state.Modals.ResourceActionsLoading || len(actions) == 0 {
+			return m, nil
+		}
+		if action := actions[m.state.Modals.ResourceActionSelected]; !m.resourceActionAllowed(action) {
+			errMsg := "Your role does not allow running " + action
+			m.state.Modals.ResourceActionError = &errMsg
+			return m, nil
+		}
+		m.state.Modals.ResourceActionConfirm = true
+		m.state.Modals.ResourceActionConfirmSelected = 0 // Default to Run
+		m.state.Modals.ResourceActionError = nil
+		return m, nil
+	}
+	return m, nil
+}
+
+// executeResourceAction runs the chosen action after confirmation
+func (m *Model) executeResourceAction() (tea.Model, tea.Cmd) {
+	target := m.state.Modals.ResourceActionTarget
+	actions := m.state.Modals.ResourceActions
+	idx := m.state.Modals.ResourceActionSelected
+	if target == nil || idx < 0 || idx >= len(actions) {
+		return m, nil
+	}
+	m.state.Modals.ResourceActionRunning = true
+	m.state.Modals.ResourceActionError = nil
+	return m, m.runResourceAction(*target, actions[idx])
+}
+
+// clearResourceActionsModal closes the resource actions modal and resets its state
+func (m *Model) clearResourceActionsModal() {
+	m.state.Mode = model.ModeNormal
+	m.state.Modals.ResourceActionTarget = nil
+	m.state.Modals.ResourceActions = nil
+	m.state.Modals.ResourceActionsLoading = false
+	m.state.Modals.ResourceActionSelected = 0
+	m.state.Modals.ResourceActionConfirm = false
+	m.state.Modals.ResourceActionRunning = false
+	m.state.Modals.ResourceActionError = nil
+}
+
 // handleAuthRequiredModeKeys handles input when authentication is required
 func (m *Model) handleAuthRequiredModeKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
-	switch msg.String() {
+	key := msg.String()
+	if m.ensureLoginForm() != nil {
+		// The login form takes typed characters; quit and logs move to ctrl keys
+		switch key {
+		case "ctrl+c":
+		case "ctrl+l":
+			key = "l"
+		default:
+			return m.handleLoginKeys(msg)
+		}
+	}
+	switch key {
 	case "q", "ctrl+c":
 		return m, func() tea.Msg { return model.QuitMsg{} }
 	case "l":
@@ -1386,6 +1901,10 @@ func (m *Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m.handleNoDiffModeKeys(msg)
 	case model.ModeConfirmSync:
 		return m.handleConfirmSyncKeys(msg)
+	case model.ModeConfirmTerminate:
+		return m.handleConfirmTerminateKeys(msg)
+	case model.ModeConfirmAppSetDelete:
+		return m.handleConfirmAppSetDeleteKeys(msg)
 	case model.ModeRollback:
 		return m.handleRollbackModeKeys(msg)
 	case model.ModeConfirmAppDelete:
@@ -1394,8 +1913,26 @@ func (m *Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m.handleConfirmResourceDeleteKeys(msg)
 	case model.ModeConfirmResourceSync:
 		return m.handleConfirmResourceSyncKeys(msg)
+	case model.ModeResourceActions:
+		return m.handleResourceActionsKeys(msg)
 	case model.ModeDiff:
 		return m.handleDiffModeKeys(msg)
+	case model.ModeResourceDiffs:
+		return m.handleResourceDiffsKeys(msg)
+	case model.ModeLogs:
+		return m.handleLogsModeKeys(msg)
+	case model.ModeEvents:
+		return m.handleEventsModeKeys(msg)
+	case model.ModeManifest:
+		return m.handleManifestModeKeys(msg)
+	case model.ModeResourceEdit:
+		return m.handleResourceEditKeys(msg)
+	case model.ModeSyncProgress:
+		return m.handleSyncProgressKeys(msg)
+	case model.ModeProjectDetail:
+		return m.handleProjectDetailKeys(msg)
+	case model.ModeAppDetail:
+		return m.handleAppDetailKeys(msg)
 	case model.ModeAuthRequired:
 		return m.handleAuthRequiredModeKeys(msg)
 	case model.ModeError:
@@ -1472,6 +2009,24 @@ func (m *Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		case "d":
 			// Show diff for the selected resource
 			return m.handleResourceDiff()
+		case "L":
+			// Stream container logs for the selected pod or workload
+			return m.handleOpenPodLogs()
+		case "a":
+			// Open the actions picker for the selected resource
+			return m.handleResourceActions()
+		case "e":
+			// Show Kubernetes events for the selected resource (or whole app on its root)
+			return m.handleOpenResourceEvents()
+		case "y":
+			// Show the live manifest of the selected resource
+			return m.handleOpenManifest()
+		case "E":
+			// Edit the selected resource in $EDITOR and patch it
+			return m.handleEditResource()
+		case "O":
+			// Show sync operation progress for the selected app
+			return m.handleOpenSyncProgress()
 		case " ", "space":
 			// Toggle selection for delete
 			if m.treeView != nil {
@@ -1527,6 +2082,10 @@ func (m *Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		if m.state.Navigation.View == model.ViewApps {
 			return m.handleOpenResourcesForSelection()
 		}
+		// Re-check the connection of the selected repository (repositories view)
+		if m.state.Navigation.View == model.ViewRepositories {
+			return m.handleRefreshRepository()
+		}
 		return m, nil
 	case "d":
 		// Open diff for selected app (apps view)
@@ -1534,6 +2093,12 @@ func (m *Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			return m.handleOpenDiffForSelection()
 		}
 		return m, nil
+	case "e":
+		// Show Kubernetes events for selected app (apps view)
+		if m.state.Navigation.View == model.ViewApps {
+			return m.handleOpenAppEvents()
+		}
+		return m, nil
 	case "K":
 		// Open Application CR in k9s (apps view)
 		if m.state.Navigation.View == model.ViewApps {
@@ -1547,6 +2112,28 @@ func (m *Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		} else {
 			cblog.With("component", "rollback").Debug("Rollback not available in view", "view", m.state.Navigation.View)
 		}
+	case "T":
+		// Terminate the running operation of the selected app(s) (apps view)
+		if m.state.Navigation.View == model.ViewApps {
+			return m.handleTerminateModal("")
+		}
+		return m, nil
+	case "O":
+		// Show sync operation progress for the selected app (apps view)
+		if m.state.Navigation.View == model.ViewApps {
+			return m.handleOpenSyncProgress()
+		}
+		return m, nil
+	case "i":
+		// Show details and sync windows of the selected project (projects view)
+		if m.state.Navigation.View == model.ViewProjects {
+			return m.handleOpenProjectDetail()
+		}
+		// Show sources, conditions and sync policy of the selected app (apps view)
+		if m.state.Navigation.View == model.ViewApps {
+			return m.handleOpenAppDetail()
+		}
+		return m, nil
 	case "ctrl+d":
 		// Open delete confirmation for selected app (apps view) or resource (tree view)
 		if m.state.Navigation.View == model.ViewApps {
@@ -1555,6 +2142,9 @@ func (m *Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		if m.state.Navigation.View == model.ViewTree {
 			return m.handleResourceDelete()
 		}
+		if m.state.Navigation.View == model.ViewApplicationSets {
+			return m.handleAppSetDelete()
+		}
 		return m, nil
 	case "esc":
 		return m.handleEscape()
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/darksworm/argonaut/pkg/api"
+	"github.com/darksworm/argonaut/pkg/model"
+	"github.com/darksworm/argonaut/pkg/tui/treeview"
+)
+
+// buildActionsTestModel creates a model in tree view with the cursor on a Deployment
+func buildActionsTestModel() *Model {
+	m := NewModel(nil)
+	m.ready = true
+	m.state.Terminal.Cols = 100
+	m.state.Terminal.Rows = 30
+	m.state.Mode = model.ModeNormal
+	m.state.Navigation.View = model.ViewTree
+
+	appNamespace := "argocd"
+	m.state.Apps = []model.App{{Name: "web-app", Sync: "Synced", Health: "Healthy", AppNamespace: &appNamespace}}
+
+	ns := "default"
+	m.treeView = treeview.NewTreeView(100, 30)
+	m.treeView.SetAppMeta("web-app", "Healthy", "Synced")
+	m.treeView.UpsertAppTree("web-app", &api.ResourceTree{
+		Nodes: []api.ResourceNode{
+			{UID: "deploy-uid", Group: "apps", Version: "v1", Kind: "Deployment", Name: "web", Namespace: &ns},
+		},
+	})
+	m.treeView.SetSelectedIndex(1) // Deployment below the Application root
+	return m
+}
+
+func TestHandleResourceActions_OpensModalForCursorResource(t *testing.T) {
+	m := buildActionsTestModel()
+
+	_, cmd := m.handleResourceActions()
+	if cmd == nil {
+		t.Fatal("Expected a command to load actions")
+	}
+	if m.state.Mode != model.ModeResourceActions {
+		t.Fatalf("Expected ModeResourceActions, got %s", m.state.Mode)
+	}
+	target := m.state.Modals.ResourceActionTarget
+	if target == nil {
+		t.Fatal("Expected action target to be set")
+	}
+	if target.AppName != "web-app" || target.Kind != "Deployment" || target.Group != "apps" || target.Version != "v1" || target.Name != "web" {
+		t.Errorf("Unexpected target: %+v", target)
+	}
+	if target.AppNamespace == nil || *target.AppNamespace != "argocd" {
+		t.Errorf("Expected app namespace argocd, got %v", target.AppNamespace)
+	}
+	if !m.state.Modals.ResourceActionsLoading {
+		t.Error("Expected actions to be loading")
+	}
+}
+
+func TestHandleResourceActions_RejectsApplicationRoot(t *testing.T) {
+	m := buildActionsTestModel()
+	m.treeView.SetSelectedIndex(0)
+
+	m.handleResourceActions()
+	if m.state.Mode != model.ModeNormal {
+		t.Fatalf("Expected mode to stay normal on Application root, got %s", m.state.Mode)
+	}
+}
+
+func TestResourceActionsKeys_SelectConfirmAndRun(t *testing.T) {
+	m := buildActionsTestModel()
+	m.handleResourceActions()
+	m.Update(model.ResourceActionsLoadedMsg{Actions: []string{"restart", "pause"}, SwitchEpoch: m.switchEpoch})
+
+	if m.state.Modals.ResourceActionsLoading {
+		t.Fatal("Expected loading to finish")
+	}
+
+	m.handleResourceActionsKeys(testKeyMsg("j"))
+	if m.state.Modals.ResourceActionSelected != 1 {
+		t.Fatalf("Expected second action selected, got %d", m.state.Modals.ResourceActionSelected)
+	}
+	m.handleResourceActionsKeys(testKeyMsg("k"))
+
+	m.handleResourceActionsKeys(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if !m.state.Modals.ResourceActionConfirm {
+		t.Fatal("Expected confirmation step after choosing an action")
+	}
+
+	// Esc in confirmation goes back to the list, not out of the modal
+	m.handleResourceActionsKeys(testKeyMsg("esc"))
+	if m.state.Modals.ResourceActionConfirm || m.state.Mode != model.ModeResourceActions {
+		t.Fatal("Expected esc to return to the action list")
+	}
+
+	m.handleResourceActionsKeys(tea.KeyPressMsg{Code: tea.KeyEnter})
+	_, cmd := m.handleResourceActionsKeys(testKeyMsg("y"))
+	if cmd == nil || !m.state.Modals.ResourceActionRunning {
+		t.Fatal("Expected action to start running after confirmation")
+	}
+
+	m.Update(model.ResourceActionCompletedMsg{AppName: "web-app", Action: "restart", Kind: "Deployment", Name: "web", SwitchEpoch: m.switchEpoch})
+	if m.state.Mode != model.ModeNormal || m.state.Modals.ResourceActionTarget != nil {
+		t.Fatal("Expected modal to close after a successful action")
+	}
+}
+
+func TestResourceActionCompleted_ErrorKeepsModalOpen(t *testing.T) {
+	m := buildActionsTestModel()
+	m.handleResourceActions()
+	m.Update(model.ResourceActionsLoadedMsg{Actions: []string{"restart"}, SwitchEpoch: m.switchEpoch})
+	m.handleResourceActionsKeys(tea.KeyPressMsg{Code: tea.KeyEnter})
+	m.handleResourceActionsKeys(testKeyMsg("y"))
+	if !m.state.Modals.ResourceActionRunning {
+		t.Fatal("Expected action to be running")
+	}
+
+	m.Update(model.ResourceActionCompletedMsg{Action: "restart", Kind: "Deployment", Name: "web", Error: "forbidden", SwitchEpoch: m.switchEpoch})
+	if m.state.Mode != model.ModeResourceActions {
+		t.Fatalf("Expected modal to stay open on error, got %s", m.state.Mode)
+	}
+	if m.state.Modals.ResourceActionRunning {
+		t.Error("Expected running state to be cleared")
+	}
+	if m.state.Modals.ResourceActionError == nil || *m.state.Modals.ResourceActionError != "forbidden" {
+		t.Errorf("Expected error to be shown, got %v", m.state.Modals.ResourceActionError)
+	}
+}
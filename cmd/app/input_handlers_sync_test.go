@@ -0,0 +1,134 @@
+package main
+
+import (
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+func TestSyncCommand_WithAppAndFlags(t *testing.T) {
+	m := buildDeleteTestModel(100, 30)
+
+	m.handleSyncCommand([]string{"zzz-other-app", "--server-side", "--revision=v2", "--prune", "--retry-limit", "3"})
+
+	if m.state.Mode != model.ModeConfirmSync {
+		t.Fatalf("Expected mode to be ModeConfirmSync, got %s", m.state.Mode)
+	}
+	if m.state.Modals.ConfirmTarget == nil || *m.state.Modals.ConfirmTarget != "zzz-other-app" {
+		t.Fatalf("Expected ConfirmTarget zzz-other-app, got %v", m.state.Modals.ConfirmTarget)
+	}
+	opts := m.state.Modals.ConfirmSyncOptions
+	if !opts.ServerSideApply || opts.Revision != "v2" || opts.RetryLimit != 3 {
+		t.Fatalf("Expected flags to be applied, got %+v", opts)
+	}
+	if !m.state.Modals.ConfirmSyncPrune {
+		t.Fatal("Expected --prune to enable prune")
+	}
+}
+
+func TestSyncCommand_InvalidFlag(t *testing.T) {
+	m := buildDeleteTestModel(100, 30)
+
+	_, cmd := m.handleSyncCommand([]string{"--bogus"})
+	if m.state.Mode != model.ModeNormal {
+		t.Fatalf("Expected mode to stay ModeNormal, got %s", m.state.Mode)
+	}
+	if cmd == nil {
+		t.Fatal("Expected a status command for the invalid flag")
+	}
+	if !m.validateCommand("sync --server-side") || m.validateCommand("sync --bogus") || m.validateCommand("sync no-such-app") {
+		t.Fatal("validateCommand did not match ParseSyncArgs and app lookup")
+	}
+}
+
+func TestSyncModal_ResetsOptionsOnOpen(t *testing.T) {
+	m := buildDeleteTestModel(100, 30)
+	m.state.Modals.ConfirmSyncOptions = model.SyncOptions{Revision: "stale", Replace: true}
+
+	m.handleSyncModal()
+
+	if m.state.Modals.ConfirmSyncOptions != (model.SyncOptions{}) {
+		t.Fatalf("Expected options to be reset, got %+v", m.state.Modals.ConfirmSyncOptions)
+	}
+}
+
+func TestConfirmSyncKeys_ToggleOptions(t *testing.T) {
+	m := buildDeleteTestModel(100, 30)
+	m.handleSyncModal()
+
+	for _, k := range []string{"o", "s", "r", "P", "i", "n", "t", "t", "b"} {
+		m.handleConfirmSyncKeys(testKeyMsg(k))
+	}
+
+	want := model.SyncOptions{
+		ApplyOutOfSyncOnly:       true,
+		ServerSideApply:          true,
+		Replace:                  true,
+		PruneLast:                true,
+		RespectIgnoreDifferences: true,
+		CreateNamespace:          true,
+		RetryLimit:               syncRetryLimits[2],
+		RetryBackoffDuration:     syncRetryBackoffs[1],
+	}
+	if m.state.Modals.ConfirmSyncOptions != want {
+		t.Fatalf("Expected %+v, got %+v", want, m.state.Modals.ConfirmSyncOptions)
+	}
+}
+
+func TestConfirmSyncKeys_EditRevision(t *testing.T) {
+	m := buildDeleteTestModel(100, 30)
+	m.handleSyncModal()
+
+	m.handleConfirmSyncKeys(testKeyMsg("v"))
+	for _, k := range []string{"v", "1", "x", "backspace"} {
+		m.handleConfirmSyncKeys(testKeyMsg(k))
+	}
+	m.handleConfirmSyncKeys(tea.KeyPressMsg{Code: tea.KeyEnter})
+
+	if m.state.Modals.ConfirmSyncEditingRevision {
+		t.Fatal("Expected enter to finish revision editing")
+	}
+	if got := m.state.Modals.ConfirmSyncOptions.Revision; got != "v1" {
+		t.Fatalf("Expected revision v1, got %q", got)
+	}
+	if m.state.Mode != model.ModeConfirmSync {
+		t.Fatalf("Expected enter while editing not to confirm the sync, got mode %s", m.state.Mode)
+	}
+
+	// Esc while editing restores the previous value and keeps the modal open
+	m.handleConfirmSyncKeys(testKeyMsg("v"))
+	m.handleConfirmSyncKeys(testKeyMsg("2"))
+	m.handleConfirmSyncKeys(testKeyMsg("esc"))
+	if got := m.state.Modals.ConfirmSyncOptions.Revision; got != "v1" {
+		t.Fatalf("Expected esc to restore revision v1, got %q", got)
+	}
+	if m.state.Mode != model.ModeConfirmSync {
+		t.Fatalf("Expected modal to stay open, got mode %s", m.state.Mode)
+	}
+}
+
+func TestAPISyncOptions(t *testing.T) {
+	opts := apiSyncOptions(true, model.SyncOptions{
+		Revision:             "main",
+		ServerSideApply:      true,
+		RetryLimit:           5,
+		RetryBackoffDuration: "10s",
+		RetryBackoffFactor:   3,
+	}, "team-a")
+
+	if !opts.Prune || opts.AppNamespace != "team-a" || opts.Revision != "main" || !opts.ServerSideApply {
+		t.Fatalf("Unexpected options: %+v", opts)
+	}
+	if opts.Retry == nil || opts.Retry.Limit != 5 {
+		t.Fatalf("Expected retry limit 5, got %+v", opts.Retry)
+	}
+	if b := opts.Retry.Backoff; b == nil || b.Duration != "10s" || b.Factor == nil || *b.Factor != 3 || b.MaxDuration != "" {
+		t.Fatalf("Unexpected backoff: %+v", b)
+	}
+
+	// Backoff settings without a retry limit are ignored
+	if opts := apiSyncOptions(false, model.SyncOptions{RetryBackoffDuration: "10s"}, ""); opts.Retry != nil {
+		t.Fatalf("Expected no retry strategy, got %+v", opts.Retry)
+	}
+}
@@ -0,0 +1,156 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+// Test helper to create model with one running and one idle app
+func buildTerminateTestModel() *Model {
+	m := buildDeleteTestModel(100, 30)
+	m.state.Apps[0].OperationPhase = "Running"
+	return m
+}
+
+func TestHandleTerminateModal_OpensForRunningApp(t *testing.T) {
+	m := buildTerminateTestModel()
+
+	teaModel, _ := m.handleTerminateModal("")
+	newModel := teaModel.(*Model)
+
+	if newModel.state.Mode != model.ModeConfirmTerminate {
+		t.Fatalf("Expected mode to be ModeConfirmTerminate, got %s", newModel.state.Mode)
+	}
+	if len(newModel.state.Modals.TerminateApps) != 1 || newModel.state.Modals.TerminateApps[0] != "test-app" {
+		t.Fatalf("Expected TerminateApps to be [test-app], got %v", newModel.state.Modals.TerminateApps)
+	}
+}
+
+func TestHandleTerminateModal_IgnoresIdleApp(t *testing.T) {
+	m := buildTerminateTestModel()
+	m.state.Navigation.SelectedIdx = 1
+
+	teaModel, cmd := m.handleTerminateModal("")
+	newModel := teaModel.(*Model)
+
+	if newModel.state.Mode != model.ModeNormal {
+		t.Fatalf("Expected mode to stay ModeNormal, got %s", newModel.state.Mode)
+	}
+	if cmd == nil {
+		t.Fatal("Expected a status command explaining why nothing happened")
+	}
+	if status, ok := cmd().(model.StatusChangeMsg); !ok || status.Status != "No running operation for zzz-other-app" {
+		t.Fatalf("Unexpected status message: %#v", cmd())
+	}
+}
+
+func TestHandleTerminateModal_SelectionKeepsOnlyRunning(t *testing.T) {
+	m := buildTerminateTestModel()
+	m.state.Selections.SelectedApps = map[string]bool{"test-app": true, "zzz-other-app": true}
+
+	teaModel, _ := m.handleTerminateModal("")
+	newModel := teaModel.(*Model)
+
+	if got := newModel.state.Modals.TerminateApps; len(got) != 1 || got[0] != "test-app" {
+		t.Fatalf("Expected only running app to be terminated, got %v", got)
+	}
+}
+
+func TestTerminateCommand_WithAppArg(t *testing.T) {
+	m := buildTerminateTestModel()
+	m.state.Navigation.SelectedIdx = 1
+
+	teaModel, _ := m.handleTerminateModal("TEST-APP")
+	newModel := teaModel.(*Model)
+
+	if newModel.state.Mode != model.ModeConfirmTerminate {
+		t.Fatalf("Expected mode to be ModeConfirmTerminate, got %s", newModel.state.Mode)
+	}
+}
+
+func TestConfirmTerminateKeys(t *testing.T) {
+	m := buildTerminateTestModel()
+	m.handleTerminateModal("")
+
+	// Enter on Cancel closes the modal
+	m.handleConfirmTerminateKeys(testKeyMsg("l"))
+	m.handleConfirmTerminateKeys(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if m.state.Mode != model.ModeNormal || m.state.Modals.TerminateApps != nil {
+		t.Fatalf("Expected Cancel to close the modal, mode=%s apps=%v", m.state.Mode, m.state.Modals.TerminateApps)
+	}
+
+	// y confirms and starts the request
+	m.handleTerminateModal("")
+	_, cmd := m.handleConfirmTerminateKeys(testKeyMsg("y"))
+	if cmd == nil {
+		t.Fatal("Expected terminate command")
+	}
+	if !m.state.Modals.TerminateLoading {
+		t.Fatal("Expected TerminateLoading to be set")
+	}
+
+	// Keys are ignored while the request is in flight
+	m.handleConfirmTerminateKeys(testKeyMsg("esc"))
+	if m.state.Mode != model.ModeConfirmTerminate {
+		t.Fatalf("Expected modal to stay open while loading, got %s", m.state.Mode)
+	}
+}
+
+func TestOperationTerminatedMsg(t *testing.T) {
+	m := buildTerminateTestModel()
+	m.state.Apps[1].OperationPhase = "Running"
+	m.state.Selections.SelectedApps = map[string]bool{"test-app": true, "zzz-other-app": true}
+	m.handleTerminateModal("")
+	m.state.Modals.TerminateLoading = true
+
+	// Partial failure keeps the modal open for the remaining app
+	m.Update(model.OperationTerminatedMsg{
+		Terminated:  []string{"test-app"},
+		Errors:      []string{"zzz-other-app: permission denied"},
+		SwitchEpoch: m.switchEpoch,
+	})
+	if m.state.Mode != model.ModeConfirmTerminate {
+		t.Fatalf("Expected modal to stay open on error, got %s", m.state.Mode)
+	}
+	if m.state.Modals.TerminateError == nil {
+		t.Fatal("Expected TerminateError to be set")
+	}
+	if got := m.state.Modals.TerminateApps; len(got) != 1 || got[0] != "zzz-other-app" {
+		t.Fatalf("Expected only failed app to remain, got %v", got)
+	}
+	if m.state.Modals.TerminateLoading {
+		t.Fatal("Expected TerminateLoading to be cleared")
+	}
+
+	// Success closes the modal
+	m.Update(model.OperationTerminatedMsg{
+		Terminated:  []string{"zzz-other-app"},
+		SwitchEpoch: m.switchEpoch,
+	})
+	if m.state.Mode != model.ModeNormal {
+		t.Fatalf("Expected modal to close on success, got %s", m.state.Mode)
+	}
+}
+
+func TestRenderAppRow_ShowsEveryTag(t *testing.T) {
+	m := buildTerminateTestModel()
+	m.state.Terminal.Cols = 140
+	label := "prod-eu"
+	m.state.Apps[0].ClusterLabel = &label
+	m.state.Clusters = map[string]model.Cluster{"prod-eu": {Name: "prod-eu", ConnectionStatus: "Failed"}}
+
+	row := stripANSI(m.renderAppRow(m.state.Apps[0], false))
+	if !strings.Contains(row, "test-app"+runningOperationTag+clusterFailedTag) {
+		t.Fatalf("Expected the running and cluster tags together, got %q", row)
+	}
+
+	// A narrow name column keeps the first tags that fit
+	m.state.Terminal.Cols = 60
+	row = stripANSI(m.renderAppRow(m.state.Apps[0], false))
+	if !strings.Contains(row, runningOperationTag) || strings.Contains(row, clusterFailedTag) {
+		t.Fatalf("Expected only the running tag in a narrow row, got %q", row)
+	}
+}
@@ -258,12 +258,12 @@ func main() {
 
 	// Apply saved sort preference from config
 	if argonautConfig.Sort.Field != "" {
-		m.state.UI.Sort = model.SortConfig{
-			Field:     model.SortField(argonautConfig.Sort.Field),
-			Direction: model.SortDirection(argonautConfig.Sort.Direction),
-		}
+		m.state.UI.Sort = argonautConfig.GetSortConfig()
 	}
 
+	// Apply configured apps list columns
+	m.state.UI.Columns = argonautConfig.GetAppColumns()
+
 	// Load Argo CD CLI configuration (matches TypeScript app-orchestrator.ts)
 	cblog.With("component", "app").Info("Loading Argo CD config…")
 
@@ -277,6 +277,7 @@ func main() {
 	// Read the CLI config to populate context names
 	if cliCfg, cfgErr := config.ReadCLIConfigFromPath(effectiveConfigPath); cfgErr == nil {
 		m.state.ContextNames = cliCfg.GetContextNames()
+		m.state.ContextTokens = cliCfg.ContextTokenStatuses(argonautConfig)
 		m.currentContextName = cliCfg.CurrentContext
 	}
 
@@ -284,7 +285,7 @@ func main() {
 	var pfManager *portforward.Manager
 
 	// Try to read the ArgoCD CLI config file
-	server, err := loadArgoConfig(cfgPathFlag)
+	server, err := loadArgoConfig(cfgPathFlag, argonautConfig)
 	if err != nil {
 		// Check if it's a port-forward mode error
 		if pfErr, isPortForward := err.(*PortForwardModeError); isPortForward {
@@ -411,7 +412,7 @@ func setupLogging() {
 }
 
 // loadArgoConfig loads ArgoCD CLI configuration (matches TypeScript app-orchestrator.ts)
-func loadArgoConfig(overridePath string) (*model.Server, error) {
+func loadArgoConfig(overridePath string, argonautConfig *config.ArgonautConfig) (*model.Server, error) {
 	// Read CLI config file (override path if specified)
 	var (
 		cfg *config.ArgoCLIConfig
@@ -437,6 +438,11 @@ func loadArgoConfig(overridePath string) (*model.Server, error) {
 		return nil, &PortForwardModeError{Token: token}
 	}
 
+	// A credential command replaces the token from the CLI config
+	if server := execAuthServer(cfg, argonautConfig, cfg.CurrentContext); server != nil {
+		return server, nil
+	}
+
 	// Convert to server config
 	server, err := cfg.ToServerConfig()
 	if err != nil {
@@ -453,6 +459,25 @@ func loadArgoConfig(overridePath string) (*model.Server, error) {
 	return server, nil
 }
 
+// execAuthServer returns the server of a context that gets its tokens from a credential command
+// configured in the Argonaut config, or nil if the context has none
+func execAuthServer(cfg *config.ArgoCLIConfig, argonautConfig *config.ArgonautConfig, contextName string) *model.Server {
+	if argonautConfig == nil {
+		return nil
+	}
+	execAuth := argonautConfig.GetExecAuth(contextName)
+	if execAuth == nil {
+		return nil
+	}
+	server, err := cfg.LoginServerForContext(contextName)
+	if err != nil {
+		cblog.With("component", "auth").Warn("Credential command configured for unknown context", "context", contextName, "err", err)
+		return nil
+	}
+	server.ExecAuth = execAuth
+	return server
+}
+
 // createFileStatusHandler creates a status handler that logs to file
 func createFileStatusHandler() services.StatusChangeHandler {
 	return func(msg services.StatusMessage) {
@@ -3,6 +3,7 @@ package main
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -14,6 +15,7 @@ import (
 	"github.com/darksworm/argonaut/pkg/api"
 	"github.com/darksworm/argonaut/pkg/autocomplete"
 	"github.com/darksworm/argonaut/pkg/config"
+	"github.com/darksworm/argonaut/pkg/diff"
 	apperrors "github.com/darksworm/argonaut/pkg/errors"
 	"github.com/darksworm/argonaut/pkg/model"
 	"github.com/darksworm/argonaut/pkg/services"
@@ -98,14 +100,58 @@ type Model struct {
 	treeLoading bool
 
 	// List navigators for all scrollable lists
-	listNav     *listnav.ListNavigator // Main list (apps, clusters, namespaces, projects)
-	treeNav     *listnav.ListNavigator // Tree view
-	themeNav    *listnav.ListNavigator // Theme selection modal
-	rollbackNav *listnav.ListNavigator // Rollback history modal
+	listNav         *listnav.ListNavigator // Main list (apps, clusters, namespaces, projects)
+	treeNav         *listnav.ListNavigator // Tree view
+	themeNav        *listnav.ListNavigator // Theme selection modal
+	rollbackNav     *listnav.ListNavigator // Rollback history modal
+	resourceDiffNav *listnav.ListNavigator // Per-resource diff navigator
 
 	// Cleanup callbacks for active tree watchers
 	treeWatchCleanups []func()
 
+	// Pod logs stream: cleanup for the active stream and a monotonic session
+	// counter used to drop batches from streams that have been replaced
+	podLogsCleanup func()
+	podLogsCh      <-chan services.PodLogEvent
+	podLogsSession int
+
+	// Events view session counter; ends refresh loops of closed or replaced views
+	eventsSession int
+	// manifestSession guards manifest loads from viewers that were closed or reloaded
+	manifestSession int
+	// resourceEditSession guards editor results from edits that were discarded
+	resourceEditSession int
+
+	// syncProgressSession guards loads and ticks from sync progress views that were closed or reopened
+	syncProgressSession int
+
+	// ApplicationSets watch: cleanup for the active stream and a session counter
+	// used to drop events and refreshes after a context switch
+	appSetsCleanup func()
+	appSetsSession int
+
+	// repositoriesSession guards repository listings from earlier visits to the repositories view
+	repositoriesSession int
+
+	// clustersSession identifies the background clusters refresh; zero until it is started
+	clustersSession int
+	// permissionsSession identifies the can-i checks of the current context; zero until they are started
+	permissionsSession int
+
+	// projectsSession identifies the background projects refresh; zero until it is started
+	projectsSession int
+	// projectDetailSession guards loads from project detail views that were closed or reloaded
+	projectDetailSession int
+	// appDetailSession guards loads from app detail views that were closed or reloaded
+	appDetailSession int
+
+	// Built-in diff engine results, kept to re-render on resize or layout toggle
+	diffSections   []diff.Section
+	diffSideBySide bool
+
+	// syncRevisionBeforeEdit restores the sync modal's revision when editing is cancelled
+	syncRevisionBeforeEdit string
+
 	// Debug: render counter
 	renderCount int
 
@@ -144,6 +190,10 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if m.treeView != nil {
 			m.treeView.SetSize(m.contentInnerWidth(), msg.Height)
 		}
+		if m.diffSections != nil {
+			m.renderBuiltinDiff()
+		}
+		m.clampLogsOffset()
 		if !m.ready {
 			m.ready = true
 			return m, func() tea.Msg {
@@ -215,6 +265,90 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.treeLoading = false
 		return m, m.consumeTreeEvent()
 
+	// Events view messages
+	case model.EventsLoadedMsg:
+		return m.handleEventsLoaded(msg)
+
+	case eventsRefreshTickMsg:
+		return m.handleEventsRefreshTick(msg)
+
+	case model.ManifestLoadedMsg:
+		return m.handleManifestLoaded(msg)
+
+	case model.ResourceEditedMsg:
+		return m.handleResourceEdited(msg)
+
+	case model.ResourcePatchedMsg:
+		return m.handleResourcePatched(msg)
+
+	case model.SyncProgressLoadedMsg:
+		return m.handleSyncProgressLoaded(msg)
+
+	case syncProgressTickMsg:
+		return m.handleSyncProgressTick(msg)
+
+	// ApplicationSets messages
+	case model.ApplicationSetsLoadedMsg:
+		return m.handleApplicationSetsLoaded(msg)
+
+	case appSetsWatchStartedMsg:
+		return m.handleAppSetsWatchStarted(msg)
+
+	case model.ApplicationSetWatchMsg:
+		return m.handleApplicationSetWatch(msg)
+
+	case appSetsPollTickMsg:
+		return m.handleAppSetsPollTick(msg)
+
+	// Repository messages
+	case model.RepositoriesLoadedMsg:
+		return m.handleRepositoriesLoaded(msg)
+
+	case model.RepositoryRefreshedMsg:
+		return m.handleRepositoryRefreshed(msg)
+
+	// Cluster messages
+	case model.ClustersLoadedMsg:
+		return m.handleClustersLoaded(msg)
+
+	case model.PermissionsLoadedMsg:
+		return m.handlePermissionsLoaded(msg)
+
+	case clustersRefreshTickMsg:
+		return m.handleClustersRefreshTick(msg)
+
+	// Project messages
+	case model.ProjectsLoadedMsg:
+		return m.handleProjectsLoaded(msg)
+
+	case projectsRefreshTickMsg:
+		return m.handleProjectsRefreshTick(msg)
+
+	case tokenExpiryTickMsg:
+		return m.handleTokenExpiryTick(msg)
+
+	case model.ProjectDetailLoadedMsg:
+		return m.handleProjectDetailLoaded(msg)
+
+	case model.AppDetailLoadedMsg:
+		return m.handleAppDetailLoaded(msg)
+
+	case model.AutoSyncUpdatedMsg:
+		return m.handleAutoSyncUpdated(msg)
+
+	case model.ApplicationSetDeletedMsg:
+		return m.handleApplicationSetDeleted(msg)
+
+	// Pod logs stream messages
+	case podLogsStartedMsg:
+		return m.handlePodLogsStarted(msg)
+
+	case model.PodContainersLoadedMsg:
+		return m.handlePodContainersLoaded(msg)
+
+	case model.PodLogLinesMsg:
+		return m.handlePodLogLines(msg)
+
 	// Tree watch started (store cleanup)
 	case treeWatchStartedMsg:
 		if msg.cleanup != nil {
@@ -442,6 +576,18 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			targetMode = model.ModeDefaultViewWarning
 		}
 
+		// A default_view of ApplicationSets needs the sets from the API as well
+		var appSetsCmd tea.Cmd
+		if m.state.Navigation.View == model.ViewApplicationSets {
+			appSetsCmd = m.ensureApplicationSets()
+		}
+		// Sync windows of the apps' projects flag apps whose sync is blocked
+		projectsCmd := m.ensureProjects()
+		// Cluster connection states flag apps on unreachable clusters
+		clustersCmd := m.ensureClusters()
+		// Can-i checks disable operations the user's role does not allow
+		permissionsCmd := m.ensurePermissions()
+
 		// Only start watching if we haven't already started
 		// (watchChan is set when watch starts)
 		if m.watchChan == nil {
@@ -450,11 +596,15 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, tea.Batch(
 				func() tea.Msg { return model.SetModeMsg{Mode: targetMode} },
 				m.startWatchingApplications(),
+				appSetsCmd,
+				projectsCmd,
+				clustersCmd,
+				permissionsCmd,
 			)
 		}
 		// Watch is already running — the batch handler maintains the chain.
 		// Do NOT call consumeWatchEvents() here to avoid duplicate consumers.
-		return m, func() tea.Msg { return model.SetModeMsg{Mode: targetMode} }
+		return m, tea.Batch(func() tea.Msg { return model.SetModeMsg{Mode: targetMode} }, appSetsCmd, projectsCmd, clustersCmd, permissionsCmd)
 
 	case model.AppsBatchUpdateMsg:
 		// Gate by switch epoch — discard entire batch from a previous context
@@ -692,6 +842,12 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.inPager = false
 		return m, nil
 
+	case builtinDiffMsg:
+		return m.handleBuiltinDiff(msg)
+
+	case model.ResourceDiffsLoadedMsg:
+		return m.handleResourceDiffsLoaded(msg)
+
 	case pagerDoneMsg:
 		// Restore pager state
 		m.inPager = false
@@ -712,7 +868,11 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 		}
 
-		// No error, go back to normal mode
+		// No error, go back to the manifest viewer if it opened the pager, else normal mode
+		if m.state.Manifest != nil {
+			m.state.Mode = model.ModeManifest
+			return m, nil
+		}
 		m.state.Mode = model.ModeNormal
 		return m, nil
 
@@ -836,7 +996,10 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				if !found {
 					appObj = model.App{Name: msg.AppName}
 				}
-				return m, tea.Batch(m.startLoadingResourceTree(appObj), m.startWatchingResourceTree(appObj), m.consumeTreeEvent())
+				// Follow the operation in the sync progress view; esc returns to the tree
+				progressCmd := m.openSyncProgress(msg.AppName, false)
+				m.state.SyncProgress.IgnoreBefore = msg.PreviousOperationAt
+				return m, tea.Batch(m.startLoadingResourceTree(appObj), m.startWatchingResourceTree(appObj), m.consumeTreeEvent(), progressCmd)
 			}
 		} else {
 			m.statusService.Set("Sync cancelled")
@@ -970,8 +1133,9 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.treeView.ClearSelection()
 		}
 
-		// Clear modal state and return to normal mode
-		m.state.Mode = model.ModeNormal
+		// Clear modal state and return to normal mode (or the diff navigator)
+		m.markResourceDiffsSynced(m.state.Modals.ResourceSyncTargets)
+		m.state.Mode = m.resourceDiffsReturnMode()
 		m.state.Modals.ResourceSyncAppName = nil
 		m.state.Modals.ResourceSyncTargets = nil
 		m.state.Modals.ResourceSyncError = nil
@@ -999,6 +1163,54 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		return m, nil
 
+	case model.ResourceActionsLoadedMsg:
+		// Gate by switch epoch
+		if msg.SwitchEpoch != m.switchEpoch || m.state.Mode != model.ModeResourceActions {
+			return m, nil
+		}
+		m.state.Modals.ResourceActionsLoading = false
+		if msg.Error != "" {
+			m.state.Modals.ResourceActionError = &msg.Error
+			return m, nil
+		}
+		if len(msg.Permitted) > 0 {
+			m.state.Permissions = m.state.Permissions.Merge(model.Permissions{msg.Object: msg.Permitted})
+		}
+		m.state.Modals.ResourceActions = msg.Actions
+		m.state.Modals.ResourceActionSelected = 0
+		return m, nil
+
+	case model.ResourceActionCompletedMsg:
+		// Gate by switch epoch
+		if msg.SwitchEpoch != m.switchEpoch {
+			return m, nil
+		}
+		if msg.Error != "" {
+			m.statusService.Set(fmt.Sprintf("Action %s on %s/%s failed: %s", msg.Action, msg.Kind, msg.Name, msg.Error))
+			if m.state.Mode == model.ModeResourceActions {
+				// Keep modal open to show error
+				m.state.Modals.ResourceActionRunning = false
+				m.state.Modals.ResourceActionError = &msg.Error
+			}
+			return m, nil
+		}
+		m.statusService.Set(fmt.Sprintf("Ran action %s on %s/%s", msg.Action, msg.Kind, msg.Name))
+		if m.state.Mode == model.ModeResourceActions {
+			m.clearResourceActionsModal()
+		}
+		// Refresh the affected app's resource tree to reflect the action
+		if m.state.Navigation.View == model.ViewTree {
+			appObj := model.App{Name: msg.AppName}
+			for i := range m.state.Apps {
+				if m.state.Apps[i].Name == msg.AppName {
+					appObj = m.state.Apps[i]
+					break
+				}
+			}
+			return m, m.startLoadingResourceTree(appObj)
+		}
+		return m, nil
+
 	case model.ResourceSyncErrorMsg:
 		// Handle resource sync error
 		m.statusService.Set(fmt.Sprintf("Resource sync failed: %s", msg.Error))
@@ -1007,6 +1219,33 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Keep modal open to show error
 		return m, nil
 
+	case model.OperationTerminatedMsg:
+		// Gate by switch epoch
+		if msg.SwitchEpoch != m.switchEpoch {
+			return m, nil
+		}
+		m.state.Modals.TerminateLoading = false
+		if len(msg.Errors) > 0 {
+			// Keep the modal open with the failures; only retry what is left
+			errMsg := strings.Join(msg.Errors, "\n")
+			m.state.Modals.TerminateError = &errMsg
+			remaining := make([]string, 0, len(m.state.Modals.TerminateApps))
+			for _, name := range m.state.Modals.TerminateApps {
+				if !slices.Contains(msg.Terminated, name) {
+					remaining = append(remaining, name)
+				}
+			}
+			m.state.Modals.TerminateApps = remaining
+			return m, nil
+		}
+		if len(msg.Terminated) == 1 {
+			m.statusService.Set(fmt.Sprintf("Terminated operation for %s", msg.Terminated[0]))
+		} else {
+			m.statusService.Set(fmt.Sprintf("Terminated operations for %d app(s)", len(msg.Terminated)))
+		}
+		m.clearTerminateModal()
+		return m, nil
+
 	case model.MultiSyncCompletedMsg:
 		// Gate by switch epoch
 		if msg.SwitchEpoch != m.switchEpoch {
@@ -1174,6 +1413,8 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			Prune:           false,
 			Watch:           true,
 			DryRun:          false,
+			AutoSync:        msg.AutoSync,
+			DisableAutoSync: msg.AutoSync != nil,
 		}
 
 		// Start loading metadata for the first visible chunk (up to 10)
@@ -1207,6 +1448,13 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Handle rollback completion
 		if msg.Success {
 			m.statusService.Set(fmt.Sprintf("Rollback initiated for %s", msg.AppName))
+			if msg.AutoSyncDisabled {
+				for i := range m.state.Apps {
+					if m.state.Apps[i].Name == msg.AppName {
+						m.state.Apps[i].AutoSync = nil
+					}
+				}
+			}
 
 			// Clear rollback state and return to normal mode
 			m.state.Rollback = nil
@@ -1333,6 +1581,9 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case model.ContextSwitchResultMsg:
 		return m.handleContextSwitchResult(msg)
 
+	case model.LoginResultMsg:
+		return m.handleLoginResult(msg)
+
 	case model.QuitMsg:
 		return m, tea.Quit
 
@@ -1435,6 +1686,7 @@ func (m *Model) applyBatchAppUpdate(upd model.AppUpdatedMsg) {
 	if !found {
 		m.state.Apps = append(m.state.Apps, upd.App)
 	}
+	m.updateSyncProgress(upd.App.Name, upd.Operation)
 	// Update tree view sync statuses
 	if m.treeView != nil && m.state.Navigation.View == model.ViewTree && len(upd.ResourcesJSON) > 0 {
 		var resources []api.ResourceStatus
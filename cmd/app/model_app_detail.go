@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	tea "charm.land/bubbletea/v2"
+	cblog "github.com/charmbracelet/log"
+	"github.com/darksworm/argonaut/pkg/api"
+	appcontext "github.com/darksworm/argonaut/pkg/context"
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+// handleOpenAppDetail opens the detail view for the app under the cursor (apps view)
+func (m *Model) handleOpenAppDetail() (tea.Model, tea.Cmd) {
+	items := m.getVisibleItemsForCurrentView()
+	if len(items) == 0 || m.state.Navigation.SelectedIdx >= len(items) {
+		return m, nil
+	}
+	app, ok := items[m.state.Navigation.SelectedIdx].(model.App)
+	if !ok {
+		return m, nil
+	}
+	return m, m.openAppDetail(app.Name, app.AppNamespace)
+}
+
+// openAppDetail switches to the app detail view and fetches the application
+func (m *Model) openAppDetail(name string, appNamespace *string) tea.Cmd {
+	m.appDetailSession++
+	m.state.AppDetail = &model.AppDetailState{
+		AppName:      name,
+		AppNamespace: appNamespace,
+		Loading:      true,
+		Session:      m.appDetailSession,
+	}
+	m.state.Mode = model.ModeAppDetail
+	return m.loadAppDetail(name, appNamespace, m.appDetailSession)
+}
+
+// loadAppDetail fetches the full application object
+func (m *Model) loadAppDetail(name string, appNamespace *string, session int) tea.Cmd {
+	epoch := m.switchEpoch // capture at call time
+	return func() tea.Msg {
+		if m.state.Server == nil {
+			return model.AppDetailLoadedMsg{Session: session, Err: fmt.Errorf("no server configured"), SwitchEpoch: epoch}
+		}
+		ctx, cancel := appcontext.WithAPITimeout(context.Background())
+		defer cancel()
+
+		appService := api.NewApplicationService(m.state.Server)
+		app, err := appService.GetApplication(ctx, name, appNamespace)
+		if err != nil {
+			cblog.With("component", "app-detail").Error("Failed to load application", "app", name, "err", err)
+			return model.AppDetailLoadedMsg{Session: session, Err: err, SwitchEpoch: epoch}
+		}
+		detail := appService.ConvertToAppDetail(*app)
+		return model.AppDetailLoadedMsg{Session: session, Detail: &detail, SwitchEpoch: epoch}
+	}
+}
+
+// handleAppDetailLoaded stores the fetched application
+func (m *Model) handleAppDetailLoaded(msg model.AppDetailLoadedMsg) (tea.Model, tea.Cmd) {
+	state := m.state.AppDetail
+	if msg.SwitchEpoch != m.switchEpoch || state == nil || state.Session != msg.Session {
+		return m, nil
+	}
+	state.Loading = false
+	if msg.Err != nil {
+		state.Error = extractUserFriendlyError(msg.Err)
+		return m, nil
+	}
+	state.Error = ""
+	state.Detail = msg.Detail
+	return m, nil
+}
+
+// handleAppDetailKeys handles input in the app detail view
+func (m *Model) handleAppDetailKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	state := m.state.AppDetail
+	if state == nil {
+		m.state.Mode = model.ModeNormal
+		return m, nil
+	}
+	switch msg.String() {
+	case "q", "esc":
+		m.state.AppDetail = nil
+		m.state.Mode = model.ModeNormal
+		return m, nil
+	case "r":
+		return m, m.openAppDetail(state.AppName, state.AppNamespace)
+	}
+	return m, nil
+}
+
+// appDetailPageSize returns the number of lines visible in the app detail view
+func (m *Model) appDetailPageSize() int {
+	// title + status + content border + main container padding
+	overhead := 5
+	return max(3, m.state.Terminal.Rows-overhead)
+}
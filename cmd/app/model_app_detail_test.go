@@ -0,0 +1,55 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+func TestAppDetail_OpenAndRender(t *testing.T) {
+	m := buildDeleteTestModel(120, 40)
+	m.state.Navigation.View = model.ViewApps
+	m.state.Navigation.SelectedIdx = 0
+
+	m.handleKeyMsg(testKeyMsg("i"))
+	state := m.state.AppDetail
+	if m.state.Mode != model.ModeAppDetail || state == nil || state.AppName != "test-app" {
+		t.Fatalf("Expected app detail for test-app, got mode %s", m.state.Mode)
+	}
+
+	project := "test-project"
+	m.Update(model.AppDetailLoadedMsg{Session: state.Session, SwitchEpoch: m.switchEpoch, Detail: &model.AppDetail{
+		App:                  model.App{Name: "test-app", Sync: "Synced", Health: "Degraded", Project: &project, AutoSync: &model.AutoSync{Prune: true}},
+		DestinationName:      "in-cluster",
+		DestinationNamespace: "payments",
+		MultiSource:          true,
+		Sources: []model.AppSource{
+			{RepoURL: "https://github.com/example/apps", Path: "apps/test", TargetRevision: "main"},
+			{RepoURL: "https://charts.example.com", Chart: "redis", TargetRevision: "1.2.3"},
+		},
+		SyncedRevisions: []string{"0123456789abcdef0123456789abcdef01234567", "1.2.3"},
+		HealthMessage:   "Deployment has 0 ready replicas",
+		Conditions:      []model.AppCondition{{Type: "SyncError", Message: "failed to sync"}},
+		Operation: &model.SyncOperation{
+			Phase: "Failed", StartedAt: time.Now().Add(-5 * time.Minute), InitiatedBy: "alice",
+			Message: "one or more objects failed to apply",
+		},
+	}})
+
+	out := stripANSI(m.renderAppDetailView())
+	for _, want := range []string{
+		"in-cluster", "payments", "SOURCES (2)", "chart redis", "synced 0123456", "SyncError", "failed to sync",
+		"Deployment has 0 ready replicas", "prune on", "self-heal off", "Failed", "5m ago", "by alice",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected %q in app detail, got:\n%s", want, out)
+		}
+	}
+
+	m.handleKeyMsg(testKeyMsg("esc"))
+	if m.state.Mode != model.ModeNormal || m.state.AppDetail != nil {
+		t.Fatalf("Expected esc to close the app detail, got mode %s", m.state.Mode)
+	}
+}
@@ -0,0 +1,331 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	cblog "github.com/charmbracelet/log"
+	"github.com/darksworm/argonaut/pkg/api"
+	appcontext "github.com/darksworm/argonaut/pkg/context"
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+// appSetsPollInterval is how often ApplicationSets are re-listed when the watch stream is unavailable
+const appSetsPollInterval = 30 * time.Second
+
+// appSetsPollTickMsg re-lists ApplicationSets while the view is open and the watch is down
+type appSetsPollTickMsg struct{ session int }
+
+// appSetsStream carries watch events; err is set before events is closed
+type appSetsStream struct {
+	events chan api.ApplicationSetWatchEvent
+	err    error
+}
+
+// appSetsWatchStartedMsg is delivered once the ApplicationSets watch goroutine is running
+type appSetsWatchStartedMsg struct {
+	session int
+	stream  *appSetsStream
+	cleanup func()
+	epoch   int
+}
+
+// ensureApplicationSets loads and watches ApplicationSets the first time the view is opened.
+// If the watch is unavailable, it (re)starts polling instead.
+func (m *Model) ensureApplicationSets() tea.Cmd {
+	sets := m.state.ApplicationSets
+	if sets == nil {
+		m.appSetsSession++
+		m.state.ApplicationSets = &model.ApplicationSetsState{
+			Items:    map[string]model.ApplicationSet{},
+			Loading:  true,
+			Watching: true,
+			Session:  m.appSetsSession,
+		}
+		return tea.Batch(m.loadApplicationSets(m.appSetsSession), m.startWatchingApplicationSets(m.appSetsSession))
+	}
+	if sets.Watching || sets.Polling {
+		return nil
+	}
+	sets.Polling = true
+	sets.Loading = true
+	return tea.Batch(m.loadApplicationSets(sets.Session), scheduleAppSetsPoll(sets.Session))
+}
+
+// loadApplicationSets lists all ApplicationSets
+func (m *Model) loadApplicationSets(session int) tea.Cmd {
+	epoch := m.switchEpoch // capture at call time
+	return func() tea.Msg {
+		if m.state.Server == nil {
+			return model.ApplicationSetsLoadedMsg{Session: session, Err: fmt.Errorf("no server configured"), SwitchEpoch: epoch}
+		}
+		ctx, cancel := appcontext.WithAPITimeout(context.Background())
+		defer cancel()
+
+		sets, err := api.NewApplicationSetService(m.state.Server).ListApplicationSets(ctx)
+		if err != nil {
+			cblog.With("component", "appsets").Error("Failed to list ApplicationSets", "err", err)
+			return model.ApplicationSetsLoadedMsg{Session: session, Err: err, SwitchEpoch: epoch}
+		}
+		items := make([]model.ApplicationSet, 0, len(sets))
+		for _, s := range sets {
+			items = append(items, api.ConvertToApplicationSet(s))
+		}
+		return model.ApplicationSetsLoadedMsg{Session: session, Items: items, SwitchEpoch: epoch}
+	}
+}
+
+// startWatchingApplicationSets opens the ApplicationSets watch stream
+func (m *Model) startWatchingApplicationSets(session int) tea.Cmd {
+	epoch := m.switchEpoch // capture at call time
+	return func() tea.Msg {
+		if m.state.Server == nil {
+			return model.ApplicationSetWatchMsg{Session: session, Done: true, Err: fmt.Errorf("no server configured"), SwitchEpoch: epoch}
+		}
+		ctx, cancel := appcontext.WithCancel(context.Background())
+		stream := &appSetsStream{events: make(chan api.ApplicationSetWatchEvent, 32)}
+		svc := api.NewApplicationSetService(m.state.Server)
+		go func() {
+			stream.err = svc.WatchApplicationSets(ctx, stream.events)
+			close(stream.events)
+		}()
+		return appSetsWatchStartedMsg{session: session, stream: stream, cleanup: cancel, epoch: epoch}
+	}
+}
+
+// consumeAppSetsWatch waits for the next watch event
+func consumeAppSetsWatch(session, epoch int, stream *appSetsStream) tea.Cmd {
+	return func() tea.Msg {
+		ev, ok := <-stream.events
+		if !ok {
+			return model.ApplicationSetWatchMsg{Session: session, Done: true, Err: stream.err, SwitchEpoch: epoch}
+		}
+		return model.ApplicationSetWatchMsg{Session: session, Type: ev.Type, Set: api.ConvertToApplicationSet(ev.ApplicationSet), SwitchEpoch: epoch}
+	}
+}
+
+// handleAppSetsWatchStarted stores the stream cleanup and starts consuming events
+func (m *Model) handleAppSetsWatchStarted(msg appSetsWatchStartedMsg) (tea.Model, tea.Cmd) {
+	if msg.epoch != m.switchEpoch || m.state.ApplicationSets == nil || m.state.ApplicationSets.Session != msg.session {
+		msg.cleanup()
+		return m, nil
+	}
+	m.cleanupAppSetsWatcher()
+	m.appSetsCleanup = msg.cleanup
+	return m, consumeAppSetsWatch(msg.session, msg.epoch, msg.stream)
+}
+
+// handleApplicationSetsLoaded replaces the ApplicationSets with a fresh listing
+func (m *Model) handleApplicationSetsLoaded(msg model.ApplicationSetsLoadedMsg) (tea.Model, tea.Cmd) {
+	sets := m.state.ApplicationSets
+	if msg.SwitchEpoch != m.switchEpoch || sets == nil || sets.Session != msg.Session {
+		return m, nil
+	}
+	sets.Loading = false
+	if msg.Err != nil {
+		sets.Error = extractUserFriendlyError(msg.Err)
+		m.statusService.Error("Failed to list ApplicationSets: " + sets.Error)
+		return m, nil
+	}
+	sets.Error = ""
+	sets.Items = make(map[string]model.ApplicationSet, len(msg.Items))
+	for _, s := range msg.Items {
+		sets.Items[s.Name] = s
+	}
+	return m, nil
+}
+
+// handleApplicationSetWatch applies a watch event, or falls back to polling when the stream ends
+func (m *Model) handleApplicationSetWatch(msg model.ApplicationSetWatchMsg) (tea.Model, tea.Cmd) {
+	sets := m.state.ApplicationSets
+	if msg.SwitchEpoch != m.switchEpoch || sets == nil || sets.Session != msg.Session {
+		return m, nil
+	}
+	if msg.Done {
+		m.cleanupAppSetsWatcher()
+		sets.Watching = false
+		if msg.Err != nil {
+			cblog.With("component", "appsets").Warn("ApplicationSet watch unavailable, polling instead", "err", msg.Err)
+		}
+		if m.state.Navigation.View != model.ViewApplicationSets {
+			// Polling starts when the view is opened again
+			return m, nil
+		}
+		sets.Polling = true
+		return m, scheduleAppSetsPoll(sets.Session)
+	}
+	switch msg.Type {
+	case "DELETED":
+		delete(sets.Items, msg.Set.Name)
+	default:
+		sets.Items[msg.Set.Name] = msg.Set
+	}
+	return m, nil
+}
+
+// scheduleAppSetsPoll queues the next re-list for a session
+func scheduleAppSetsPoll(session int) tea.Cmd {
+	return tea.Tick(appSetsPollInterval, func(time.Time) tea.Msg {
+		return appSetsPollTickMsg{session: session}
+	})
+}
+
+// handleAppSetsPollTick re-lists ApplicationSets while their view is open
+func (m *Model) handleAppSetsPollTick(msg appSetsPollTickMsg) (tea.Model, tea.Cmd) {
+	sets := m.state.ApplicationSets
+	if sets == nil || sets.Session != msg.session || !sets.Polling {
+		return m, nil
+	}
+	if m.state.Navigation.View != model.ViewApplicationSets {
+		// Stop polling in the background; ensureApplicationSets restarts it
+		sets.Polling = false
+		return m, nil
+	}
+	return m, tea.Batch(m.loadApplicationSets(sets.Session), scheduleAppSetsPoll(sets.Session))
+}
+
+// applicationSetNames returns the names shown in the ApplicationSets view: every set from
+// the API plus any set that only appears as an app owner (e.g. when listing sets is forbidden)
+func (m *Model) applicationSetNames() []string {
+	seen := make(map[string]bool)
+	var names []string
+	if idx := m.state.Index; idx != nil {
+		for _, name := range idx.ApplicationSets {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	if sets := m.state.ApplicationSets; sets != nil {
+		for name := range sets.Items {
+			if !seen[name] {
+				names = append(names, name)
+			}
+		}
+	}
+	sortStrings(names)
+	return names
+}
+
+// appSetHealthRollup counts the health of the apps generated by an ApplicationSet. Live apps
+// from the watch are preferred; status.resources covers sets whose apps are not visible.
+func (m *Model) appSetHealthRollup(name string) map[string]int {
+	counts := map[string]int{}
+	if idx := m.state.Index; idx != nil {
+		for _, i := range idx.ByApplicationSet[name] {
+			if i < len(m.state.Apps) {
+				counts[m.state.Apps[i].Health]++
+			}
+		}
+	}
+	if len(counts) > 0 || m.state.ApplicationSets == nil {
+		return counts
+	}
+	for _, r := range m.state.ApplicationSets.Items[name].Resources {
+		health := r.Health
+		if health == "" {
+			health = "Unknown"
+		}
+		counts[health]++
+	}
+	return counts
+}
+
+// handleAppSetDelete opens the delete confirmation for the ApplicationSet under the cursor
+func (m *Model) handleAppSetDelete() (tea.Model, tea.Cmd) {
+	items := m.getVisibleItemsForCurrentView()
+	if len(items) == 0 || m.state.Navigation.SelectedIdx >= len(items) {
+		return m, nil
+	}
+	name, _ := items[m.state.Navigation.SelectedIdx].(string)
+	set, ok := model.ApplicationSet{}, false
+	if m.state.ApplicationSets != nil {
+		set, ok = m.state.ApplicationSets.Items[name]
+	}
+	if !ok {
+		return m, func() tea.Msg {
+			return model.StatusChangeMsg{Status: "ApplicationSet " + name + " is not loaded from the API"}
+		}
+	}
+	m.state.Modals.AppSetDeleteName = set.Name
+	m.state.Modals.AppSetDeleteNamespace = set.Namespace
+	m.state.Modals.AppSetDeleteSelected = 1 // default to Cancel
+	m.state.Modals.AppSetDeleteLoading = false
+	m.state.Modals.AppSetDeleteError = nil
+	m.state.Mode = model.ModeConfirmAppSetDelete
+	return m, nil
+}
+
+// handleConfirmAppSetDeleteKeys handles input in the ApplicationSet delete confirmation
+func (m *Model) handleConfirmAppSetDeleteKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.state.Modals.AppSetDeleteLoading {
+		return m, nil
+	}
+	switch msg.String() {
+	case "esc", "q":
+		m.clearAppSetDeleteModal()
+	case "left", "h":
+		m.state.Modals.AppSetDeleteSelected = 0
+	case "right", "l":
+		m.state.Modals.AppSetDeleteSelected = 1
+	case "enter":
+		if m.state.Modals.AppSetDeleteSelected == 1 {
+			m.clearAppSetDeleteModal()
+			return m, nil
+		}
+		fallthrough
+	case "y":
+		m.state.Modals.AppSetDeleteLoading = true
+		m.state.Modals.AppSetDeleteError = nil
+		return m, m.deleteApplicationSet(m.state.Modals.AppSetDeleteName, m.state.Modals.AppSetDeleteNamespace)
+	}
+	return m, nil
+}
+
+// clearAppSetDeleteModal closes the ApplicationSet delete confirmation and resets its state
+func (m *Model) clearAppSetDeleteModal() {
+	m.state.Mode = model.ModeNormal
+	m.state.Modals.AppSetDeleteName = ""
+	m.state.Modals.AppSetDeleteNamespace = ""
+	m.state.Modals.AppSetDeleteSelected = 0
+	m.state.Modals.AppSetDeleteLoading = false
+	m.state.Modals.AppSetDeleteError = nil
+}
+
+// deleteApplicationSet deletes an ApplicationSet
+func (m *Model) deleteApplicationSet(name, namespace string) tea.Cmd {
+	epoch := m.switchEpoch // capture at call time
+	return func() tea.Msg {
+		if m.state.Server == nil {
+			return model.ApplicationSetDeletedMsg{Name: name, Err: fmt.Errorf("no server configured"), SwitchEpoch: epoch}
+		}
+		ctx, cancel := appcontext.WithAPITimeout(context.Background())
+		defer cancel()
+
+		err := api.NewApplicationSetService(m.state.Server).DeleteApplicationSet(ctx, name, namespace)
+		if err != nil {
+			cblog.With("component", "appsets").Error("Failed to delete ApplicationSet", "appset", name, "err", err)
+		}
+		return model.ApplicationSetDeletedMsg{Name: name, Err: err, SwitchEpoch: epoch}
+	}
+}
+
+// handleApplicationSetDeleted closes the modal on success or shows the error in it
+func (m *Model) handleApplicationSetDeleted(msg model.ApplicationSetDeletedMsg) (tea.Model, tea.Cmd) {
+	if msg.SwitchEpoch != m.switchEpoch {
+		return m, nil
+	}
+	if msg.Err != nil {
+		errMsg := extractUserFriendlyError(msg.Err)
+		m.state.Modals.AppSetDeleteLoading = false
+		m.state.Modals.AppSetDeleteError = &errMsg
+		return m, nil
+	}
+	m.clearAppSetDeleteModal()
+	if m.state.ApplicationSets != nil {
+		delete(m.state.ApplicationSets.Items, msg.Name)
+	}
+	m.statusService.Set("Deleted ApplicationSet " + msg.Name)
+	return m, nil
+}
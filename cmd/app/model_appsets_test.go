@@ -0,0 +1,127 @@
+package main
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+func buildAppSetsTestModel() *Model {
+	m := buildDeleteTestModel(140, 30)
+	owner := "web-set"
+	m.state.Apps = []model.App{
+		{Name: "web-a", Health: "Healthy", ApplicationSet: &owner},
+		{Name: "web-b", Health: "Degraded", ApplicationSet: &owner},
+	}
+	m.state.Index = model.BuildAppIndex(m.state.Apps)
+	m.state.Navigation.View = model.ViewApplicationSets
+	m.ensureApplicationSets()
+	return m
+}
+
+func TestApplicationSets_ListIncludesSetsWithoutApps(t *testing.T) {
+	m := buildAppSetsTestModel()
+	session := m.state.ApplicationSets.Session
+
+	m.Update(model.ApplicationSetsLoadedMsg{Session: session, SwitchEpoch: m.switchEpoch, Items: []model.ApplicationSet{
+		{Name: "web-set", SyncPolicy: "sync"},
+		{Name: "broken-set", SyncPolicy: "sync", Conditions: []model.ApplicationSetCondition{
+			{Type: "ErrorOccurred", Status: "True", Message: "couldn't generate applications"},
+		}},
+	}})
+
+	items := m.getVisibleItems()
+	if len(items) != 2 || items[0] != "broken-set" || items[1] != "web-set" {
+		t.Fatalf("Expected both ApplicationSets sorted by name, got %v", items)
+	}
+
+	out := m.renderListView(20)
+	if !strings.Contains(out, "Error") || !strings.Contains(out, "couldn't generate") {
+		t.Fatalf("Expected the generator error to be shown, got:\n%s", out)
+	}
+}
+
+func TestApplicationSets_WatchEvents(t *testing.T) {
+	m := buildAppSetsTestModel()
+	session := m.state.ApplicationSets.Session
+
+	m.Update(model.ApplicationSetWatchMsg{Session: session, Type: "ADDED", Set: model.ApplicationSet{Name: "new-set"}, SwitchEpoch: m.switchEpoch})
+	if _, ok := m.state.ApplicationSets.Items["new-set"]; !ok {
+		t.Fatal("Expected ADDED event to add the ApplicationSet")
+	}
+	m.Update(model.ApplicationSetWatchMsg{Session: session - 1, Type: "DELETED", Set: model.ApplicationSet{Name: "new-set"}, SwitchEpoch: m.switchEpoch})
+	if _, ok := m.state.ApplicationSets.Items["new-set"]; !ok {
+		t.Fatal("Expected events from a stale session to be ignored")
+	}
+	m.Update(model.ApplicationSetWatchMsg{Session: session, Type: "DELETED", Set: model.ApplicationSet{Name: "new-set"}, SwitchEpoch: m.switchEpoch})
+	if _, ok := m.state.ApplicationSets.Items["new-set"]; ok {
+		t.Fatal("Expected DELETED event to remove the ApplicationSet")
+	}
+}
+
+func TestApplicationSets_FallsBackToPolling(t *testing.T) {
+	m := buildAppSetsTestModel()
+	session := m.state.ApplicationSets.Session
+
+	_, cmd := m.Update(model.ApplicationSetWatchMsg{Session: session, Done: true, Err: errors.New("404"), SwitchEpoch: m.switchEpoch})
+	if m.state.ApplicationSets.Watching || !m.state.ApplicationSets.Polling || cmd == nil {
+		t.Fatalf("Expected polling after the watch failed, got %+v", m.state.ApplicationSets)
+	}
+
+	// Leaving the view stops polling; coming back restarts it
+	m.state.Navigation.View = model.ViewApps
+	if _, cmd := m.handleAppSetsPollTick(appSetsPollTickMsg{session: session}); cmd != nil || m.state.ApplicationSets.Polling {
+		t.Fatal("Expected polling to stop outside the ApplicationSets view")
+	}
+	if cmd := m.ensureApplicationSets(); cmd == nil || !m.state.ApplicationSets.Polling {
+		t.Fatal("Expected polling to restart when the view is opened again")
+	}
+}
+
+func TestAppSetHealthRollup(t *testing.T) {
+	m := buildAppSetsTestModel()
+	m.state.ApplicationSets.Items["remote-set"] = model.ApplicationSet{Name: "remote-set", Resources: []model.ApplicationSetResource{
+		{Name: "x", Health: "Healthy"}, {Name: "y"},
+	}}
+
+	live := m.appSetHealthRollup("web-set")
+	if live["Healthy"] != 1 || live["Degraded"] != 1 {
+		t.Fatalf("Expected rollup from live apps, got %v", live)
+	}
+	// Sets whose apps are not in the app list fall back to status.resources
+	remote := m.appSetHealthRollup("remote-set")
+	if remote["Healthy"] != 1 || remote["Unknown"] != 1 {
+		t.Fatalf("Expected rollup from status.resources, got %v", remote)
+	}
+}
+
+func TestAppSetDelete_ConfirmFlow(t *testing.T) {
+	m := buildAppSetsTestModel()
+	m.state.ApplicationSets.Items["web-set"] = model.ApplicationSet{Name: "web-set", Namespace: "argocd"}
+
+	m.handleKeyMsg(testKeyMsg("ctrl+d"))
+	if m.state.Mode != model.ModeConfirmAppSetDelete || m.state.Modals.AppSetDeleteName != "web-set" {
+		t.Fatalf("Expected delete confirmation for web-set, got mode %s", m.state.Mode)
+	}
+	if m.state.Modals.AppSetDeleteSelected != 1 {
+		t.Fatal("Expected Cancel to be selected by default")
+	}
+	if out := m.renderConfirmAppSetDeleteModal(); !strings.Contains(out, "deletes its 2 generated app(s)") {
+		t.Fatalf("Expected a warning about generated apps, got:\n%s", out)
+	}
+
+	m.handleKeyMsg(testKeyMsg("y"))
+	if !m.state.Modals.AppSetDeleteLoading {
+		t.Fatal("Expected y to start the deletion")
+	}
+
+	m.Update(model.ApplicationSetDeletedMsg{Name: "web-set", SwitchEpoch: m.switchEpoch})
+	if m.state.Mode != model.ModeNormal {
+		t.Fatalf("Expected modal to close, got mode %s", m.state.Mode)
+	}
+	if _, ok := m.state.ApplicationSets.Items["web-set"]; ok {
+		t.Fatal("Expected the deleted ApplicationSet to be removed")
+	}
+}
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	cblog "github.com/charmbracelet/log"
+	"github.com/darksworm/argonaut/pkg/api"
+	appcontext "github.com/darksworm/argonaut/pkg/context"
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+// autoSyncTargets returns the checked apps, or the app under the cursor (apps view)
+func (m *Model) autoSyncTargets() []model.App {
+	var apps []model.App
+	if len(m.selectedAppNames()) > 0 {
+		for _, app := range m.state.Apps {
+			if m.state.Selections.SelectedApps[app.Name] {
+				apps = append(apps, app)
+			}
+		}
+		sort.Slice(apps, func(i, j int) bool { return apps[i].Name < apps[j].Name })
+		return apps
+	}
+	items := m.getVisibleItemsForCurrentView()
+	if len(items) > 0 && m.state.Navigation.SelectedIdx < len(items) {
+		if app, ok := items[m.state.Navigation.SelectedIdx].(model.App); ok {
+			apps = append(apps, app)
+		}
+	}
+	return apps
+}
+
+// handleAutoSyncCommand shows or patches the automated sync policy of the multi-selection
+// or the app under the cursor
+func (m *Model) handleAutoSyncCommand(args []string) (tea.Model, tea.Cmd) {
+	status := func(s string) tea.Cmd { return func() tea.Msg { return model.StatusChangeMsg{Status: s} } }
+	if m.state.Navigation.View != model.ViewApps {
+		return m, status("Navigate to apps view to change automated sync")
+	}
+	targets := m.autoSyncTargets()
+	if len(targets) == 0 {
+		return m, status("No app selected")
+	}
+
+	if len(args) == 0 {
+		if len(targets) == 1 {
+			return m, status(fmt.Sprintf("Auto-sync for %s: %s", targets[0].Name, model.DescribeAutoSync(targets[0].AutoSync)))
+		}
+		enabled := 0
+		for _, app := range targets {
+			if app.AutoSync != nil {
+				enabled++
+			}
+		}
+		return m, status(fmt.Sprintf("Auto-sync enabled for %d of %d selected app(s)", enabled, len(targets)))
+	}
+
+	change, err := model.ParseAutoSyncArgs(args)
+	if err != nil {
+		return m, status("Invalid :autosync: " + err.Error())
+	}
+
+	// Only patch apps whose policy actually changes
+	policies := make(map[string]*model.AutoSync)
+	var names, skipped []string
+	for _, app := range targets {
+		next, err := change.Apply(app.AutoSync)
+		if err != nil {
+			skipped = append(skipped, app.Name)
+			continue
+		}
+		if autoSyncEqual(app.AutoSync, next) {
+			continue
+		}
+		policies[app.Name] = next
+		names = append(names, app.Name)
+	}
+	if len(names) == 0 {
+		if len(skipped) > 0 {
+			return m, status(fmt.Sprintf("Automated sync is disabled for %s; enable it first", strings.Join(skipped, ", ")))
+		}
+		return m, status("Auto-sync already up to date")
+	}
+	if cmd := m.operationDenied(model.OpUpdate, names...); cmd != nil {
+		return m, cmd
+	}
+	cblog.With("component", "autosync").Info("Patching automated sync", "apps", names, "args", args)
+	return m, m.patchAutoSync(names, policies)
+}
+
+// patchAutoSync sets the automated sync policy of each given app
+func (m *Model) patchAutoSync(appNames []string, policies map[string]*model.AutoSync) tea.Cmd {
+	if m.state.Server == nil {
+		return func() tea.Msg {
+			return model.AutoSyncUpdatedMsg{Errors: []string{"No server configured"}}
+		}
+	}
+
+	// Resolve app namespaces up front; the cmd runs outside the update loop
+	namespaces := make(map[string]*string, len(appNames))
+	for _, app := range m.state.Apps {
+		namespaces[app.Name] = app.AppNamespace
+	}
+
+	epoch := m.switchEpoch // capture at call time
+	return func() tea.Msg {
+		appService := api.NewApplicationService(m.state.Server)
+
+		updated := make(map[string]*model.AutoSync, len(appNames))
+		var failures []string
+		for _, name := range appNames {
+			ctx, cancel := appcontext.WithAPITimeout(context.Background())
+			err := appService.PatchApplication(ctx, name, namespaces[name], api.AutoSyncPatch(policies[name]))
+			cancel()
+			if err != nil {
+				cblog.With("component", "autosync").Error("Patching automated sync failed", "app", name, "err", err)
+				failures = append(failures, fmt.Sprintf("%s: %s", name, extractUserFriendlyError(err)))
+				continue
+			}
+			updated[name] = policies[name]
+		}
+		return model.AutoSyncUpdatedMsg{Updated: updated, Errors: failures, SwitchEpoch: epoch}
+	}
+}
+
+// handleAutoSyncUpdated applies the patched policies without waiting for the watch stream
+func (m *Model) handleAutoSyncUpdated(msg model.AutoSyncUpdatedMsg) (tea.Model, tea.Cmd) {
+	if msg.SwitchEpoch != m.switchEpoch {
+		return m, nil
+	}
+	for i := range m.state.Apps {
+		if policy, ok := msg.Updated[m.state.Apps[i].Name]; ok {
+			m.state.Apps[i].AutoSync = policy
+		}
+	}
+	if detail := m.state.AppDetail; detail != nil && detail.Detail != nil {
+		if policy, ok := msg.Updated[detail.AppName]; ok {
+			detail.Detail.App.AutoSync = policy
+		}
+	}
+
+	var status string
+	switch {
+	case len(msg.Updated) == 1:
+		for name, policy := range msg.Updated {
+			status = fmt.Sprintf("Auto-sync for %s: %s", name, model.DescribeAutoSync(policy))
+		}
+	case len(msg.Updated) > 1:
+		status = fmt.Sprintf("Updated auto-sync for %d app(s)", len(msg.Updated))
+	}
+	if len(msg.Errors) > 0 {
+		if status != "" {
+			status += "; "
+		}
+		status += "failed: " + strings.Join(msg.Errors, ", ")
+	}
+	return m, func() tea.Msg { return model.StatusChangeMsg{Status: status} }
+}
+
+// autoSyncEqual reports whether two policies are the same; nil means disabled
+func autoSyncEqual(a, b *model.AutoSync) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
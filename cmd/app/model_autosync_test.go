@@ -0,0 +1,107 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+// autoSyncTestServer records the merge patch sent for each app
+func autoSyncTestServer(t *testing.T, patches map[string]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Name  string `json:"name"`
+			Patch string `json:"patch"`
+		}
+		if r.Method == http.MethodPatch {
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("Failed to decode body: %v", err)
+			}
+			patches[body.Name] = body.Patch
+		}
+		w.Write([]byte("{}"))
+	}))
+}
+
+func TestAutoSyncCommand_PatchesSelection(t *testing.T) {
+	patches := make(map[string]string)
+	server := autoSyncTestServer(t, patches)
+	defer server.Close()
+
+	m := buildDeleteTestModel(120, 30)
+	m.state.Server = &model.Server{BaseURL: server.URL, Token: "test-token"}
+	m.state.Apps[0].AutoSync = &model.AutoSync{Prune: true}
+	m.state.Selections.SelectedApps["test-app"] = true
+	m.state.Selections.SelectedApps["zzz-other-app"] = true
+
+	// Options only change on apps with automated sync enabled
+	m.state.Mode = model.ModeCommand
+	m.inputComponents.SetCommandValue("autosync selfheal on")
+	_, cmd := m.handleEnhancedCommandModeKeys(tea.KeyPressMsg{Code: tea.KeyEnter})
+	msg, ok := cmd().(model.AutoSyncUpdatedMsg)
+	if !ok || len(patches) != 1 || patches["test-app"] != `{"spec":{"syncPolicy":{"automated":{"prune":true,"selfHeal":true,"allowEmpty":false}}}}` {
+		t.Fatalf("Expected only test-app to be patched, got %v", patches)
+	}
+	_, cmd = m.Update(msg)
+	if policy := m.state.Apps[0].AutoSync; policy == nil || !policy.SelfHeal || !policy.Prune {
+		t.Fatalf("Expected the new policy to be applied, got %+v", policy)
+	}
+	if status := cmd().(model.StatusChangeMsg).Status; !strings.Contains(status, "self-heal on") {
+		t.Errorf("Unexpected status %q", status)
+	}
+
+	m.inputComponents.SetCommandValue("autosync off")
+	m.state.Mode = model.ModeCommand
+	_, cmd = m.handleEnhancedCommandModeKeys(tea.KeyPressMsg{Code: tea.KeyEnter})
+	m.Update(cmd())
+	if patches["test-app"] != `{"spec":{"syncPolicy":{"automated":null}}}` || m.state.Apps[0].AutoSync != nil {
+		t.Fatalf("Expected automated sync to be removed, got %v", patches)
+	}
+	if _, ok := patches["zzz-other-app"]; ok {
+		t.Error("Expected apps without automated sync to be left alone")
+	}
+}
+
+func TestRollback_OffersToDisableAutoSync(t *testing.T) {
+	patches := make(map[string]string)
+	server := autoSyncTestServer(t, patches)
+	defer server.Close()
+
+	m := buildDeleteTestModel(120, 30)
+	m.state.Server = &model.Server{BaseURL: server.URL, Token: "test-token"}
+	m.state.Mode = model.ModeRollback
+	m.Update(model.RollbackHistoryLoadedMsg{
+		AppName:         "test-app",
+		Rows:            []model.RollbackRow{{ID: 1, Revision: "abcdef1234"}},
+		CurrentRevision: "1234abcdef",
+		AutoSync:        &model.AutoSync{SelfHeal: true},
+	})
+	if !m.state.Rollback.DisableAutoSync {
+		t.Fatal("Expected disabling automated sync to be the default")
+	}
+
+	m.handleRollbackModeKeys(tea.KeyPressMsg{Code: tea.KeyEnter})
+	out := stripANSI(m.renderRollbackConfirmation(m.state.Rollback, 30, 100))
+	if !strings.Contains(out, "Automated sync is enabled") || !strings.Contains(out, "[a] Disable auto-sync: Yes") {
+		t.Fatalf("Expected the auto-sync warning and option, got:\n%s", out)
+	}
+	m.handleRollbackModeKeys(testKeyMsg("a"))
+	if m.state.Rollback.DisableAutoSync {
+		t.Fatal("Expected a to toggle the option")
+	}
+	m.handleRollbackModeKeys(testKeyMsg("a"))
+
+	_, cmd := m.handleRollbackModeKeys(tea.KeyPressMsg{Code: tea.KeyEnter})
+	msg, ok := cmd().(model.RollbackExecutedMsg)
+	if !ok || !msg.AutoSyncDisabled {
+		t.Fatalf("Expected the rollback to run after disabling automated sync, got %#v", msg)
+	}
+	if patches["test-app"] != `{"spec":{"syncPolicy":{"automated":null}}}` {
+		t.Fatalf("Expected automated sync to be disabled first, got %v", patches)
+	}
+}
@@ -0,0 +1,156 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	cblog "github.com/charmbracelet/log"
+	"github.com/darksworm/argonaut/pkg/api"
+	appcontext "github.com/darksworm/argonaut/pkg/context"
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+// clustersRefreshInterval is how often clusters are re-fetched so an unreachable cluster shows
+// up in the clusters view and on its apps
+const clustersRefreshInterval = 30 * time.Second
+
+// clustersRefreshTickMsg re-fetches clusters and their connection states
+type clustersRefreshTickMsg struct {
+	session int
+	epoch   int
+}
+
+// ensureClusters starts the background refresh of clusters after the first app load
+func (m *Model) ensureClusters() tea.Cmd {
+	if m.clustersSession != 0 {
+		return nil
+	}
+	m.clustersSession++
+	return m.loadClusters(m.clustersSession)
+}
+
+// loadClusters lists clusters with their connection and cache info
+func (m *Model) loadClusters(session int) tea.Cmd {
+	epoch := m.switchEpoch // capture at call time
+	return func() tea.Msg {
+		if m.state.Server == nil {
+			return model.ClustersLoadedMsg{Session: session, Err: fmt.Errorf("no server configured"), SwitchEpoch: epoch}
+		}
+		ctx, cancel := appcontext.WithAPITimeout(context.Background())
+		defer cancel()
+
+		clusters, err := api.NewClusterService(m.state.Server).ListClusters(ctx)
+		if err != nil {
+			return model.ClustersLoadedMsg{Session: session, Err: err, SwitchEpoch: epoch}
+		}
+		out := make([]model.Cluster, 0, len(clusters))
+		for _, c := range clusters {
+			out = append(out, api.ConvertToCluster(c))
+		}
+		return model.ClustersLoadedMsg{Session: session, Clusters: out, SwitchEpoch: epoch}
+	}
+}
+
+// handleClustersLoaded stores the refreshed clusters and schedules the next refresh
+func (m *Model) handleClustersLoaded(msg model.ClustersLoadedMsg) (tea.Model, tea.Cmd) {
+	if msg.SwitchEpoch != m.switchEpoch || msg.Session != m.clustersSession {
+		return m, nil
+	}
+	next := scheduleClustersRefresh(msg.Session, msg.SwitchEpoch)
+	if msg.Err != nil {
+		// Without cluster access the view falls back to the clusters the apps target; retry later
+		cblog.With("component", "clusters").Debug("Failed to list clusters", "err", msg.Err)
+		return m, next
+	}
+	m.state.Clusters = make(map[string]model.Cluster, len(msg.Clusters))
+	for _, c := range msg.Clusters {
+		m.state.Clusters[c.Label()] = c
+	}
+	return m, next
+}
+
+// scheduleClustersRefresh queues the next clusters refresh for a session
+func scheduleClustersRefresh(session, epoch int) tea.Cmd {
+	return tea.Tick(clustersRefreshInterval, func(time.Time) tea.Msg {
+		return clustersRefreshTickMsg{session: session, epoch: epoch}
+	})
+}
+
+// handleClustersRefreshTick re-fetches clusters unless the refresh belongs to a previous context
+func (m *Model) handleClustersRefreshTick(msg clustersRefreshTickMsg) (tea.Model, tea.Cmd) {
+	if msg.epoch != m.switchEpoch || msg.session != m.clustersSession {
+		return m, nil
+	}
+	return m, m.loadClusters(msg.session)
+}
+
+// clusterForLabel returns the registered cluster an app cluster label refers to. Apps that target
+// a cluster by server URL are labelled with the server host rather than the cluster name.
+func (m *Model) clusterForLabel(label string) (model.Cluster, bool) {
+	if c, ok := m.state.Clusters[label]; ok {
+		return c, true
+	}
+	for _, c := range m.state.Clusters {
+		for _, key := range c.Keys() {
+			if key == label {
+				return c, true
+			}
+		}
+	}
+	return model.Cluster{}, false
+}
+
+// clusterLabels returns the labels shown in the clusters view: every registered cluster, including
+// clusters without apps, plus clusters that apps target without them being returned by the API
+func (m *Model) clusterLabels() []string {
+	seen := make(map[string]bool)
+	var labels []string
+	for label, c := range m.state.Clusters {
+		labels = append(labels, label)
+		for _, key := range c.Keys() {
+			seen[key] = true
+		}
+	}
+	if idx := m.state.Index; idx != nil {
+		for _, label := range idx.Clusters {
+			if !seen[label] {
+				seen[label] = true
+				labels = append(labels, label)
+			}
+		}
+	}
+	sort.Strings(labels)
+	return labels
+}
+
+// clusterScopeKeys returns the app cluster labels a cluster scope should match
+func (m *Model) clusterScopeKeys(label string) []string {
+	if c, ok := m.clusterForLabel(label); ok {
+		return c.Keys()
+	}
+	return []string{label}
+}
+
+// clusterAppCount returns how many apps target a cluster under any of its labels
+func (m *Model) clusterAppCount(label string) int {
+	if m.state.Index == nil {
+		return 0
+	}
+	count := 0
+	for _, key := range m.clusterScopeKeys(label) {
+		count += len(m.state.Index.ByCluster[key])
+	}
+	return count
+}
+
+// appClusterFailed reports whether ArgoCD cannot currently connect to the app's cluster
+func (m *Model) appClusterFailed(app model.App) bool {
+	if app.ClusterLabel == nil || len(m.state.Clusters) == 0 {
+		return false
+	}
+	c, ok := m.clusterForLabel(*app.ClusterLabel)
+	return ok && c.Failed()
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+func buildClustersTestModel() *Model {
+	m := buildDeleteTestModel(140, 30)
+	// test-app targets prod-eu by server URL, zzz-other-app by name
+	byServer, byName := "10.0.0.1:6443", "prod-us"
+	m.state.Apps[0].ClusterID, m.state.Apps[0].ClusterLabel = &byServer, &byServer
+	m.state.Apps[1].ClusterID, m.state.Apps[1].ClusterLabel = &byName, &byName
+	m.state.Index = model.BuildAppIndex(m.state.Apps)
+	m.ensureClusters()
+	m.Update(model.ClustersLoadedMsg{Session: m.clustersSession, SwitchEpoch: m.switchEpoch, Clusters: []model.Cluster{
+		{Name: "prod-eu", Server: "https://10.0.0.1:6443", Version: "1.29", ConnectionStatus: "Failed", ConnectionMessage: "dial tcp: i/o timeout"},
+		{Name: "prod-us", Server: "https://10.0.0.3:6443", Version: "1.29", ConnectionStatus: "Successful", ResourcesCount: 420, APIsCount: 61},
+		{Name: "spare", Server: "https://10.0.0.9:6443", ConnectionStatus: "Unknown"},
+	}})
+	return m
+}
+
+func TestClusters_ListsRegisteredClusters(t *testing.T) {
+	m := buildClustersTestModel()
+	m.state.Navigation.View = model.ViewClusters
+
+	items := m.getVisibleItems()
+	want := []string{"prod-eu", "prod-us", "spare"}
+	if len(items) != len(want) {
+		t.Fatalf("Expected %v, got %v", want, items)
+	}
+	for i, w := range want {
+		if items[i] != w {
+			t.Errorf("Item %d = %v, want %s", i, items[i], w)
+		}
+	}
+	if got := m.clusterAppCount("prod-eu"); got != 1 {
+		t.Errorf("Expected the app targeting prod-eu by server URL to be counted, got %d", got)
+	}
+
+	out := m.renderListView(20)
+	for _, s := range []string{"https://10.0.0.1:6443", "Failed", "dial tcp: i/o timeout", "420", "61"} {
+		if !strings.Contains(out, s) {
+			t.Errorf("Expected %q in clusters view, got:\n%s", s, out)
+		}
+	}
+}
+
+func TestClusters_DrillDownMatchesServerLabel(t *testing.T) {
+	m := buildClustersTestModel()
+	m.state.Navigation.View = model.ViewClusters
+	m.state.Navigation.SelectedIdx = 0
+
+	m.handleKeyMsg(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if !m.state.Selections.HasCluster("prod-eu") || !m.state.Selections.HasCluster("10.0.0.1:6443") {
+		t.Fatalf("Expected the cluster scope to cover the name and server host, got %v", m.state.Selections.ScopeClusters)
+	}
+}
+
+func TestClusters_FlagAppsOnFailedClusters(t *testing.T) {
+	m := buildClustersTestModel()
+
+	out := m.renderListView(20)
+	if strings.Count(out, strings.TrimSpace(clusterFailedTag)) != 1 {
+		t.Fatalf("Expected only test-app to be flagged, got:\n%s", out)
+	}
+
+	// Stale refreshes from a previous context are ignored
+	m.Update(model.ClustersLoadedMsg{Session: m.clustersSession, SwitchEpoch: m.switchEpoch - 1})
+	if _, ok := m.state.Clusters["prod-eu"]; !ok {
+		t.Fatal("Expected clusters from a previous context to be ignored")
+	}
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	cblog "github.com/charmbracelet/log"
+	"github.com/darksworm/argonaut/pkg/config"
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+// handleColumnsCommand toggles optional apps list columns. The argument is a comma-separated
+// list of column names; "none" removes them all. Without an argument the current and available
+// columns are shown.
+func (m *Model) handleColumnsCommand(arg string) (tea.Model, tea.Cmd) {
+	if arg == "" {
+		current := "none"
+		if len(m.state.UI.Columns) > 0 {
+			current = strings.Join(m.state.UI.Columns, ", ")
+		}
+		available := make([]string, 0, len(model.AppColumns)+1)
+		for _, c := range model.AppColumns {
+			available = append(available, c.Name)
+		}
+		available = append(available, "label:<key>")
+		return m, func() tea.Msg {
+			return model.StatusChangeMsg{Status: fmt.Sprintf("Columns: %s. Toggle with :columns name[,name…] from %s",
+				current, strings.Join(available, ", "))}
+		}
+	}
+
+	columns := m.state.UI.Columns
+	if strings.EqualFold(arg, "none") {
+		columns = nil
+	} else {
+		toggle, unknown := model.ParseAppColumns(strings.Split(arg, ","))
+		if len(unknown) > 0 {
+			return m, func() tea.Msg {
+				return model.StatusChangeMsg{Status: "Unknown column: " + strings.Join(unknown, ", ")}
+			}
+		}
+		for _, name := range toggle {
+			columns = toggleColumn(columns, name)
+		}
+	}
+	m.state.UI.Columns = columns
+
+	// Persist to config
+	argonautConfig, err := config.LoadArgonautConfig()
+	if err != nil {
+		argonautConfig = config.GetDefaultConfig()
+	}
+	argonautConfig.Apps.Columns = columns
+	if err := config.SaveArgonautConfig(argonautConfig); err != nil {
+		cblog.Warn("Failed to save column preference", "err", err)
+	}
+
+	status := "Columns: none"
+	if len(columns) > 0 {
+		status = "Columns: " + strings.Join(columns, ", ")
+	}
+	return m, func() tea.Msg { return model.StatusChangeMsg{Status: status} }
+}
+
+// toggleColumn removes a column that is shown, or appends it otherwise
+func toggleColumn(columns []string, name string) []string {
+	for i, c := range columns {
+		if c == name {
+			return append(columns[:i:i], columns[i+1:]...)
+		}
+	}
+	return append(columns[:len(columns):len(columns)], name)
+}
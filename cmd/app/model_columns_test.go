@@ -0,0 +1,95 @@
+package main
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/darksworm/argonaut/pkg/config"
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+func TestAppColumnWidths_HidesColumnsThatDoNotFit(t *testing.T) {
+	columns := []string{"project", "synced", "lastsync"}
+
+	// Wide: every column fits and NAME takes the rest
+	n, s, h, widths := appColumnWidths(120, columns)
+	if widths[0] != 14 || widths[1] != 8 || widths[2] != 9 {
+		t.Fatalf("wide widths = %v, want [14 8 9]", widths)
+	}
+	if n+appColumnsWidth(widths)+s+h+2 != 120 {
+		t.Errorf("columns do not fill the width: name %d columns %v", n, widths)
+	}
+
+	// Medium: the project column no longer fits but the narrower ones still do
+	_, _, _, widths = appColumnWidths(60, columns)
+	if widths[0] != 0 || widths[1] != 8 || widths[2] != 0 {
+		t.Errorf("medium widths = %v, want [0 8 0]", widths)
+	}
+
+	// Narrow: icon-only layout has no optional columns
+	_, _, _, widths = appColumnWidths(40, columns)
+	if appColumnsWidth(widths) != 0 {
+		t.Errorf("narrow widths = %v, want none", widths)
+	}
+}
+
+func TestAppColumnValue(t *testing.T) {
+	now := time.Now()
+	synced := now.Add(-90 * time.Minute)
+	app := model.App{
+		Name:           "app",
+		RepoURLs:       []string{"https://github.com/example/apps.git", "https://charts.example.com"},
+		SyncedRevision: "0123456789abcdef0123456789abcdef01234567",
+		LastSyncAt:     &synced,
+		Labels:         map[string]string{"team": "core"},
+	}
+	tests := map[string]string{
+		"repo":       "github.com/example/apps (+1)",
+		"synced":     "0123456",
+		"lastsync":   "1h ago",
+		"label:team": "core",
+		"label:env":  "",
+		"project":    "",
+	}
+	for column, want := range tests {
+		if got := appColumnValue(app, column, now); got != want {
+			t.Errorf("appColumnValue(%s) = %q, want %q", column, got, want)
+		}
+	}
+}
+
+func TestColumnsCommand_TogglesAndPersists(t *testing.T) {
+	t.Setenv("ARGONAUT_CONFIG", filepath.Join(t.TempDir(), "config.toml"))
+	m := buildDeleteTestModel(140, 30)
+	m.state.Apps[0].SyncedRevision = "0123456789abcdef0123456789abcdef01234567"
+
+	runTestCommand(m, "columns synced,proj")
+	if got := strings.Join(m.state.UI.Columns, ","); got != "synced,project" {
+		t.Fatalf("Expected synced and project columns, got %q", got)
+	}
+	out := m.renderListView(20)
+	for _, s := range []string{"REVISION", "PROJECT", "0123456", "test-project"} {
+		if !strings.Contains(out, s) {
+			t.Errorf("Expected %q in apps list, got:\n%s", s, out)
+		}
+	}
+
+	runTestCommand(m, "columns synced")
+	if got := strings.Join(m.state.UI.Columns, ","); got != "project" {
+		t.Errorf("Expected synced to be toggled off, got %q", got)
+	}
+	cfg, err := config.LoadArgonautConfig()
+	if err != nil {
+		t.Fatalf("LoadArgonautConfig: %v", err)
+	}
+	if got := cfg.GetAppColumns(); len(got) != 1 || got[0] != "project" {
+		t.Errorf("Expected the columns to be saved, got %v", got)
+	}
+
+	runTestCommand(m, "columns bogus")
+	if len(m.state.UI.Columns) != 1 {
+		t.Errorf("Expected an unknown column to change nothing, got %v", m.state.UI.Columns)
+	}
+}
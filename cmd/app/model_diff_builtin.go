@@ -0,0 +1,153 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/darksworm/argonaut/pkg/diff"
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+// builtinDiffMsg carries a diff computed by the built-in engine to the in-app diff view
+type builtinDiffMsg struct {
+	Title       string
+	Sections    []diff.Section
+	SwitchEpoch int
+}
+
+// diffRequest describes the documents to compare and how to label them
+type diffRequest struct {
+	Title          string // Pager or view title
+	FormatterTitle string // Resource name handed to the formatter for its header
+	LeftPrefix     string // Temp file prefixes, visible in external viewers
+	RightPrefix    string
+	Resources      []diff.Resource
+}
+
+// useBuiltinDiff reports whether diffs are rendered in-app rather than through git
+func (m *Model) useBuiltinDiff() bool {
+	return (m.config != nil && m.config.UseBuiltinDiff()) || !inPath("git")
+}
+
+// presentDiff shows a diff with the configured viewer, the built-in engine, or
+// git and the formatter. It reports false when both sides are identical.
+func (m *Model) presentDiff(req diffRequest, epoch int) (tea.Msg, bool) {
+	var leftDocs, rightDocs []string
+	for _, r := range req.Resources {
+		if r.Left != "" {
+			leftDocs = append(leftDocs, r.Left)
+		}
+		if r.Right != "" {
+			rightDocs = append(rightDocs, r.Right)
+		}
+	}
+	viewer := ""
+	if m.config != nil {
+		viewer = m.config.GetDiffViewer()
+	}
+
+	if m.useBuiltinDiff() {
+		sections := diff.Compute(req.Resources)
+		if len(sections) == 0 {
+			return nil, false
+		}
+		if viewer != "" {
+			leftFile, _ := writeTempYAML(req.LeftPrefix, leftDocs)
+			rightFile, _ := writeTempYAML(req.RightPrefix, rightDocs)
+			return m.openInteractiveDiffViewer(leftFile, rightFile, viewer), true
+		}
+		return builtinDiffMsg{Title: req.Title, Sections: sections, SwitchEpoch: epoch}, true
+	}
+
+	leftFile, rightFile, cleaned, err := diffManifestDocs(req.LeftPrefix, req.RightPrefix, leftDocs, rightDocs)
+	if err != nil {
+		return model.ApiErrorMsg{Message: "Diff failed: " + err.Error(), SwitchEpoch: epoch}, true
+	}
+	if strings.TrimSpace(cleaned) == "" {
+		return nil, false
+	}
+
+	// 1) Interactive diff viewer: replace the terminal (e.g., vimdiff, meld)
+	if viewer != "" {
+		return m.openInteractiveDiffViewer(leftFile, rightFile, viewer), true
+	}
+
+	// 2) Non-interactive formatter: pipe to tool (e.g., delta) and then show via pager
+	formatted := cleaned
+	if formattedOut, ferr := m.runDiffFormatterWithTitle(cleaned, req.FormatterTitle); ferr == nil && strings.TrimSpace(formattedOut) != "" {
+		formatted = formattedOut
+	}
+	return m.openTextPager(req.Title, formatted)(), true
+}
+
+// handleBuiltinDiff opens the in-app diff view for a built-in engine result
+func (m *Model) handleBuiltinDiff(msg builtinDiffMsg) (tea.Model, tea.Cmd) {
+	if msg.SwitchEpoch != m.switchEpoch {
+		return m, nil
+	}
+	m.diffSections = msg.Sections
+	m.state.Diff = &model.DiffState{Title: msg.Title}
+	m.renderBuiltinDiff()
+	m.state.Mode = model.ModeDiff
+	return m, nil
+}
+
+// renderBuiltinDiff lays out the built-in diff for the current width and layout
+func (m *Model) renderBuiltinDiff() {
+	if m.state.Diff == nil || m.diffSections == nil {
+		return
+	}
+	width := m.contentInnerWidth()
+	renderer := diff.Renderer{Palette: currentPalette, Width: width}
+	rendered := renderer.Unified(m.diffSections)
+	if m.diffSideBySide {
+		rendered = renderer.SideBySide(m.diffSections)
+	}
+	// Clip long lines so each one stays a single row and header indexes stay valid
+	for i, line := range rendered.Lines {
+		rendered.Lines[i] = clipAnsiToWidth(line, width)
+	}
+	m.state.Diff.Content = rendered.Lines
+	m.state.Diff.Hunks = rendered.Hunks
+	m.state.Diff.Sections = rendered.Sections
+}
+
+// jumpDiff moves the diff view to the next (dir > 0) or previous header in
+// positions. The search filter is cleared since positions index the full diff.
+func (m *Model) jumpDiff(positions []int, dir int, what string) tea.Cmd {
+	if len(positions) == 0 {
+		return nil
+	}
+	d := m.state.Diff
+	d.SearchQuery = ""
+	target := -1
+	if dir > 0 {
+		for _, p := range positions {
+			if p > d.Offset {
+				target = p
+				break
+			}
+		}
+	} else {
+		for i := len(positions) - 1; i >= 0; i-- {
+			if positions[i] < d.Offset {
+				target = positions[i]
+				break
+			}
+		}
+	}
+	if target < 0 {
+		return func() tea.Msg { return model.StatusChangeMsg{Status: fmt.Sprintf("No more %ss", what)} }
+	}
+	d.Offset = target
+	return nil
+}
+
+// diffResourceTitle names a resource in a diff section header
+func diffResourceTitle(kind, namespace, name string) string {
+	if namespace == "" {
+		return kind + " " + name
+	}
+	return fmt.Sprintf("%s %s/%s", kind, namespace, name)
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/darksworm/argonaut/pkg/config"
+	"github.com/darksworm/argonaut/pkg/diff"
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+func TestBuiltinDiff_RendersInAppWithNavigation(t *testing.T) {
+	m := buildDeleteTestModel(100, 30)
+	m.config = &config.ArgonautConfig{Diff: config.DiffConfig{Engine: "builtin"}}
+
+	msg, changed := m.presentDiff(diffRequest{
+		Title: "test-app - Live vs Desired",
+		Resources: []diff.Resource{
+			{Title: "ConfigMap default/a", Left: "data:\n  key: one\n", Right: "data:\n  key: two\n"},
+			{Title: "ConfigMap default/same", Left: "data: {}\n", Right: "data: {}\n"},
+			{Title: "Service default/b", Left: "", Right: "kind: Service\n"},
+		},
+	}, m.switchEpoch)
+	if !changed {
+		t.Fatal("Expected a diff")
+	}
+	m.Update(msg)
+	if m.state.Mode != model.ModeDiff || m.state.Diff == nil {
+		t.Fatalf("Expected the in-app diff view, got mode %s", m.state.Mode)
+	}
+	if got := m.state.Diff.Sections; len(got) != 2 {
+		t.Fatalf("Expected two changed resources, got %v", got)
+	}
+
+	out := stripANSI(m.renderDiffView())
+	if !strings.Contains(out, "━━ ConfigMap default/a +1 -1") || !strings.Contains(out, "+kind: Service") {
+		t.Errorf("Expected resource sections in the view:\n%s", out)
+	}
+	if strings.Contains(out, "default/same") {
+		t.Error("Expected unchanged resources to be omitted")
+	}
+
+	m.handleDiffModeKeys(testKeyMsg("}"))
+	if m.state.Diff.Offset != m.state.Diff.Sections[1] {
+		t.Errorf("Expected } to jump to the second resource, offset %d", m.state.Diff.Offset)
+	}
+	m.handleDiffModeKeys(testKeyMsg("["))
+	if m.state.Diff.Offset != m.state.Diff.Hunks[0] {
+		t.Errorf("Expected [ to jump back to the first hunk, offset %d", m.state.Diff.Offset)
+	}
+
+	m.handleDiffModeKeys(testKeyMsg("v"))
+	if !m.diffSideBySide || !strings.Contains(stripANSI(m.renderDiffView()), "│    2   key: two") {
+		t.Errorf("Expected the side-by-side layout:\n%s", stripANSI(m.renderDiffView()))
+	}
+
+	m.handleDiffModeKeys(testKeyMsg("q"))
+	if m.diffSections != nil || m.state.Diff != nil {
+		t.Error("Expected leaving the view to drop the diff")
+	}
+}
+
+func TestRollbackDiff_WithoutGit(t *testing.T) {
+	t.Setenv("PATH", t.TempDir())
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"items":[{"kind":"ConfigMap","namespace":"default","name":"cfg",` +
+			`"liveState":"{\"apiVersion\":\"v1\",\"kind\":\"ConfigMap\",\"data\":{\"key\":\"one\"}}",` +
+			`"targetState":"{\"apiVersion\":\"v1\",\"kind\":\"ConfigMap\",\"data\":{\"key\":\"two\"}}"}]}`))
+	}))
+	defer server.Close()
+
+	m := buildDeleteTestModel(100, 30)
+	m.state.Server = &model.Server{BaseURL: server.URL, Token: "test-token"}
+
+	msg := m.startRollbackDiffSession("test-app", "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa")()
+	built, ok := msg.(builtinDiffMsg)
+	if !ok {
+		t.Fatalf("Expected the built-in diff without git, got %#v", msg)
+	}
+	if len(built.Sections) != 1 || built.Sections[0].Title != "ConfigMap default/cfg" || built.Sections[0].Added != 1 {
+		t.Errorf("Expected the ConfigMap change, got %+v", built.Sections)
+	}
+	if built.Title != "Rollback test-app to aaaaaaa" {
+		t.Errorf("Unexpected title %q", built.Title)
+	}
+}
@@ -0,0 +1,183 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	cblog "github.com/charmbracelet/log"
+	"github.com/darksworm/argonaut/pkg/api"
+	appcontext "github.com/darksworm/argonaut/pkg/context"
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+// eventsRefreshInterval is how often the events view reloads while open
+const eventsRefreshInterval = 5 * time.Second
+
+// eventsRefreshTickMsg triggers a periodic reload of the events view
+type eventsRefreshTickMsg struct{ session int }
+
+// handleOpenAppEvents opens the events view for the app under the cursor in the apps list
+func (m *Model) handleOpenAppEvents() (tea.Model, tea.Cmd) {
+	items := m.getVisibleItemsForCurrentView()
+	if len(items) == 0 || m.state.Navigation.SelectedIdx >= len(items) {
+		return m, func() tea.Msg { return model.StatusChangeMsg{Status: "No app selected for events"} }
+	}
+	app, ok := items[m.state.Navigation.SelectedIdx].(model.App)
+	if !ok {
+		return m, nil
+	}
+	return m, m.openEvents(&model.EventsState{AppName: app.Name, AppNamespace: app.AppNamespace})
+}
+
+// handleOpenResourceEvents opens the events view for the tree node under the cursor.
+// Application root nodes show all events of that application.
+func (m *Model) handleOpenResourceEvents() (tea.Model, tea.Cmd) {
+	if m.treeView == nil {
+		return m, nil
+	}
+	events := &model.EventsState{}
+	if res, ok := m.treeView.CurrentResource(); ok {
+		events.AppName = res.AppName
+		events.Resource = res.Kind + "/" + res.Name
+		events.ResourceName = res.Name
+		events.ResourceNamespace = res.Namespace
+		events.ResourceUID = res.UID
+	} else if _, kind, _, name, ok := m.treeView.SelectedResource(); ok && kind == "Application" {
+		events.AppName = name
+	}
+	if events.AppName == "" {
+		return m, func() tea.Msg { return model.StatusChangeMsg{Status: "Could not determine application name"} }
+	}
+	for i := range m.state.Apps {
+		if m.state.Apps[i].Name == events.AppName {
+			events.AppNamespace = m.state.Apps[i].AppNamespace
+			break
+		}
+	}
+	return m, m.openEvents(events)
+}
+
+// openEvents switches to the events view and starts the first load
+func (m *Model) openEvents(events *model.EventsState) tea.Cmd {
+	m.eventsSession++
+	events.Session = m.eventsSession
+	events.Loading = true
+	m.state.Events = events
+	m.state.Mode = model.ModeEvents
+	return m.loadEvents(*events)
+}
+
+// loadEvents fetches events for the events view
+func (m *Model) loadEvents(events model.EventsState) tea.Cmd {
+	epoch := m.switchEpoch // capture at call time
+	return func() tea.Msg {
+		if m.state.Server == nil {
+			return model.EventsLoadedMsg{Session: events.Session, Err: fmt.Errorf("no server configured"), SwitchEpoch: epoch}
+		}
+		ctx, cancel := appcontext.WithAPITimeout(context.Background())
+		defer cancel()
+
+		appService := api.NewApplicationService(m.state.Server)
+		items, err := appService.ListResourceEvents(ctx, api.ListResourceEventsParams{
+			AppName:           events.AppName,
+			AppNamespace:      events.AppNamespace,
+			ResourceName:      events.ResourceName,
+			ResourceNamespace: events.ResourceNamespace,
+			ResourceUID:       events.ResourceUID,
+		})
+		if err != nil {
+			cblog.With("component", "events").Error("Failed to list events", "app", events.AppName, "resource", events.Resource, "err", err)
+			return model.EventsLoadedMsg{Session: events.Session, Err: err, SwitchEpoch: epoch}
+		}
+		return model.EventsLoadedMsg{Session: events.Session, Events: toEventRows(items), SwitchEpoch: epoch}
+	}
+}
+
+// toEventRows converts API events to display rows sorted newest first
+func toEventRows(items []api.ResourceEvent) []model.EventRow {
+	rows := make([]model.EventRow, 0, len(items))
+	for _, e := range items {
+		rows = append(rows, model.EventRow{
+			Type:     e.Type,
+			Reason:   e.Reason,
+			Message:  strings.TrimSpace(e.Message),
+			Object:   e.InvolvedObject.Kind + "/" + e.InvolvedObject.Name,
+			Count:    e.Occurrences(),
+			LastSeen: e.LastSeen(),
+		})
+	}
+	sort.SliceStable(rows, func(i, j int) bool {
+		return rows[i].LastSeen.After(rows[j].LastSeen)
+	})
+	return rows
+}
+
+// scheduleEventsRefresh queues the next periodic reload for a session
+func scheduleEventsRefresh(session int) tea.Cmd {
+	return tea.Tick(eventsRefreshInterval, func(time.Time) tea.Msg {
+		return eventsRefreshTickMsg{session: session}
+	})
+}
+
+// handleEventsLoaded stores loaded events and schedules the next refresh
+func (m *Model) handleEventsLoaded(msg model.EventsLoadedMsg) (tea.Model, tea.Cmd) {
+	// Gate by switch epoch and by the events view that requested the load
+	if msg.SwitchEpoch != m.switchEpoch || m.state.Events == nil || m.state.Events.Session != msg.Session {
+		return m, nil
+	}
+	events := m.state.Events
+	events.Loading = false
+	if msg.Err != nil {
+		events.Error = extractUserFriendlyError(msg.Err)
+	} else {
+		events.Error = ""
+		events.Events = msg.Events
+		now := time.Now()
+		events.UpdatedAt = &now
+	}
+	return m, scheduleEventsRefresh(msg.Session)
+}
+
+// handleEventsRefreshTick reloads the events view if it is still open
+func (m *Model) handleEventsRefreshTick(msg eventsRefreshTickMsg) (tea.Model, tea.Cmd) {
+	if m.state.Events == nil || m.state.Events.Session != msg.session {
+		// View closed or replaced; let the refresh loop end
+		return m, nil
+	}
+	if m.inPager {
+		// Don't hit the API while the pager owns the terminal; try again later
+		return m, scheduleEventsRefresh(msg.session)
+	}
+	return m, m.loadEvents(*m.state.Events)
+}
+
+// handleEventsModeKeys handles non-navigation input in the events view.
+// Navigation keys (up/k, down/j, pgup, pgdown, g, G) are handled by the centralized router.
+func (m *Model) handleEventsModeKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.state.Events == nil {
+		m.state.Mode = model.ModeNormal
+		return m, nil
+	}
+	switch msg.String() {
+	case "q", "esc":
+		m.state.Events = nil
+		m.state.Mode = model.ModeNormal
+		return m, nil
+	case "r":
+		// Reload now; a new session ends the pending refresh loop and starts a fresh one
+		events := *m.state.Events
+		return m, m.openEvents(&events)
+	}
+	return m, nil
+}
+
+// eventsPageSize returns the number of visible event rows in the events view
+func (m *Model) eventsPageSize() int {
+	// title + column header + status + content border + main container padding
+	overhead := 6
+	return max(3, m.state.Terminal.Rows-overhead)
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/darksworm/argonaut/pkg/api"
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+func TestToEventRows_NewestFirst(t *testing.T) {
+	older := time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+	newer := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	var a, b api.ResourceEvent
+	a.Reason, a.LastTimestamp = "Scheduled", &older
+	b.Reason, b.LastTimestamp = "BackOff", &newer
+	b.InvolvedObject.Kind, b.InvolvedObject.Name = "Pod", "web-1"
+
+	rows := toEventRows([]api.ResourceEvent{a, b})
+	if len(rows) != 2 || rows[0].Reason != "BackOff" || rows[1].Reason != "Scheduled" {
+		t.Fatalf("Expected newest event first, got %+v", rows)
+	}
+	if rows[0].Object != "Pod/web-1" {
+		t.Errorf("Expected object Pod/web-1, got %s", rows[0].Object)
+	}
+}
+
+func TestFormatEventAge(t *testing.T) {
+	now := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+	cases := map[time.Duration]string{
+		30 * time.Second: "30s",
+		5 * time.Minute:  "5m",
+		3 * time.Hour:    "3h",
+		50 * time.Hour:   "2d",
+	}
+	for d, want := range cases {
+		if got := formatEventAge(now.Add(-d), now); got != want {
+			t.Errorf("formatEventAge(%v) = %s, want %s", d, got, want)
+		}
+	}
+	if got := formatEventAge(time.Time{}, now); got != "-" {
+		t.Errorf("Expected '-' for zero time, got %s", got)
+	}
+}
+
+func TestEventsLoaded_IgnoresStaleSession(t *testing.T) {
+	m := NewModel(nil)
+	m.state.Apps = []model.App{{Name: "web-app"}}
+	m.state.Navigation.View = model.ViewApps
+	m.handleOpenAppEvents()
+	if m.state.Mode != model.ModeEvents || m.state.Events == nil {
+		t.Fatal("Expected events view to open for the app under the cursor")
+	}
+	session := m.state.Events.Session
+
+	m.Update(model.EventsLoadedMsg{Session: session - 1, Events: []model.EventRow{{Reason: "stale"}}, SwitchEpoch: m.switchEpoch})
+	if len(m.state.Events.Events) != 0 {
+		t.Fatal("Expected stale events to be ignored")
+	}
+
+	_, cmd := m.Update(model.EventsLoadedMsg{Session: session, Events: []model.EventRow{{Reason: "BackOff"}}, SwitchEpoch: m.switchEpoch})
+	if len(m.state.Events.Events) != 1 || m.state.Events.Loading {
+		t.Fatal("Expected events to be stored")
+	}
+	if cmd == nil {
+		t.Error("Expected a refresh to be scheduled")
+	}
+
+	// Closing the view ends the refresh loop
+	m.handleEventsModeKeys(testKeyMsg("q"))
+	if _, cmd := m.Update(eventsRefreshTickMsg{session: session}); cmd != nil {
+		t.Error("Expected no reload after the view was closed")
+	}
+}
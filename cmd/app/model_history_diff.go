@@ -0,0 +1,194 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/darksworm/argonaut/pkg/api"
+	appcontext "github.com/darksworm/argonaut/pkg/context"
+	"github.com/darksworm/argonaut/pkg/diff"
+	"github.com/darksworm/argonaut/pkg/model"
+	yaml "gopkg.in/yaml.v3"
+)
+
+// historyRevision is one side of a deployment history comparison
+type historyRevision struct {
+	Revision string
+	Label    string
+}
+
+// toggleRollbackMark marks or unmarks the selected history entry for comparison.
+// At most two entries are marked; marking a third replaces the oldest mark.
+func (m *Model) toggleRollbackMark() {
+	rb := m.state.Rollback
+	if rb == nil || rb.SelectedIdx < 0 || rb.SelectedIdx >= len(rb.Rows) {
+		return
+	}
+	id := rb.Rows[rb.SelectedIdx].ID
+	if i := slices.Index(rb.Marked, id); i >= 0 {
+		rb.Marked = slices.Delete(rb.Marked, i, i+1)
+		return
+	}
+	if len(rb.Marked) == 2 {
+		rb.Marked = rb.Marked[1:]
+	}
+	rb.Marked = append(rb.Marked, id)
+}
+
+// historyDiffRevisions picks the two revisions to compare: both marked entries,
+// the marked entry and the cursor, or the cursor and the current revision.
+// The older deployment is always on the left.
+func (m *Model) historyDiffRevisions() (from, to historyRevision, ok bool) {
+	rb := m.state.Rollback
+	if rb == nil || rb.SelectedIdx < 0 || rb.SelectedIdx >= len(rb.Rows) {
+		return from, to, false
+	}
+
+	rowByID := func(id int) (model.RollbackRow, bool) {
+		for _, row := range rb.Rows {
+			if row.ID == id {
+				return row, true
+			}
+		}
+		return model.RollbackRow{}, false
+	}
+	toRevision := func(row model.RollbackRow) historyRevision {
+		return historyRevision{Revision: row.Revision, Label: fmt.Sprintf("#%d %s", row.ID, shortRevision(row.Revision))}
+	}
+
+	cursor := rb.Rows[rb.SelectedIdx]
+	var pair []model.RollbackRow
+	for _, id := range rb.Marked {
+		if row, found := rowByID(id); found {
+			pair = append(pair, row)
+		}
+	}
+	switch len(pair) {
+	case 0:
+		if rb.CurrentRevision == "" {
+			return from, to, false
+		}
+		return toRevision(cursor), historyRevision{Revision: rb.CurrentRevision, Label: "current " + shortRevision(rb.CurrentRevision)}, true
+	case 1:
+		if pair[0].ID == cursor.ID {
+			return from, to, false
+		}
+		pair = append(pair, cursor)
+	}
+	if pair[0].ID > pair[1].ID {
+		pair[0], pair[1] = pair[1], pair[0]
+	}
+	return toRevision(pair[0]), toRevision(pair[1]), true
+}
+
+// startHistoryDiffSession fetches the manifests rendered at two revisions and shows their diff
+func (m *Model) startHistoryDiffSession(appName string, appNamespace *string, from, to historyRevision) tea.Cmd {
+	epoch := m.switchEpoch // capture at call time
+	return func() tea.Msg {
+		if m.state.Server == nil {
+			return model.ApiErrorMsg{Message: "No server configured", SwitchEpoch: epoch}
+		}
+
+		ctx, cancel := appcontext.WithMinAPITimeout(context.Background(), 45*time.Second)
+		defer cancel()
+
+		appService := api.NewApplicationService(m.state.Server)
+		fromManifests, err := appService.GetManifests(ctx, appName, appNamespace, from.Revision)
+		if err != nil {
+			return model.ApiErrorMsg{Message: "Failed to load manifests: " + err.Error(), SwitchEpoch: epoch}
+		}
+		toManifests, err := appService.GetManifests(ctx, appName, appNamespace, to.Revision)
+		if err != nil {
+			return model.ApiErrorMsg{Message: "Failed to load manifests: " + err.Error(), SwitchEpoch: epoch}
+		}
+
+		msg, changed := m.presentDiff(diffRequest{
+			Title:          fmt.Sprintf("%s - %s vs %s", appName, from.Label, to.Label),
+			FormatterTitle: appName,
+			LeftPrefix:     "from-",
+			RightPrefix:    "to-",
+			Resources:      manifestResources(fromManifests, toManifests),
+		}, epoch)
+		if !changed {
+			return model.StatusChangeMsg{Status: fmt.Sprintf("No differences between %s and %s", from.Label, to.Label)}
+		}
+		return msg
+	}
+}
+
+// manifestResources pairs the rendered manifests of two revisions by resource and
+// normalises them to YAML, ordered by kind, namespace and name so that both sides
+// line up regardless of the order the server returns.
+func manifestResources(from, to []string) []diff.Resource {
+	byKey := make(map[string]*diff.Resource)
+	var keys []string
+	add := func(manifests []string, left bool) {
+		for _, manifest := range manifests {
+			cleaned := cleanManifestToYAML(manifest)
+			if strings.TrimSpace(cleaned) == "" {
+				continue
+			}
+			kind, namespace, name := manifestIdentity(manifest)
+			key := kind + "/" + namespace + "/" + name
+			r, ok := byKey[key]
+			if !ok {
+				r = &diff.Resource{Title: diffResourceTitle(kind, namespace, name)}
+				byKey[key] = r
+				keys = append(keys, key)
+			}
+			if left {
+				r.Left = cleaned
+			} else {
+				r.Right = cleaned
+			}
+		}
+	}
+	add(from, true)
+	add(to, false)
+	sort.Strings(keys)
+
+	resources := make([]diff.Resource, len(keys))
+	for i, key := range keys {
+		resources[i] = *byKey[key]
+	}
+	return resources
+}
+
+// manifestIdentity returns the kind, namespace and name of a JSON or YAML manifest
+func manifestIdentity(manifest string) (kind, namespace, name string) {
+	var obj struct {
+		Kind     string `yaml:"kind"`
+		Metadata struct {
+			Namespace string `yaml:"namespace"`
+			Name      string `yaml:"name"`
+		} `yaml:"metadata"`
+	}
+	if err := yaml.Unmarshal([]byte(manifest), &obj); err != nil {
+		return "", "", ""
+	}
+	return obj.Kind, obj.Metadata.Namespace, obj.Metadata.Name
+}
+
+// diffManifestDocs writes both document sets to temp files and returns their
+// unified diff without the git header. An empty diff means no differences.
+func diffManifestDocs(leftPrefix, rightPrefix string, leftDocs, rightDocs []string) (leftFile, rightFile, unified string, err error) {
+	if leftFile, err = writeTempYAML(leftPrefix, leftDocs); err != nil {
+		return "", "", "", err
+	}
+	if rightFile, err = writeTempYAML(rightPrefix, rightDocs); err != nil {
+		return "", "", "", err
+	}
+
+	cmd := exec.Command("git", "--no-pager", "diff", "--no-index", "--no-color", "--", leftFile, rightFile)
+	out, err := cmd.CombinedOutput()
+	if err != nil && cmd.ProcessState != nil && cmd.ProcessState.ExitCode() != 1 {
+		return "", "", "", err
+	}
+	return leftFile, rightFile, stripDiffHeader(string(out)), nil
+}
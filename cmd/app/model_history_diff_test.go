@@ -0,0 +1,116 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+func buildHistoryTestModel() *Model {
+	m := buildDeleteTestModel(120, 30)
+	m.state.Mode = model.ModeRollback
+	m.state.Modals.RollbackAppName = &m.state.Apps[0].Name
+	m.state.Rollback = &model.RollbackState{
+		AppName:         "test-app",
+		Mode:            "list",
+		CurrentRevision: "cccccccccccccccccccccccccccccccccccccccc",
+		Rows: []model.RollbackRow{
+			{ID: 3, Revision: "cccccccccccccccccccccccccccccccccccccccc"},
+			{ID: 2, Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"},
+			{ID: 1, Revision: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"},
+		},
+	}
+	return m
+}
+
+func TestRollbackHistory_MarksPickDiffPair(t *testing.T) {
+	m := buildHistoryTestModel()
+	space := tea.KeyPressMsg{Code: tea.KeySpace, Text: " "}
+
+	// No marks: the cursor is compared with the current revision
+	m.state.Rollback.SelectedIdx = 2
+	from, to, ok := m.historyDiffRevisions()
+	if !ok || from.Revision != "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa" || to.Label != "current ccccccc" {
+		t.Fatalf("Expected cursor vs current, got %+v %+v", from, to)
+	}
+
+	// One mark: the marked entry is compared with the cursor, older on the left
+	m.handleRollbackModeKeys(space)
+	if _, _, ok := m.historyDiffRevisions(); ok {
+		t.Error("Expected no pair while the cursor is on the only mark")
+	}
+	m.state.Rollback.SelectedIdx = 1
+	from, to, _ = m.historyDiffRevisions()
+	if from.Label != "#1 aaaaaaa" || to.Label != "#2 bbbbbbb" {
+		t.Fatalf("Expected #1 vs #2, got %q vs %q", from.Label, to.Label)
+	}
+
+	// A third mark replaces the oldest
+	m.handleRollbackModeKeys(space)
+	m.state.Rollback.SelectedIdx = 0
+	m.handleRollbackModeKeys(space)
+	if got := m.state.Rollback.Marked; len(got) != 2 || got[0] != 2 || got[1] != 3 {
+		t.Fatalf("Expected marks [2 3], got %v", got)
+	}
+	from, to, _ = m.historyDiffRevisions()
+	if from.Revision != "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb" || to.Revision != "cccccccccccccccccccccccccccccccccccccccc" {
+		t.Errorf("Expected #2 vs #3, got %+v %+v", from, to)
+	}
+	if out := stripANSI(m.renderRollbackModal()); !strings.Contains(out, "● #3") || !strings.Contains(out, "Space: Mark") {
+		t.Errorf("Expected marked rows and instructions in the modal:\n%s", out)
+	}
+
+	// Space unmarks
+	m.handleRollbackModeKeys(space)
+	if got := m.state.Rollback.Marked; len(got) != 1 || got[0] != 2 {
+		t.Errorf("Expected marks [2], got %v", got)
+	}
+}
+
+func TestHistoryDiff_IdenticalManifests(t *testing.T) {
+	var revisions []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		revisions = append(revisions, r.URL.Query().Get("revision"))
+		w.Write([]byte(`{"manifests":["{\"apiVersion\":\"v1\",\"kind\":\"ConfigMap\",\"metadata\":{\"name\":\"cfg\"}}"]}`))
+	}))
+	defer server.Close()
+
+	m := buildHistoryTestModel()
+	m.state.Server = &model.Server{BaseURL: server.URL, Token: "test-token"}
+	from := historyRevision{Revision: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", Label: "#1 aaaaaaa"}
+	to := historyRevision{Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb", Label: "#2 bbbbbbb"}
+
+	msg := m.startHistoryDiffSession("test-app", nil, from, to)()
+	status, ok := msg.(model.StatusChangeMsg)
+	if !ok || !strings.Contains(status.Status, "No differences between #1 aaaaaaa and #2 bbbbbbb") {
+		t.Fatalf("Expected a no-differences status, got %#v", msg)
+	}
+	if len(revisions) != 2 || revisions[0] != "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa" || revisions[1] != "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb" {
+		t.Errorf("Expected manifests for both revisions, got %v", revisions)
+	}
+}
+
+func TestManifestResources_PairsByResource(t *testing.T) {
+	resources := manifestResources(
+		[]string{
+			`{"apiVersion":"v1","kind":"Service","metadata":{"name":"web","namespace":"prod"}}`,
+			`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"web","namespace":"prod"},"data":{"a":"1"}}`,
+		},
+		[]string{
+			`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"web","namespace":"prod"},"data":{"a":"2"}}`,
+		},
+	)
+	if len(resources) != 2 || resources[0].Title != "ConfigMap prod/web" || resources[1].Title != "Service prod/web" {
+		t.Fatalf("Expected ConfigMap then Service, got %+v", resources)
+	}
+	if !strings.Contains(resources[0].Left, `a: "1"`) || !strings.Contains(resources[0].Right, `a: "2"`) {
+		t.Errorf("Expected both ConfigMap revisions paired, got %+v", resources[0])
+	}
+	if resources[1].Right != "" {
+		t.Errorf("Expected the removed Service to have no right side, got %q", resources[1].Right)
+	}
+}
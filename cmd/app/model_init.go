@@ -94,6 +94,7 @@ func NewModel(cfg *config.ArgonautConfig) *Model {
 		treeNav:            listnav.New(),
 		themeNav:           listnav.New(),
 		rollbackNav:            listnav.New(),
+		resourceDiffNav:        listnav.New(),
 		selection:              selection.New(),
 		pendingDefaultViewScope: pendingDefaultViewScope,
 	}
@@ -195,6 +196,8 @@ func (m *Model) Init() tea.Cmd {
 		m.validateAuthentication(),
 		// Start periodic update check (delayed)
 		m.scheduleInitialUpdateCheck(),
+		// Warn before the token expires and ask to log in once it has
+		m.scheduleTokenExpiry(),
 	)
 
 	_ = context.TODO() // keep import stable if unused on some builds
@@ -214,6 +217,12 @@ func (m *Model) validateAuthentication() tea.Cmd {
 			return model.AuthValidationResultMsg{Mode: model.ModeAuthRequired, SwitchEpoch: epoch}
 		}
 
+		// An expired token would only be rejected, so ask to log in right away
+		if exp, ok := model.TokenExpiry(m.state.Server.Token); ok && !time.Now().Before(exp) {
+			cblog.With("component", "auth").Info("Auth token expired", "expiredAt", exp)
+			return model.AuthValidationResultMsg{Mode: model.ModeAuthRequired, SwitchEpoch: epoch}
+		}
+
 		// Create API service to validate authentication
 		appService := api.NewApplicationService(m.state.Server)
 		ctx, cancel := appcontext.WithAPITimeout(context.Background())
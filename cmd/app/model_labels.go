@@ -0,0 +1,89 @@
+package main
+
+import (
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+// labelItems returns the rows of the labels view: every label key, or once a key is chosen the
+// key=value selectors of its values
+func (m *Model) labelItems() []string {
+	idx := m.state.Index
+	if idx == nil {
+		return nil
+	}
+	key := m.state.Selections.LabelKey
+	if key == "" {
+		return idx.LabelKeys
+	}
+	values := idx.LabelValues[key]
+	items := make([]string, 0, len(values))
+	for _, v := range values {
+		items = append(items, model.LabelSelector(key, v))
+	}
+	return items
+}
+
+// labelAppCount returns how many apps carry a label key, or a key=value selector
+func (m *Model) labelAppCount(item string) int {
+	idx := m.state.Index
+	if idx == nil {
+		return 0
+	}
+	if strings.Contains(item, "=") {
+		return len(idx.ByLabel[item])
+	}
+	count := 0
+	for _, v := range idx.LabelValues[item] {
+		count += len(idx.ByLabel[model.LabelSelector(item, v)])
+	}
+	return count
+}
+
+// resolveLabelArg matches a :label argument, either a key or a key=value selector, against the
+// labels of the loaded apps. Keys match case-insensitively.
+func (m *Model) resolveLabelArg(arg string) (key, selector string, ok bool) {
+	idx := m.state.Index
+	if idx == nil {
+		return "", "", false
+	}
+	want, value, hasValue := strings.Cut(arg, "=")
+	for _, k := range idx.LabelKeys {
+		if !strings.EqualFold(k, want) {
+			continue
+		}
+		if !hasValue {
+			return k, "", true
+		}
+		selector := model.LabelSelector(k, value)
+		if len(idx.ByLabel[selector]) > 0 {
+			return k, selector, true
+		}
+	}
+	return "", "", false
+}
+
+// handleLabelCommand opens the labels view: without an argument at the label keys, with a key
+// at that key's values, and with a key=value selector straight at the matching apps
+func (m *Model) handleLabelCommand(arg string) (tea.Model, tea.Cmd) {
+	m.state.Selections.ScopeLabels = model.NewStringSet()
+	m.state.Selections.LabelKey = ""
+	if arg == "" {
+		m = m.safeChangeView(model.ViewLabels)
+		return m, nil
+	}
+	key, selector, ok := m.resolveLabelArg(arg)
+	if !ok {
+		return m, func() tea.Msg { return model.StatusChangeMsg{Status: "Unknown label: " + arg} }
+	}
+	m.state.Selections.LabelKey = key
+	if selector == "" {
+		m = m.safeChangeView(model.ViewLabels)
+		return m, nil
+	}
+	m.state.Selections.ScopeLabels = model.StringSetFromSlice([]string{selector})
+	m = m.safeChangeView(model.ViewApps)
+	return m, nil
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+func buildLabelsTestModel() *Model {
+	m := buildDeleteTestModel(140, 30)
+	m.state.Apps[0].Labels = map[string]string{"team": "core", "env": "prod"}
+	m.state.Apps[1].Labels = map[string]string{"team": "web"}
+	m.state.Index = model.BuildAppIndex(m.state.Apps)
+	runTestCommand(m, "labels")
+	return m
+}
+
+func TestLabels_DrillDownAndBack(t *testing.T) {
+	m := buildLabelsTestModel()
+	if m.state.Navigation.View != model.ViewLabels {
+		t.Fatalf("Expected labels view, got %s", m.state.Navigation.View)
+	}
+	if items := m.getVisibleItems(); len(items) != 2 || items[0] != "env" || items[1] != "team" {
+		t.Fatalf("Expected the label keys, got %v", items)
+	}
+
+	// Key → values
+	m.state.Navigation.SelectedIdx = 1
+	m.handleKeyMsg(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if m.state.Navigation.View != model.ViewLabels || m.state.Selections.LabelKey != "team" {
+		t.Fatalf("Expected the values of team, got view %s key %q", m.state.Navigation.View, m.state.Selections.LabelKey)
+	}
+	if items := m.getVisibleItems(); len(items) != 2 || items[0] != "team=core" || items[1] != "team=web" {
+		t.Fatalf("Expected the team values, got %v", items)
+	}
+	if out := m.renderListView(20); !strings.Contains(out, "team=core") || !strings.Contains(out, "APPS") {
+		t.Errorf("Expected the values table, got:\n%s", out)
+	}
+
+	// Value → apps
+	m.handleKeyMsg(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if m.state.Navigation.View != model.ViewApps {
+		t.Fatalf("Expected apps view after drill-down, got %s", m.state.Navigation.View)
+	}
+	apps := m.getVisibleItems()
+	if len(apps) != 1 || apps[0].(model.App).Name != "test-app" {
+		t.Fatalf("Expected only test-app in the label scope, got %v", apps)
+	}
+	if !strings.Contains(m.renderStatusLine(), "<apps in team=core>") {
+		t.Errorf("Expected the label breadcrumb, got %s", m.renderStatusLine())
+	}
+
+	// Esc walks back up: apps → values → keys
+	m.handleKeyMsg(testKeyMsg("esc"))
+	if m.state.Navigation.View != model.ViewLabels || m.state.Selections.LabelKey != "team" || len(m.state.Selections.ScopeLabels) != 0 {
+		t.Fatalf("Expected esc to return to the team values, got view %s key %q", m.state.Navigation.View, m.state.Selections.LabelKey)
+	}
+	m.state.Navigation.LastEscPressed = 0 // skip the escape debounce
+	m.handleKeyMsg(testKeyMsg("esc"))
+	if m.state.Selections.LabelKey != "" {
+		t.Errorf("Expected esc to return to the label keys, got key %q", m.state.Selections.LabelKey)
+	}
+}
+
+func TestLabels_LabelCommand(t *testing.T) {
+	m := buildLabelsTestModel()
+
+	runTestCommand(m, "label Team")
+	if m.state.Navigation.View != model.ViewLabels || m.state.Selections.LabelKey != "team" {
+		t.Fatalf("Expected the values of team, got view %s key %q", m.state.Navigation.View, m.state.Selections.LabelKey)
+	}
+
+	runTestCommand(m, "label team=web")
+	if m.state.Navigation.View != model.ViewApps || !m.state.Selections.HasLabel("team=web") {
+		t.Fatalf("Expected apps scoped to team=web, got view %s scope %v", m.state.Navigation.View, m.state.Selections.ScopeLabels)
+	}
+	if m.validateCommand(":label team=unknown") || m.validateCommand(":label owner") {
+		t.Error("Expected unknown labels to be invalid")
+	}
+}
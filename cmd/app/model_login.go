@@ -0,0 +1,157 @@
+package main
+
+import (
+	"context"
+	stdErrors "errors"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	cblog "github.com/charmbracelet/log"
+	"github.com/darksworm/argonaut/pkg/api"
+	"github.com/darksworm/argonaut/pkg/config"
+	appcontext "github.com/darksworm/argonaut/pkg/context"
+	apperrors "github.com/darksworm/argonaut/pkg/errors"
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+// ensureLoginForm returns the login form of the auth-required view, creating it on first use.
+// It returns nil when there is no server to log in to (e.g. no context configured).
+func (m *Model) ensureLoginForm() *model.LoginState {
+	if m.state.Login == nil {
+		login := &model.LoginState{Server: m.state.Server}
+		if login.Server == nil && m.argoConfigPath != "" && m.currentContextName != "" {
+			// Started without a token: the context still names the server to log in to
+			if cfg, err := config.ReadCLIConfigFromPath(m.argoConfigPath); err == nil {
+				login.Server, _ = cfg.LoginServerForContext(m.currentContextName)
+			}
+		}
+		if login.Server != nil {
+			login.Username = login.Server.Username
+		}
+		m.state.Login = login
+	}
+	if m.state.Login.Server == nil {
+		return nil
+	}
+	return m.state.Login
+}
+
+// handleLoginKeys edits and submits the login form
+func (m *Model) handleLoginKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	login := m.state.Login
+	if login.Submitting {
+		return m, nil
+	}
+	field := &login.Username
+	if login.Field == model.LoginFieldPassword {
+		field = &login.Password
+	}
+	switch msg.String() {
+	case "tab", "shift+tab", "up", "down":
+		login.Field = 1 - login.Field
+	case "enter":
+		if login.Field == model.LoginFieldUsername && login.Password == "" {
+			login.Field = model.LoginFieldPassword
+			return m, nil
+		}
+		return m, m.submitLogin()
+	case "backspace":
+		if r := []rune(*field); len(r) > 0 {
+			*field = string(r[:len(r)-1])
+		}
+	case "ctrl+u":
+		*field = ""
+	default:
+		// Passwords may contain spaces, usernames may not
+		if key, ok := msg.(tea.KeyPressMsg); ok && key.Text != "" && (key.Text != " " || login.Field == model.LoginFieldPassword) {
+			*field += key.Text
+		}
+	}
+	return m, nil
+}
+
+// submitLogin posts the form's credentials to the session API
+func (m *Model) submitLogin() tea.Cmd {
+	login := m.state.Login
+	login.Username = strings.TrimSpace(login.Username)
+	if login.Username == "" || login.Password == "" {
+		login.Error = "Enter a username and password"
+		return nil
+	}
+	login.Submitting = true
+	login.Error = ""
+
+	server := login.Server
+	username, password := login.Username, login.Password
+	epoch := m.switchEpoch // capture at call time
+	return func() tea.Msg {
+		ctx, cancel := appcontext.WithAPITimeout(context.Background())
+		defer cancel()
+
+		token, err := api.NewSessionService(server).CreateSession(ctx, username, password)
+		if err != nil {
+			cblog.With("component", "auth").Error("Login failed", "user", username, "err", err)
+		}
+		return model.LoginResultMsg{Username: username, Token: token, Err: err, SwitchEpoch: epoch}
+	}
+}
+
+// handleLoginResult stores the new token and resumes loading and watching apps
+func (m *Model) handleLoginResult(msg model.LoginResultMsg) (tea.Model, tea.Cmd) {
+	login := m.state.Login
+	if msg.SwitchEpoch != m.switchEpoch || login == nil || login.Server == nil {
+		return m, nil
+	}
+	login.Submitting = false
+	login.Password = ""
+	if msg.Err != nil {
+		login.Field = model.LoginFieldPassword
+		var argErr *apperrors.ArgonautError
+		if stdErrors.As(msg.Err, &argErr) && argErr.IsCategory(apperrors.ErrorAuth) {
+			login.Error = "Invalid username or password"
+		} else {
+			login.Error = extractUserFriendlyError(msg.Err)
+		}
+		return m, nil
+	}
+
+	server := *login.Server
+	server.Token = msg.Token
+	server.Username = msg.Username
+	m.state.Server = &server
+	m.state.Login = nil
+	m.err = nil
+
+	// Keep the token for the next start and for the argocd CLI; the session works regardless
+	if m.argoConfigPath != "" && m.currentContextName != "" {
+		if err := config.SaveAuthToken(m.argoConfigPath, m.currentContextName, msg.Token); err != nil {
+			cblog.With("component", "auth").Warn("Could not save auth token", "err", err)
+			m.statusService.Warn("Logged in, but the token could not be saved: " + err.Error())
+		} else {
+			m.statusService.Set("Logged in as " + msg.Username)
+		}
+	}
+
+	if m.currentContextName != "" {
+		if m.state.ContextTokens == nil {
+			m.state.ContextTokens = make(map[string]model.TokenStatus)
+		}
+		m.state.ContextTokens[m.currentContextName] = model.TokenStatusOf(msg.Token)
+	}
+
+	cmds := []tea.Cmd{func() tea.Msg { return model.SetModeMsg{Mode: model.ModeLoading} }, m.scheduleTokenExpiry()}
+	if len(m.state.Apps) == 0 {
+		cmds = append(cmds, func() tea.Msg { return model.SetInitialLoadingMsg{Loading: true} })
+	}
+	if m.watchChan != nil {
+		// The stream that hit the 401 is gone; the first load only starts a watch when none ever ran.
+		// Stop its forwarder first, then the SSE stream, as a context switch does.
+		m.cleanupAppWatcher()
+		if m.watchCleanup != nil {
+			m.watchCleanup()
+			m.watchCleanup = nil
+		}
+		cmds = append(cmds, m.startWatchingApplications())
+	}
+	return m, tea.Batch(cmds...)
+}
@@ -0,0 +1,169 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/darksworm/argonaut/pkg/config"
+	"github.com/darksworm/argonaut/pkg/model"
+	"github.com/darksworm/argonaut/pkg/services"
+)
+
+// newLoginTestServer accepts admin/secret on the session endpoint
+func newLoginTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v1/session" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		var body struct{ Username, Password string }
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if body.Username != "admin" || body.Password != "s3cret pw" {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"error": "Invalid username or password", "code": 16, "message": "Invalid username or password"}`)
+			return
+		}
+		fmt.Fprint(w, `{"token": "fresh-token"}`)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// buildLoginTestModel starts like argonaut does when the context has no token yet
+func buildLoginTestModel(t *testing.T, serverURL string) *Model {
+	t.Helper()
+	configPath := filepath.Join(t.TempDir(), "config")
+	host := strings.TrimPrefix(serverURL, "http://")
+	cfg := fmt.Sprintf(`contexts:
+  - name: prod
+    server: %s
+    user: prod-user
+current-context: prod
+servers:
+  - server: %s
+    plain-text: true
+users:
+  - name: prod-user
+`, host, host)
+	if err := os.WriteFile(configPath, []byte(cfg), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	m := buildDeleteTestModel(120, 30)
+	m.state.Apps = nil
+	m.state.Server = nil
+	m.argoConfigPath = configPath
+	m.currentContextName = "prod"
+	m.state.Mode = model.ModeAuthRequired
+	return m
+}
+
+func typeText(m *Model, text string) {
+	for _, r := range text {
+		m.handleKeyMsg(tea.KeyPressMsg{Code: r, Text: string(r)})
+	}
+}
+
+func TestLogin_StoresTokenAndResumesLoading(t *testing.T) {
+	server := newLoginTestServer(t)
+	m := buildLoginTestModel(t, server.URL)
+
+	if out := m.renderAuthRequiredView(); !strings.Contains(out, "Log in to "+server.URL) {
+		t.Fatalf("Expected the login form, got:\n%s", out)
+	}
+
+	typeText(m, "admin")
+	m.handleKeyMsg(tea.KeyPressMsg{Code: tea.KeyEnter}) // moves to the password field
+	typeText(m, "s3cret pw")
+	if m.state.Login.Username != "admin" || m.state.Login.Password != "s3cret pw" {
+		t.Fatalf("Unexpected form values %q / %q", m.state.Login.Username, m.state.Login.Password)
+	}
+	if out := m.renderAuthRequiredView(); strings.Contains(out, "s3cret") {
+		t.Fatal("Expected the password to be masked")
+	}
+
+	_, cmd := m.handleKeyMsg(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if cmd == nil || !m.state.Login.Submitting {
+		t.Fatal("Expected enter to submit the form")
+	}
+	m.Update(cmd())
+
+	if m.state.Server == nil || m.state.Server.Token != "fresh-token" || m.state.Login != nil {
+		t.Fatalf("Expected the session token to be used, got server %+v", m.state.Server)
+	}
+	cfg, err := config.ReadCLIConfigFromPath(m.argoConfigPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token, _ := cfg.GetCurrentToken(); token != "fresh-token" {
+		t.Errorf("Expected the token to be saved to the CLI config, got %q", token)
+	}
+}
+
+func TestLogin_InvalidCredentials(t *testing.T) {
+	server := newLoginTestServer(t)
+	m := buildLoginTestModel(t, server.URL)
+
+	typeText(m, "admin")
+	m.handleKeyMsg(tea.KeyPressMsg{Code: tea.KeyTab})
+	typeText(m, "wrong")
+	_, cmd := m.handleKeyMsg(tea.KeyPressMsg{Code: tea.KeyEnter})
+	m.Update(cmd())
+
+	login := m.state.Login
+	if login == nil || login.Error != "Invalid username or password" || login.Password != "" || m.state.Server != nil {
+		t.Fatalf("Expected an invalid credentials error and a cleared password, got %+v", login)
+	}
+
+	// q is typed into the form instead of quitting
+	typeText(m, "q")
+	if login.Password != "q" {
+		t.Errorf("Expected q to be typed into the password, got %q", login.Password)
+	}
+}
+
+func TestLogin_ReauthAfterSessionExpired(t *testing.T) {
+	server := newLoginTestServer(t)
+	m := buildLoginTestModel(t, server.URL)
+	m.state.Server = &model.Server{BaseURL: server.URL, Token: "expired-token"}
+	m.state.Apps = []model.App{{Name: "test-app"}}
+
+	if out := m.renderAuthRequiredView(); !strings.Contains(out, "Your session expired") {
+		t.Fatalf("Expected the re-auth prompt, got:\n%s", out)
+	}
+
+	// A result from a previous context is ignored
+	m.ensureLoginForm()
+	m.Update(model.LoginResultMsg{Token: "other", SwitchEpoch: m.switchEpoch + 1})
+	if m.state.Server.Token != "expired-token" {
+		t.Fatal("Expected a stale login result to be ignored")
+	}
+}
+
+func TestLogin_RestartsWatchAfterStoppingTheOldOne(t *testing.T) {
+	server := newLoginTestServer(t)
+	m := buildLoginTestModel(t, server.URL)
+	m.state.Server = &model.Server{BaseURL: server.URL, Token: "expired-token"}
+	m.state.Apps = []model.App{{Name: "test-app"}}
+
+	var stopped []string
+	m.watchChan = make(chan services.ArgoApiEvent)
+	m.appWatchCleanup = func() { stopped = append(stopped, "forwarder") }
+	m.watchCleanup = func() { stopped = append(stopped, "stream") }
+
+	m.ensureLoginForm()
+	m.Update(model.LoginResultMsg{Token: "fresh-token", Username: "admin", SwitchEpoch: m.switchEpoch})
+	if strings.Join(stopped, ",") != "forwarder,stream" {
+		t.Fatalf("Expected the forwarder and then the stream to be stopped, got %v", stopped)
+	}
+	if m.appWatchCleanup != nil || m.watchCleanup != nil {
+		t.Error("Expected the old watch cleanups to be cleared")
+	}
+}
@@ -0,0 +1,237 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	cblog "github.com/charmbracelet/log"
+	"github.com/darksworm/argonaut/pkg/api"
+	appcontext "github.com/darksworm/argonaut/pkg/context"
+	"github.com/darksworm/argonaut/pkg/model"
+	yaml "gopkg.in/yaml.v3"
+)
+
+// handleOpenManifest opens the manifest viewer for the tree node under the cursor
+func (m *Model) handleOpenManifest() (tea.Model, tea.Cmd) {
+	if m.state.Navigation.View != model.ViewTree || m.treeView == nil {
+		return m, nil
+	}
+	res, ok := m.treeView.CurrentResource()
+	if !ok {
+		return m, func() tea.Msg {
+			return model.StatusChangeMsg{Status: "Select a resource to view its manifest"}
+		}
+	}
+
+	manifest := &model.ManifestState{
+		Target: model.ResourceActionTarget{
+			AppName:   res.AppName,
+			Group:     res.Group,
+			Version:   res.Version,
+			Kind:      res.Kind,
+			Namespace: res.Namespace,
+			Name:      res.Name,
+		},
+		// A missing resource has no live object, only the desired one
+		ShowDesired: res.IsMissing(),
+	}
+	for i := range m.state.Apps {
+		if m.state.Apps[i].Name == res.AppName {
+			manifest.Target.AppNamespace = m.state.Apps[i].AppNamespace
+			break
+		}
+	}
+
+	cblog.With("component", "manifest").Debug("Opening manifest", "kind", res.Kind, "name", res.Name)
+	return m, m.openManifest(manifest)
+}
+
+// openManifest switches to the manifest viewer and starts loading
+func (m *Model) openManifest(manifest *model.ManifestState) tea.Cmd {
+	m.manifestSession++
+	manifest.Session = m.manifestSession
+	manifest.Loading = true
+	manifest.Error = ""
+	m.state.Manifest = manifest
+	m.state.Mode = model.ModeManifest
+	return m.loadManifest(*manifest)
+}
+
+// loadManifest fetches the live object and the desired manifest of a resource
+func (m *Model) loadManifest(manifest model.ManifestState) tea.Cmd {
+	epoch := m.switchEpoch // capture at call time
+	target := manifest.Target
+	return func() tea.Msg {
+		if m.state.Server == nil {
+			return model.ManifestLoadedMsg{Session: manifest.Session, Err: fmt.Errorf("no server configured"), SwitchEpoch: epoch}
+		}
+		ctx, cancel := appcontext.WithAPITimeout(context.Background())
+		defer cancel()
+
+		appService := api.NewApplicationService(m.state.Server)
+
+		// Missing resources have no live object; the desired manifest is still worth showing
+		live, liveErr := appService.GetResource(ctx, resourceParams(target))
+		if liveErr != nil {
+			cblog.With("component", "manifest").Error("Failed to get resource", "kind", target.Kind, "name", target.Name, "err", liveErr)
+		}
+
+		// The desired manifest is best effort; unmanaged resources (e.g. Pods) have none
+		desired := ""
+		if diffs, err := appService.GetManagedResourceDiffs(ctx, target.AppName); err != nil {
+			cblog.With("component", "manifest").Warn("Failed to get managed resources", "app", target.AppName, "err", err)
+		} else {
+			for _, d := range diffs {
+				if d.Group == target.Group && d.Kind == target.Kind && d.Namespace == target.Namespace && d.Name == target.Name {
+					desired = d.TargetState
+					break
+				}
+			}
+		}
+
+		if live == "" && desired == "" {
+			if liveErr != nil {
+				return model.ManifestLoadedMsg{Session: manifest.Session, Err: liveErr, SwitchEpoch: epoch}
+			}
+			return model.ManifestLoadedMsg{Session: manifest.Session, Err: fmt.Errorf("no manifest available for %s/%s", target.Kind, target.Name), SwitchEpoch: epoch}
+		}
+		return model.ManifestLoadedMsg{Session: manifest.Session, Live: live, Desired: desired, SwitchEpoch: epoch}
+	}
+}
+
+// handleManifestLoaded stores loaded manifests and renders the current view
+func (m *Model) handleManifestLoaded(msg model.ManifestLoadedMsg) (tea.Model, tea.Cmd) {
+	// Gate by switch epoch and by the viewer that requested the load
+	if msg.SwitchEpoch != m.switchEpoch || m.state.Manifest == nil || m.state.Manifest.Session != msg.Session {
+		return m, nil
+	}
+	manifest := m.state.Manifest
+	manifest.Loading = false
+	if msg.Err != nil {
+		manifest.Error = extractUserFriendlyError(msg.Err)
+		return m, nil
+	}
+	manifest.Live = msg.Live
+	manifest.Desired = msg.Desired
+	if manifest.Live == "" {
+		manifest.ShowDesired = true
+	} else if manifest.Desired == "" {
+		manifest.ShowDesired = false
+	}
+	m.renderManifestContent()
+	return m, nil
+}
+
+// renderManifestContent converts the selected manifest to YAML lines for the viewer
+func (m *Model) renderManifestContent() {
+	manifest := m.state.Manifest
+	if manifest == nil {
+		return
+	}
+	src := manifest.Live
+	if manifest.ShowDesired {
+		src = manifest.Desired
+	}
+	if strings.TrimSpace(src) == "" {
+		manifest.Content = nil
+		return
+	}
+	var text string
+	if manifest.Neat {
+		text = cleanManifestToYAML(src)
+	} else {
+		text = manifestToYAML(src)
+	}
+	manifest.Content = strings.Split(strings.TrimRight(text, "\n"), "\n")
+}
+
+// manifestToYAML converts a JSON or YAML manifest to YAML without cleaning it
+func manifestToYAML(jsonOrYaml string) string {
+	var obj interface{}
+	if err := json.Unmarshal([]byte(jsonOrYaml), &obj); err != nil {
+		if err := yaml.Unmarshal([]byte(jsonOrYaml), &obj); err != nil {
+			return jsonOrYaml
+		}
+	}
+	yamlBytes, err := yaml.Marshal(obj)
+	if err != nil {
+		return jsonOrYaml
+	}
+	return string(yamlBytes)
+}
+
+// manifestTitle returns the viewer title for the current toggles
+func manifestTitle(manifest *model.ManifestState) string {
+	t := manifest.Target
+	resource := t.Kind + "/" + t.Name
+	if t.Namespace != "" {
+		resource = t.Namespace + "/" + resource
+	}
+	source := "Live"
+	if manifest.ShowDesired {
+		source = "Desired"
+	}
+	form := "raw"
+	if manifest.Neat {
+		form = "neat"
+	}
+	return fmt.Sprintf("%s - %s (%s)", resource, source, form)
+}
+
+// handleManifestModeKeys handles non-navigation input in the manifest viewer.
+// Navigation keys (up/k, down/j, pgup, pgdown, g, G) are handled by the centralized router.
+func (m *Model) handleManifestModeKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	manifest := m.state.Manifest
+	if manifest == nil {
+		m.state.Mode = model.ModeNormal
+		return m, nil
+	}
+	switch msg.String() {
+	case "q", "esc":
+		m.state.Manifest = nil
+		m.state.Mode = model.ModeNormal
+		return m, nil
+	case "n":
+		manifest.Neat = !manifest.Neat
+		m.renderManifestContent()
+		return m, nil
+	case "d":
+		if manifest.Loading {
+			return m, nil
+		}
+		if !manifest.ShowDesired && manifest.Desired == "" {
+			m.statusService.Set("No desired manifest: resource is not managed by the application")
+			return m, nil
+		}
+		if manifest.ShowDesired && manifest.Live == "" {
+			m.statusService.Set("No live object: resource is missing from the cluster")
+			return m, nil
+		}
+		manifest.ShowDesired = !manifest.ShowDesired
+		manifest.Offset = 0
+		m.renderManifestContent()
+		return m, nil
+	case "r":
+		// Reload keeping the current toggles
+		reload := *manifest
+		reload.Live, reload.Desired, reload.Content = "", "", nil
+		return m, m.openManifest(&reload)
+	case "o":
+		// Open the current manifest in the external pager
+		if len(manifest.Content) == 0 {
+			return m, nil
+		}
+		return m, m.openTextPager(manifestTitle(manifest), strings.Join(manifest.Content, "\n"))
+	}
+	return m, nil
+}
+
+// manifestPageSize returns the number of visible lines in the manifest viewer
+func (m *Model) manifestPageSize() int {
+	// title + status + content border + main container padding
+	overhead := 5
+	return max(3, m.state.Terminal.Rows-overhead)
+}
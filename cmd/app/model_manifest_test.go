@@ -0,0 +1,77 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+const testLiveDeployment = `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"web","namespace":"default","uid":"abc","managedFields":[{"manager":"kubectl"}]},"spec":{"replicas":2},"status":{"readyReplicas":2}}`
+const testDesiredDeployment = `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"web","namespace":"default"},"spec":{"replicas":3}}`
+
+func TestHandleOpenManifest_TargetsCursorResource(t *testing.T) {
+	m := buildActionsTestModel()
+
+	_, cmd := m.handleOpenManifest()
+	if cmd == nil {
+		t.Fatal("Expected a command to load the manifest")
+	}
+	if m.state.Mode != model.ModeManifest || m.state.Manifest == nil {
+		t.Fatalf("Expected manifest viewer to open, got %s", m.state.Mode)
+	}
+	target := m.state.Manifest.Target
+	if target.Kind != "Deployment" || target.Name != "web" || target.Group != "apps" || target.Namespace != "default" {
+		t.Errorf("Unexpected target: %+v", target)
+	}
+	if m.state.Manifest.ShowDesired || m.state.Manifest.Neat {
+		t.Error("Expected raw live manifest by default")
+	}
+}
+
+func TestManifestViewer_Toggles(t *testing.T) {
+	m := buildActionsTestModel()
+	m.handleOpenManifest()
+	session := m.state.Manifest.Session
+
+	m.Update(model.ManifestLoadedMsg{Session: session, Live: testLiveDeployment, Desired: testDesiredDeployment, SwitchEpoch: m.switchEpoch})
+	content := strings.Join(m.state.Manifest.Content, "\n")
+	if !strings.Contains(content, "readyReplicas: 2") || !strings.Contains(content, "managedFields") {
+		t.Fatalf("Expected raw live manifest with status, got:\n%s", content)
+	}
+
+	// Neat drops status and noisy metadata
+	m.handleManifestModeKeys(testKeyMsg("n"))
+	content = strings.Join(m.state.Manifest.Content, "\n")
+	if strings.Contains(content, "status:") || strings.Contains(content, "managedFields") {
+		t.Errorf("Expected neat manifest without status and managedFields, got:\n%s", content)
+	}
+
+	m.handleManifestModeKeys(testKeyMsg("d"))
+	content = strings.Join(m.state.Manifest.Content, "\n")
+	if !m.state.Manifest.ShowDesired || !strings.Contains(content, "replicas: 3") {
+		t.Errorf("Expected desired manifest, got:\n%s", content)
+	}
+
+	m.handleManifestModeKeys(testKeyMsg("esc"))
+	if m.state.Mode != model.ModeNormal || m.state.Manifest != nil {
+		t.Error("Expected esc to close the manifest viewer")
+	}
+}
+
+func TestManifestViewer_UnmanagedResourceStaysOnLive(t *testing.T) {
+	m := buildActionsTestModel()
+	m.handleOpenManifest()
+	m.Update(model.ManifestLoadedMsg{Session: m.state.Manifest.Session, Live: testLiveDeployment, SwitchEpoch: m.switchEpoch})
+
+	m.handleManifestModeKeys(testKeyMsg("d"))
+	if m.state.Manifest.ShowDesired {
+		t.Error("Expected to stay on the live manifest when there is no desired one")
+	}
+
+	// Loads from a previous viewer are ignored
+	m.Update(model.ManifestLoadedMsg{Session: m.state.Manifest.Session - 1, Live: testDesiredDeployment, SwitchEpoch: m.switchEpoch})
+	if m.state.Manifest.Live != testLiveDeployment {
+		t.Error("Expected stale manifest load to be ignored")
+	}
+}
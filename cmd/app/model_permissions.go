@@ -0,0 +1,152 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	tea "charm.land/bubbletea/v2"
+	cblog "github.com/charmbracelet/log"
+	"github.com/darksworm/argonaut/pkg/api"
+	appcontext "github.com/darksworm/argonaut/pkg/context"
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+// ensurePermissions runs the can-i checks for the apps' projects after the first app load.
+// Projects that appear later are treated as allowed until the next context load.
+func (m *Model) ensurePermissions() tea.Cmd {
+	if m.permissionsSession != 0 || m.state.Index == nil {
+		return nil
+	}
+	m.permissionsSession++
+	projects := make(map[string][]string, len(m.state.Index.Projects))
+	for _, app := range m.state.Apps {
+		project := ""
+		if app.Project != nil {
+			project = *app.Project
+		}
+		projects[project] = append(projects[project], app.Name)
+	}
+	return m.loadPermissions(m.permissionsSession, append([]string(nil), m.state.Index.Projects...), projects)
+}
+
+// loadPermissions checks every checked operation in every project. A project-wide "no" does
+// not deny every app, since policies may allow some apps (proj/app-*), so the apps of the
+// project are then checked one by one. Each check has its own timeout, and a failed check
+// leaves only that operation unchecked.
+func (m *Model) loadPermissions(session int, projects []string, appsByProject map[string][]string) tea.Cmd {
+	epoch := m.switchEpoch // capture at call time
+	return func() tea.Msg {
+		if m.state.Server == nil {
+			return model.PermissionsLoadedMsg{Session: session, Err: fmt.Errorf("no server configured"), SwitchEpoch: epoch}
+		}
+
+		account := api.NewAccountService(m.state.Server)
+		perms := make(model.Permissions)
+		var failures []model.PermissionCheckError
+		for _, project := range projects {
+			done := make(map[string]bool) // Operations share RBAC actions, e.g. sync and rollback
+			for _, op := range model.CheckedOperations {
+				action := op.RBACAction()
+				if done[action] {
+					continue
+				}
+				done[action] = true
+				allowed, err := canI(account, action, project+"/*")
+				if err != nil {
+					failures = append(failures, model.PermissionCheckError{Project: project, Action: action, Err: err})
+					continue
+				}
+				if allowed {
+					perms.Set(project, action, true)
+					continue
+				}
+				for _, appName := range appsByProject[project] {
+					object := model.AppRBACObject(project, appName)
+					allowed, err := canI(account, action, object)
+					if err != nil {
+						failures = append(failures, model.PermissionCheckError{Project: project, App: appName, Action: action, Err: err})
+						break
+					}
+					perms.Set(object, action, allowed)
+				}
+			}
+		}
+		return model.PermissionsLoadedMsg{Session: session, Permissions: perms, Failures: failures, SwitchEpoch: epoch}
+	}
+}
+
+// canI runs one can-i check on applications with its own timeout
+func canI(account *api.AccountService, action, object string) (bool, error) {
+	ctx, cancel := appcontext.WithAPITimeout(context.Background())
+	defer cancel()
+	return account.CanI(ctx, "applications", action, object)
+}
+
+// handlePermissionsLoaded stores the can-i results
+func (m *Model) handlePermissionsLoaded(msg model.PermissionsLoadedMsg) (tea.Model, tea.Cmd) {
+	if msg.SwitchEpoch != m.switchEpoch || msg.Session != m.permissionsSession {
+		return m, nil
+	}
+	if msg.Err != nil {
+		// Without can-i results every operation stays enabled and the server has the final say
+		cblog.With("component", "permissions").Debug("Failed to check permissions", "err", msg.Err)
+		return m, nil
+	}
+	for _, f := range msg.Failures {
+		// Failed checks stay unchecked, so the operation stays enabled
+		cblog.With("component", "permissions").Debug("Failed to check permission",
+			"project", f.Project, "app", f.App, "action", f.Action, "err", f.Err)
+	}
+	m.state.Permissions = m.state.Permissions.Merge(msg.Permissions)
+	return m, nil
+}
+
+// appProject returns the project of an app, or "" if unknown
+func (m *Model) appProject(appName string) string {
+	for _, app := range m.state.Apps {
+		if app.Name == appName && app.Project != nil {
+			return *app.Project
+		}
+	}
+	return ""
+}
+
+// operationDenied returns a status message explaining why if the user's role forbids op on any
+// of the apps, or nil if it is allowed on all of them
+func (m *Model) operationDenied(op model.AppOperation, appNames ...string) tea.Cmd {
+	for _, name := range appNames {
+		project := m.appProject(name)
+		if !m.state.Permissions.AllowedForApp(project, name, op.RBACAction()) {
+			status := fmt.Sprintf("Not permitted: your role cannot %s %s in project %s", op, name, project)
+			return func() tea.Msg { return model.StatusChangeMsg{Status: status} }
+		}
+	}
+	return nil
+}
+
+// selectedAppNames returns the names of the checked apps
+func (m *Model) selectedAppNames() []string {
+	names := make([]string, 0, len(m.state.Selections.SelectedApps))
+	for name, ok := range m.state.Selections.SelectedApps {
+		if ok {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// operationAvailable reports whether op is allowed in at least one project of the loaded apps
+func (m *Model) operationAvailable(op model.AppOperation) bool {
+	return m.state.Permissions.OperationAvailable(m.state.Apps, op)
+}
+
+// resourceActionAllowed reports whether the user's role allows a resource action on the target
+// of the resource actions modal
+func (m *Model) resourceActionAllowed(action string) bool {
+	target := m.state.Modals.ResourceActionTarget
+	if target == nil {
+		return true
+	}
+	project := m.appProject(target.AppName)
+	return m.state.Permissions.AllowedForApp(project, target.AppName, model.ResourceActionRBAC(target.Group, target.Kind, action))
+}
@@ -0,0 +1,174 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+func TestPermissions_LoadedPerProject(t *testing.T) {
+	var checked []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		checked = append(checked, strings.TrimPrefix(r.URL.Path, "/api/v1/account/can-i/applications/"))
+		if strings.Contains(r.URL.Path, "/sync/") || strings.Contains(r.URL.Path, "/delete/") {
+			fmt.Fprint(w, `{"value": "no"}`)
+			return
+		}
+		fmt.Fprint(w, `{"value": "yes"}`)
+	}))
+	defer server.Close()
+
+	m := buildDeleteTestModel(100, 30)
+	m.state.Server = &model.Server{BaseURL: server.URL, Token: "test-token"}
+	m.state.Index = model.BuildAppIndex(m.state.Apps)
+
+	cmd := m.ensurePermissions()
+	if cmd == nil || m.ensurePermissions() != nil {
+		t.Fatal("Expected the checks to start once")
+	}
+	m.Update(cmd())
+
+	// sync and rollback share the sync check; a project-wide "no" is asked again for the app
+	want := []string{"sync/test-project/*", "sync/test-project/test-app", "delete/test-project/*", "delete/test-project/test-app", "get/test-project/*", "update/test-project/*"}
+	if strings.Join(checked, " ") != strings.Join(want, " ") {
+		t.Fatalf("Expected checks %v, got %v", want, checked)
+	}
+	perms := m.state.Permissions
+	if perms.OperationAllowedForApp(m.state.Apps[0], model.OpRollback) || !perms.OperationAllowedForApp(m.state.Apps[0], model.OpRefresh) {
+		t.Fatalf("Unexpected permissions %v", perms)
+	}
+	if perms.Checked("test-project", "sync") {
+		t.Error("Expected a project-wide no to leave the project unchecked")
+	}
+}
+
+func TestPermissions_AppScopedPolicy(t *testing.T) {
+	// The role may only sync apps named test-*, so proj/* is denied
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/api/v1/account/can-i/applications/sync/test-project/test-") {
+			fmt.Fprint(w, `{"value": "yes"}`)
+			return
+		}
+		fmt.Fprint(w, `{"value": "no"}`)
+	}))
+	defer server.Close()
+
+	m := buildDeleteTestModel(100, 30)
+	project := "test-project"
+	m.state.Apps[1].Project = &project
+	m.state.Server = &model.Server{BaseURL: server.URL, Token: "test-token"}
+	m.state.Index = model.BuildAppIndex(m.state.Apps)
+	m.Update(m.ensurePermissions()())
+
+	perms := m.state.Permissions
+	if !perms.OperationAllowedForApp(m.state.Apps[0], model.OpSync) || perms.OperationAllowedForApp(m.state.Apps[1], model.OpSync) {
+		t.Fatalf("Expected sync to be allowed on test-app only, got %v", perms)
+	}
+	if cmd := m.operationDenied(model.OpSync, "test-app"); cmd != nil {
+		t.Fatal("Expected test-app to be syncable")
+	}
+	if cmd := m.operationDenied(model.OpSync, "zzz-other-app"); cmd == nil {
+		t.Fatal("Expected zzz-other-app to be denied")
+	}
+}
+
+func TestPermissions_KeepPartialResults(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/account/can-i/applications/delete/team-b/*" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		fmt.Fprint(w, `{"value": "no"}`)
+	}))
+	defer server.Close()
+
+	m := buildDeleteTestModel(100, 30)
+	teamB := "team-b"
+	m.state.Apps[1].Project = &teamB
+	m.state.Server = &model.Server{BaseURL: server.URL, Token: "test-token"}
+	m.state.Index = model.BuildAppIndex(m.state.Apps)
+
+	msg := m.ensurePermissions()().(model.PermissionsLoadedMsg)
+	if len(msg.Failures) != 1 || msg.Failures[0].Project != "team-b" || msg.Failures[0].Action != "delete" {
+		t.Fatalf("Expected only the team-b delete check to fail, got %+v", msg.Failures)
+	}
+	previous := model.Permissions{"team-c": {"sync": false}}
+	m.state.Permissions = previous
+	m.Update(msg)
+
+	perms := m.state.Permissions
+	if perms.OperationAllowedForApp(m.state.Apps[0], model.OpDelete) || perms.OperationAllowedForApp(m.state.Apps[1], model.OpSync) {
+		t.Errorf("Expected the successful checks to be kept, got %v", perms)
+	}
+	if perms.Checked("team-b", "delete") || perms.OperationAllowed("team-c", model.OpSync) {
+		t.Error("Expected the failed check to stay unchecked and earlier results to be kept")
+	}
+	if previous.Checked(model.AppRBACObject("test-project", "test-app"), "sync") {
+		t.Error("Expected the results to replace the permissions rather than modify them")
+	}
+}
+
+func TestPermissions_DisableDeniedKeys(t *testing.T) {
+	m := buildDeleteTestModel(100, 30)
+	m.state.Permissions = model.Permissions{"test-project": {"sync": false, "delete": false}}
+
+	_, cmd := m.handleKeyMsg(testKeyMsg("s"))
+	if m.state.Mode != model.ModeNormal || m.state.Modals.ConfirmTarget != nil {
+		t.Fatalf("Expected sync to be blocked, got mode %s", m.state.Mode)
+	}
+	if status, ok := cmd().(model.StatusChangeMsg); !ok || !strings.Contains(status.Status, "cannot sync test-app in project test-project") {
+		t.Fatalf("Expected a not permitted status, got %v", status)
+	}
+
+	m.handleKeyMsg(tea.KeyPressMsg{Code: 'd', Mod: tea.ModCtrl})
+	if m.state.Mode != model.ModeNormal {
+		t.Fatalf("Expected delete to be blocked, got mode %s", m.state.Mode)
+	}
+
+	// Apps of other projects are unaffected
+	m.state.Navigation.SelectedIdx = 1
+	m.handleKeyMsg(testKeyMsg("s"))
+	if m.state.Mode != model.ModeConfirmSync {
+		t.Fatalf("Expected sync of zzz-other-app to open the confirmation, got mode %s", m.state.Mode)
+	}
+}
+
+func TestPermissions_HelpMarksUnavailable(t *testing.T) {
+	m := buildDeleteTestModel(120, 50)
+	if strings.Contains(m.renderHelpModal(), "not permitted by your ArgoCD role") {
+		t.Fatal("Expected no unavailable operations without can-i results")
+	}
+
+	m.state.Permissions = model.Permissions{"test-project": {"delete": false}, "": {"delete": false}}
+	if !strings.Contains(m.renderHelpModal(), "not permitted by your ArgoCD role") {
+		t.Fatal("Expected the help to mark delete as unavailable")
+	}
+}
+
+func TestPermissions_ResourceActionDenied(t *testing.T) {
+	m := buildDeleteTestModel(100, 30)
+	m.state.Mode = model.ModeResourceActions
+	m.state.Modals.ResourceActionTarget = &model.ResourceActionTarget{AppName: "test-app", Group: "apps", Kind: "Deployment", Name: "web"}
+	m.state.Modals.ResourceActionsLoading = true
+
+	m.Update(model.ResourceActionsLoadedMsg{
+		Actions:     []string{"pause", "restart"},
+		Object:      model.AppRBACObject("test-project", "test-app"),
+		Permitted:   map[string]bool{"action/apps/Deployment/pause": true, "action/apps/Deployment/restart": false},
+		SwitchEpoch: m.switchEpoch,
+	})
+	if out := m.renderResourceActionsModal(); !strings.Contains(out, "restart (not permitted)") || strings.Contains(out, "pause (not permitted)") {
+		t.Fatalf("Expected only restart to be marked, got:\n%s", out)
+	}
+
+	m.handleKeyMsg(testKeyMsg("j"))
+	m.handleKeyMsg(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if m.state.Modals.ResourceActionConfirm || m.state.Modals.ResourceActionError == nil {
+		t.Fatal("Expected the denied action not to reach the confirmation")
+	}
+}
@@ -0,0 +1,435 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	cblog "github.com/charmbracelet/log"
+	"github.com/darksworm/argonaut/pkg/api"
+	"github.com/darksworm/argonaut/pkg/model"
+	"github.com/darksworm/argonaut/pkg/services"
+)
+
+const (
+	// maxPodLogLines caps the lines kept in memory for the logs pane
+	maxPodLogLines = 10000
+	// maxPodLogBatch caps the events folded into one PodLogLinesMsg
+	maxPodLogBatch = 500
+)
+
+// logsSincePresets are the "since" windows cycled with 's' (0 = no limit)
+var logsSincePresets = []int64{0, 60, 300, 900, 3600, 21600, 86400}
+
+// logsTailPresets are the tail sizes cycled with 't' (0 = all lines)
+var logsTailPresets = []int64{100, 500, 2000, 0}
+
+// podLogsKinds are the tree node kinds that own pods we can stream logs from
+var podLogsKinds = map[string]bool{
+	"Pod":         true,
+	"Deployment":  true,
+	"ReplicaSet":  true,
+	"StatefulSet": true,
+	"DaemonSet":   true,
+	"Job":         true,
+	"CronJob":     true,
+	"Rollout":     true,
+}
+
+// podLogsStartedMsg is delivered once a logs stream is open
+type podLogsStartedMsg struct {
+	session int
+	ch      <-chan services.PodLogEvent
+	cleanup func()
+}
+
+// handleOpenPodLogs opens the logs pane for the pod (or workload pods) under the tree cursor
+func (m *Model) handleOpenPodLogs() (tea.Model, tea.Cmd) {
+	if m.treeView == nil {
+		return m, func() tea.Msg { return model.StatusChangeMsg{Status: "No resource selected"} }
+	}
+	_, kind, _, name, ok := m.treeView.SelectedResource()
+	if !ok {
+		return m, func() tea.Msg { return model.StatusChangeMsg{Status: "No resource selected"} }
+	}
+	if !podLogsKinds[kind] {
+		return m, func() tea.Msg {
+			return model.StatusChangeMsg{Status: fmt.Sprintf("Logs are not available for %s", kind)}
+		}
+	}
+	pods := m.treeView.SelectedPods()
+	if len(pods) == 0 {
+		return m, func() tea.Msg {
+			return model.StatusChangeMsg{Status: fmt.Sprintf("No pods found for %s/%s", kind, name)}
+		}
+	}
+
+	var appNamespace *string
+	for i := range m.state.Apps {
+		if m.state.Apps[i].Name == pods[0].AppName {
+			appNamespace = m.state.Apps[i].AppNamespace
+			break
+		}
+	}
+	podNames := make([]string, 0, len(pods))
+	for _, p := range pods {
+		podNames = append(podNames, p.Name)
+	}
+
+	m.cleanupPodLogs()
+	m.state.Logs = &model.LogsState{
+		AppName:      pods[0].AppName,
+		AppNamespace: appNamespace,
+		Resource:     kind + "/" + name,
+		Namespace:    pods[0].Namespace,
+		Pods:         podNames,
+		Pod:          podNames[0],
+		TailLines:    500,
+		Follow:       true,
+	}
+	m.state.Mode = model.ModeLogs
+	return m, m.switchLogsPod(podNames[0])
+}
+
+// switchLogsPod selects a pod and loads its containers; the stream starts once they arrive
+func (m *Model) switchLogsPod(pod string) tea.Cmd {
+	logs := m.state.Logs
+	m.cleanupPodLogs()
+	m.podLogsSession++
+	logs.Session = m.podLogsSession
+	logs.Pod = pod
+	logs.Containers = nil
+	logs.Container = ""
+	logs.Lines = nil
+	logs.Offset = 0
+	logs.Error = ""
+	logs.Streaming = true
+	return m.loadPodContainers(logs.Session, logs.AppName, logs.AppNamespace, logs.Namespace, pod)
+}
+
+// restartPodLogs reopens the stream with the current pod, container and window settings
+func (m *Model) restartPodLogs() tea.Cmd {
+	logs := m.state.Logs
+	m.cleanupPodLogs()
+	m.podLogsSession++
+	logs.Session = m.podLogsSession
+	logs.Lines = nil
+	logs.Offset = 0
+	logs.Error = ""
+	logs.Streaming = true
+	return m.startPodLogsStream(logs.Session, api.PodLogsRequest{
+		AppName:      logs.AppName,
+		AppNamespace: logs.AppNamespace,
+		PodName:      logs.Pod,
+		Namespace:    logs.Namespace,
+		Container:    logs.Container,
+		SinceSeconds: logs.SinceSeconds,
+		TailLines:    logs.TailLines,
+		Follow:       true,
+	})
+}
+
+// loadPodContainers fetches the container names of a pod
+func (m *Model) loadPodContainers(session int, appName string, appNamespace *string, namespace, pod string) tea.Cmd {
+	return func() tea.Msg {
+		if m.state.Server == nil {
+			return model.PodContainersLoadedMsg{Session: session, Pod: pod, Err: fmt.Errorf("no server configured")}
+		}
+		apiService := services.NewArgoApiService(m.state.Server)
+		containers, err := apiService.ListPodContainers(context.Background(), m.state.Server, appName, appNamespace, namespace, pod)
+		return model.PodContainersLoadedMsg{Session: session, Pod: pod, Containers: containers, Err: err}
+	}
+}
+
+// startPodLogsStream opens a logs stream for the given session
+func (m *Model) startPodLogsStream(session int, req api.PodLogsRequest) tea.Cmd {
+	return func() tea.Msg {
+		if m.state.Server == nil {
+			return model.PodLogLinesMsg{Session: session, Err: fmt.Errorf("no server configured"), Done: true}
+		}
+		apiService := services.NewArgoApiService(m.state.Server)
+		ch, cleanup, err := apiService.StreamPodLogs(context.Background(), m.state.Server, req)
+		if err != nil {
+			cblog.With("component", "ui").Error("Pod logs stream failed", "err", err, "pod", req.PodName)
+			return model.PodLogLinesMsg{Session: session, Err: err, Done: true}
+		}
+		return podLogsStartedMsg{session: session, ch: ch, cleanup: cleanup}
+	}
+}
+
+// consumePodLogs waits for the next log event and folds any already-queued
+// events into the same batch so fast streams don't cost one render per line
+func consumePodLogs(session int, ch <-chan services.PodLogEvent) tea.Cmd {
+	return func() tea.Msg {
+		ev, ok := <-ch
+		if !ok {
+			return model.PodLogLinesMsg{Session: session, Done: true}
+		}
+		batch := model.PodLogLinesMsg{Session: session}
+		appendEvent := func(ev services.PodLogEvent) bool {
+			if ev.Err != nil {
+				batch.Err = ev.Err
+				batch.Done = true
+				return false
+			}
+			batch.Lines = append(batch.Lines, strings.Split(strings.TrimRight(ev.Entry.Content, "\n"), "\n")...)
+			return true
+		}
+		if !appendEvent(ev) {
+			return batch
+		}
+		for i := 0; i < maxPodLogBatch; i++ {
+			select {
+			case ev, ok := <-ch:
+				if !ok {
+					batch.Done = true
+					return batch
+				}
+				if !appendEvent(ev) {
+					return batch
+				}
+			default:
+				return batch
+			}
+		}
+		return batch
+	}
+}
+
+// handlePodLogsStarted stores the stream cleanup and starts consuming events
+func (m *Model) handlePodLogsStarted(msg podLogsStartedMsg) (tea.Model, tea.Cmd) {
+	if m.state.Logs == nil || m.state.Logs.Session != msg.session {
+		// Pane closed or stream replaced while connecting
+		if msg.cleanup != nil {
+			msg.cleanup()
+		}
+		return m, nil
+	}
+	m.cleanupPodLogs()
+	m.podLogsCleanup = msg.cleanup
+	m.podLogsCh = msg.ch
+	return m, consumePodLogs(msg.session, msg.ch)
+}
+
+// handlePodContainersLoaded picks a container and opens the stream
+func (m *Model) handlePodContainersLoaded(msg model.PodContainersLoadedMsg) (tea.Model, tea.Cmd) {
+	logs := m.state.Logs
+	if logs == nil || logs.Session != msg.Session {
+		return m, nil
+	}
+	if msg.Err != nil {
+		// Without the container list the API falls back to the pod's default container
+		cblog.With("component", "ui").Warn("Failed to list pod containers", "pod", msg.Pod, "err", msg.Err)
+	}
+	logs.Containers = msg.Containers
+	if len(logs.Containers) > 0 {
+		logs.Container = logs.Containers[0]
+	}
+	return m, m.restartPodLogs()
+}
+
+// handlePodLogLines appends a batch of streamed lines to the logs pane
+func (m *Model) handlePodLogLines(msg model.PodLogLinesMsg) (tea.Model, tea.Cmd) {
+	logs := m.state.Logs
+	if logs == nil || logs.Session != msg.Session {
+		return m, nil
+	}
+	logs.Lines = append(logs.Lines, msg.Lines...)
+	if over := len(logs.Lines) - maxPodLogLines; over > 0 {
+		logs.Lines = logs.Lines[over:]
+		if !logs.Follow {
+			logs.Offset = max(0, logs.Offset-over)
+		}
+	}
+	m.clampLogsOffset()
+	if msg.Done {
+		logs.Streaming = false
+		if msg.Err != nil {
+			logs.Error = msg.Err.Error()
+		}
+		m.cleanupPodLogs()
+		return m, nil
+	}
+	return m, consumePodLogs(msg.Session, m.podLogsCh)
+}
+
+// handleLogsModeKeys handles non-navigation input in the logs pane.
+// Navigation keys (up/k, down/j, pgup, pgdown, g, G) are handled by the centralized router.
+func (m *Model) handleLogsModeKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	logs := m.state.Logs
+	if logs == nil {
+		m.state.Mode = model.ModeNormal
+		return m, nil
+	}
+	if logs.Picker != "" {
+		return m.handleLogsPickerKeys(msg)
+	}
+	switch msg.String() {
+	case "q", "esc":
+		m.cleanupPodLogs()
+		m.state.Logs = nil
+		m.state.Mode = model.ModeNormal
+		return m, nil
+	case "/":
+		// Reuse search input for filtering log lines
+		m.inputComponents.ClearSearchInput()
+		m.inputComponents.FocusSearchInput()
+		m.state.Mode = model.ModeSearch
+		m.clampLogsOffset() // The search bar takes room from the pane
+		return m, nil
+	case "f":
+		logs.Follow = !logs.Follow
+		m.clampLogsOffset()
+		return m, nil
+	case "c":
+		if len(logs.Containers) < 2 {
+			return m, func() tea.Msg { return model.StatusChangeMsg{Status: "Pod has a single container"} }
+		}
+		logs.Picker = "container"
+		logs.PickerIdx = max(0, indexOf(logs.Containers, logs.Container))
+		return m, nil
+	case "p":
+		if len(logs.Pods) < 2 {
+			return m, func() tea.Msg { return model.StatusChangeMsg{Status: "No other pods to pick from"} }
+		}
+		logs.Picker = "pod"
+		logs.PickerIdx = max(0, indexOf(logs.Pods, logs.Pod))
+		return m, nil
+	case "s":
+		logs.SinceSeconds = nextPreset(logsSincePresets, logs.SinceSeconds)
+		return m, m.restartPodLogs()
+	case "t":
+		logs.TailLines = nextPreset(logsTailPresets, logs.TailLines)
+		return m, m.restartPodLogs()
+	default:
+		return m, nil
+	}
+}
+
+// handleLogsPickerKeys handles input while the pod or container picker is open
+func (m *Model) handleLogsPickerKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	logs := m.state.Logs
+	options := m.logsPickerOptions()
+	switch msg.String() {
+	case "esc", "q":
+		logs.Picker = ""
+		return m, nil
+	case "up", "k":
+		if logs.PickerIdx > 0 {
+			logs.PickerIdx--
+		}
+		return m, nil
+	case "down", "j":
+		if logs.PickerIdx < len(options)-1 {
+			logs.PickerIdx++
+		}
+		return m, nil
+	case "enter":
+		if logs.PickerIdx < 0 || logs.PickerIdx >= len(options) {
+			return m, nil
+		}
+		choice := options[logs.PickerIdx]
+		picker := logs.Picker
+		logs.Picker = ""
+		if picker == "pod" {
+			if choice == logs.Pod {
+				return m, nil
+			}
+			return m, m.switchLogsPod(choice)
+		}
+		if choice == logs.Container {
+			return m, nil
+		}
+		logs.Container = choice
+		return m, m.restartPodLogs()
+	}
+	return m, nil
+}
+
+// logsPickerOptions returns the entries of the currently open picker
+func (m *Model) logsPickerOptions() []string {
+	if m.state.Logs == nil {
+		return nil
+	}
+	switch m.state.Logs.Picker {
+	case "pod":
+		return m.state.Logs.Pods
+	case "container":
+		return m.state.Logs.Containers
+	}
+	return nil
+}
+
+// filteredLogLines returns the log lines matching the active logs search query
+func (m *Model) filteredLogLines() []string {
+	lines := m.state.Logs.Lines
+	q := strings.ToLower(strings.TrimSpace(m.state.Logs.SearchQuery))
+	if q == "" {
+		return lines
+	}
+	filtered := make([]string, 0, len(lines))
+	for _, ln := range lines {
+		if strings.Contains(strings.ToLower(ln), q) {
+			filtered = append(filtered, ln)
+		}
+	}
+	return filtered
+}
+
+// logsPageSize returns the number of visible log lines in the logs pane
+func (m *Model) logsPageSize() int {
+	// title + status + content border + main container padding
+	overhead := 5
+	if m.state.Mode == model.ModeSearch {
+		overhead += 3 // search bar with border
+	}
+	return max(3, m.state.Terminal.Rows-overhead)
+}
+
+// logsMaxOffset returns the offset that shows the last page of the filtered log lines
+func (m *Model) logsMaxOffset() int {
+	return max(0, len(m.filteredLogLines())-m.logsPageSize())
+}
+
+// clampLogsOffset keeps the logs offset within the filtered lines, pinned to the bottom while
+// following. Call it whenever the lines, the filter or the page size change.
+func (m *Model) clampLogsOffset() {
+	logs := m.state.Logs
+	if logs == nil {
+		return
+	}
+	maxOffset := m.logsMaxOffset()
+	if logs.Follow {
+		logs.Offset = maxOffset
+	}
+	logs.Offset = min(max(0, logs.Offset), maxOffset)
+}
+
+// syncLogsFollow clamps the logs offset and re-enables follow once the view reaches the bottom
+func (m *Model) syncLogsFollow() {
+	logs := m.state.Logs
+	maxOffset := m.logsMaxOffset()
+	logs.Offset = min(max(0, logs.Offset), maxOffset)
+	logs.Follow = logs.Offset >= maxOffset
+}
+
+// nextPreset returns the preset following current, wrapping around
+func nextPreset(presets []int64, current int64) int64 {
+	for i, p := range presets {
+		if p == current {
+			return presets[(i+1)%len(presets)]
+		}
+	}
+	return presets[0]
+}
+
+// indexOf returns the position of s in list, or -1
+func indexOf(list []string, s string) int {
+	for i, v := range list {
+		if v == s {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/darksworm/argonaut/pkg/api"
+	"github.com/darksworm/argonaut/pkg/model"
+	"github.com/darksworm/argonaut/pkg/services"
+)
+
+// openTestLogs puts the model in the logs pane with a running stream for web-1/app
+func openTestLogs(m *Model) *model.LogsState {
+	m.podLogsSession = 1
+	m.state.Logs = &model.LogsState{
+		AppName:    "test-app",
+		Resource:   "Deployment/web",
+		Namespace:  "default",
+		Pods:       []string{"web-1", "web-2"},
+		Pod:        "web-1",
+		Containers: []string{"app", "sidecar"},
+		Container:  "app",
+		TailLines:  500,
+		Follow:     true,
+		Streaming:  true,
+		Session:    1,
+	}
+	m.state.Mode = model.ModeLogs
+	return m.state.Logs
+}
+
+func testLogLines(n int) []string {
+	lines := make([]string, n)
+	for i := range lines {
+		lines[i] = fmt.Sprintf("line %d", i)
+	}
+	return lines
+}
+
+func TestPodLogs_DropsStaleSessions(t *testing.T) {
+	m := buildDeleteTestModel(100, 30)
+	logs := openTestLogs(m)
+	logs.Session, m.podLogsSession = 2, 2
+
+	m.Update(model.PodLogLinesMsg{Session: 1, Lines: []string{"old stream"}})
+	m.Update(model.PodContainersLoadedMsg{Session: 1, Pod: "web-1", Containers: []string{"other"}})
+	if len(logs.Lines) != 0 || logs.Container != "app" {
+		t.Fatalf("Expected messages of the replaced stream to be dropped, got lines %v container %q", logs.Lines, logs.Container)
+	}
+
+	cleaned := false
+	m.Update(podLogsStartedMsg{session: 1, ch: make(chan services.PodLogEvent), cleanup: func() { cleaned = true }})
+	if !cleaned || m.podLogsCh != nil {
+		t.Fatal("Expected a stream opened for a replaced session to be closed")
+	}
+
+	m.Update(model.PodLogLinesMsg{Session: 2, Lines: []string{"current"}, Err: errors.New("stream reset"), Done: true})
+	if len(logs.Lines) != 1 || logs.Streaming || logs.Error != "stream reset" {
+		t.Errorf("Expected the current session to be applied, got %+v", logs)
+	}
+}
+
+func TestPodLogs_BatchesQueuedLines(t *testing.T) {
+	ch := make(chan services.PodLogEvent, 3)
+	ch <- services.PodLogEvent{Entry: api.PodLogEntry{Content: "one\n"}}
+	ch <- services.PodLogEvent{Entry: api.PodLogEntry{Content: "two\nthree"}}
+	ch <- services.PodLogEvent{Entry: api.PodLogEntry{Content: "four"}}
+	close(ch)
+
+	msg := consumePodLogs(7, ch)().(model.PodLogLinesMsg)
+	if msg.Session != 7 || !msg.Done || len(msg.Lines) != 4 || msg.Lines[2] != "three" {
+		t.Errorf("Expected all queued lines in one final batch, got %+v", msg)
+	}
+}
+
+func TestPodLogs_FollowPinsToBottom(t *testing.T) {
+	m := buildDeleteTestModel(100, 30)
+	logs := openTestLogs(m)
+	m.podLogsCh = make(chan services.PodLogEvent)
+	page := m.logsPageSize()
+
+	m.Update(model.PodLogLinesMsg{Session: 1, Lines: testLogLines(100)})
+	if logs.Offset != 100-page {
+		t.Fatalf("Expected follow to show the newest lines, offset %d", logs.Offset)
+	}
+
+	// Scrolling up stops following; new lines leave the view where it is
+	m.handleKeyMsg(testKeyMsg("k"))
+	if logs.Follow {
+		t.Fatal("Expected scrolling up to stop following")
+	}
+	offset := logs.Offset
+	m.Update(model.PodLogLinesMsg{Session: 1, Lines: testLogLines(10)})
+	if logs.Offset != offset {
+		t.Fatalf("Expected the offset to stay at %d, got %d", offset, logs.Offset)
+	}
+
+	// Dropping the oldest lines keeps the same lines in view
+	m.Update(model.PodLogLinesMsg{Session: 1, Lines: testLogLines(maxPodLogLines)})
+	if len(logs.Lines) != maxPodLogLines || logs.Offset != max(0, offset-110) {
+		t.Fatalf("Expected the buffer to be capped and the offset clamped, got %d lines offset %d", len(logs.Lines), logs.Offset)
+	}
+
+	m.handleKeyMsg(testKeyMsg("G"))
+	if !logs.Follow || logs.Offset != maxPodLogLines-page {
+		t.Errorf("Expected G to resume following, got follow %v offset %d", logs.Follow, logs.Offset)
+	}
+}
+
+func TestPodLogs_ViewLeavesOffsetAlone(t *testing.T) {
+	m := buildDeleteTestModel(100, 30)
+	logs := openTestLogs(m)
+	m.podLogsCh = make(chan services.PodLogEvent)
+	m.Update(model.PodLogLinesMsg{Session: 1, Lines: testLogLines(100)})
+
+	logs.Follow = false
+	logs.Offset = 500
+	m.renderLogsView()
+	if logs.Offset != 500 {
+		t.Fatalf("Expected rendering to leave the offset alone, got %d", logs.Offset)
+	}
+
+	// Filtering down to a few lines clamps the offset in the handler
+	m.handleKeyMsg(testKeyMsg("/"))
+	typeText(m, "line 9")
+	if want := m.logsMaxOffset(); logs.Offset != want {
+		t.Errorf("Expected the search to clamp the offset to %d, got %d", want, logs.Offset)
+	}
+}
+
+func TestPodLogs_PickerRestartsStream(t *testing.T) {
+	m := buildDeleteTestModel(100, 30)
+	logs := openTestLogs(m)
+	logs.Lines = []string{"from app"}
+
+	m.handleLogsModeKeys(testKeyMsg("c"))
+	if logs.Picker != "container" {
+		t.Fatalf("Expected the container picker, got %q", logs.Picker)
+	}
+	m.handleLogsModeKeys(testKeyMsg("j"))
+	_, cmd := m.handleLogsModeKeys(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if logs.Picker != "" || logs.Container != "sidecar" || logs.Session != 2 || len(logs.Lines) != 0 || cmd == nil {
+		t.Fatalf("Expected the sidecar stream to restart in a new session, got %+v", logs)
+	}
+	// Without a server the new stream fails at once, under the new session
+	m.Update(cmd())
+	if logs.Error != "no server configured" || logs.Streaming {
+		t.Errorf("Expected the restarted stream's result to be applied, got %+v", logs)
+	}
+
+	m.handleLogsModeKeys(testKeyMsg("p"))
+	m.handleLogsModeKeys(testKeyMsg("j"))
+	_, cmd = m.handleLogsModeKeys(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if logs.Pod != "web-2" || logs.Session != 3 || logs.Containers != nil || cmd == nil {
+		t.Fatalf("Expected picking a pod to reload its containers, got %+v", logs)
+	}
+	if msg, ok := cmd().(model.PodContainersLoadedMsg); !ok || msg.Session != 3 || msg.Pod != "web-2" {
+		t.Errorf("Expected the container list of web-2 to be requested, got %#v", msg)
+	}
+}
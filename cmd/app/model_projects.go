@@ -0,0 +1,265 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	cblog "github.com/charmbracelet/log"
+	"github.com/darksworm/argonaut/pkg/api"
+	appcontext "github.com/darksworm/argonaut/pkg/context"
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+// projectsRefreshInterval is how often projects are re-fetched so sync windows opening and
+// closing are reflected in the apps list
+const projectsRefreshInterval = time.Minute
+
+// projectsRefreshTickMsg re-fetches projects and their active sync windows
+type projectsRefreshTickMsg struct {
+	session int
+	epoch   int
+}
+
+// ensureProjects starts the background refresh of projects after the first app load
+func (m *Model) ensureProjects() tea.Cmd {
+	if m.projectsSession != 0 {
+		return nil
+	}
+	m.projectsSession++
+	return m.loadProjects(m.projectsSession)
+}
+
+// loadProjects lists projects and fetches the active sync windows of those that define any
+func (m *Model) loadProjects(session int) tea.Cmd {
+	epoch := m.switchEpoch // capture at call time
+	return func() tea.Msg {
+		if m.state.Server == nil {
+			return model.ProjectsLoadedMsg{Session: session, Err: fmt.Errorf("no server configured"), SwitchEpoch: epoch}
+		}
+		ctx, cancel := appcontext.WithAPITimeout(context.Background())
+		defer cancel()
+
+		svc := api.NewProjectService(m.state.Server)
+		projects, err := svc.ListProjects(ctx)
+		if err != nil {
+			return model.ProjectsLoadedMsg{Session: session, Err: err, SwitchEpoch: epoch}
+		}
+		out := make([]model.Project, 0, len(projects))
+		for _, p := range projects {
+			var active []api.ArgoSyncWindow
+			if len(p.Spec.SyncWindows) > 0 {
+				active, err = svc.GetSyncWindows(ctx, p.Metadata.Name)
+				if err != nil {
+					// Without the active windows every allow window would look closed; mark them unknown
+					cblog.With("component", "projects").Warn("Failed to get sync windows", "project", p.Metadata.Name, "err", err)
+					converted := api.ConvertToProject(p, nil)
+					converted.ActiveWindowsErr = err.Error()
+					out = append(out, converted)
+					continue
+				}
+			}
+			out = append(out, api.ConvertToProject(p, active))
+		}
+		return model.ProjectsLoadedMsg{Session: session, Projects: out, SwitchEpoch: epoch}
+	}
+}
+
+// handleProjectsLoaded stores the refreshed projects and schedules the next refresh
+func (m *Model) handleProjectsLoaded(msg model.ProjectsLoadedMsg) (tea.Model, tea.Cmd) {
+	if msg.SwitchEpoch != m.switchEpoch || msg.Session != m.projectsSession {
+		return m, nil
+	}
+	next := scheduleProjectsRefresh(msg.Session, msg.SwitchEpoch)
+	if msg.Err != nil {
+		// Sync windows are advisory; keep the previous projects (if any) and try again later
+		cblog.With("component", "projects").Debug("Failed to list projects", "err", msg.Err)
+		return m, next
+	}
+	m.state.Projects = make(map[string]model.Project, len(msg.Projects))
+	for _, p := range msg.Projects {
+		m.state.Projects[p.Name] = p
+	}
+	m.evaluateSyncWindows()
+	return m, next
+}
+
+// scheduleProjectsRefresh queues the next projects refresh for a session
+func scheduleProjectsRefresh(session, epoch int) tea.Cmd {
+	return tea.Tick(projectsRefreshInterval, func(time.Time) tea.Msg {
+		return projectsRefreshTickMsg{session: session, epoch: epoch}
+	})
+}
+
+// handleProjectsRefreshTick re-fetches projects unless the refresh belongs to a previous context
+func (m *Model) handleProjectsRefreshTick(msg projectsRefreshTickMsg) (tea.Model, tea.Cmd) {
+	if msg.epoch != m.switchEpoch || msg.session != m.projectsSession {
+		return m, nil
+	}
+	return m, m.loadProjects(msg.session)
+}
+
+// evaluateSyncWindows stores the sync window state of every app. It runs when projects are
+// refreshed, once a minute, so rendering only looks the state up.
+func (m *Model) evaluateSyncWindows() {
+	blocks := make(map[string]model.SyncBlock)
+	for _, app := range m.state.Apps {
+		project := "default"
+		if app.Project != nil && *app.Project != "" {
+			project = *app.Project
+		}
+		p, ok := m.state.Projects[project]
+		if !ok {
+			continue
+		}
+		if block := p.SyncBlockFor(app); block.Blocked || block.Unknown {
+			blocks[app.Name] = block
+		}
+	}
+	m.state.SyncBlocks = blocks
+}
+
+// syncWindowBlock returns the sync window state of app as of the last projects refresh
+func (m *Model) syncWindowBlock(app model.App) model.SyncBlock {
+	return m.state.SyncBlocks[app.Name]
+}
+
+// handleOpenProjectDetail opens the detail view for the project under the cursor (projects view)
+func (m *Model) handleOpenProjectDetail() (tea.Model, tea.Cmd) {
+	items := m.getVisibleItemsForCurrentView()
+	if len(items) == 0 || m.state.Navigation.SelectedIdx >= len(items) {
+		return m, nil
+	}
+	name, _ := items[m.state.Navigation.SelectedIdx].(string)
+	if name == "" {
+		return m, nil
+	}
+	return m, m.openProjectDetail(name)
+}
+
+// openProjectDetail switches to the project detail view and fetches the project
+func (m *Model) openProjectDetail(name string) tea.Cmd {
+	m.projectDetailSession++
+	m.state.ProjectDetail = &model.ProjectDetailState{
+		Name:    name,
+		Loading: true,
+		Session: m.projectDetailSession,
+	}
+	m.state.Mode = model.ModeProjectDetail
+	return m.loadProjectDetail(name, m.projectDetailSession)
+}
+
+// loadProjectDetail fetches a project and the sync windows active right now
+func (m *Model) loadProjectDetail(name string, session int) tea.Cmd {
+	epoch := m.switchEpoch // capture at call time
+	return func() tea.Msg {
+		if m.state.Server == nil {
+			return model.ProjectDetailLoadedMsg{Session: session, Err: fmt.Errorf("no server configured"), SwitchEpoch: epoch}
+		}
+		ctx, cancel := appcontext.WithAPITimeout(context.Background())
+		defer cancel()
+
+		svc := api.NewProjectService(m.state.Server)
+		proj, err := svc.GetProject(ctx, name)
+		if err != nil {
+			cblog.With("component", "projects").Error("Failed to load project", "project", name, "err", err)
+			return model.ProjectDetailLoadedMsg{Session: session, Err: err, SwitchEpoch: epoch}
+		}
+		var active []api.ArgoSyncWindow
+		if len(proj.Spec.SyncWindows) > 0 {
+			if active, err = svc.GetSyncWindows(ctx, name); err != nil {
+				cblog.With("component", "projects").Error("Failed to load sync windows", "project", name, "err", err)
+				return model.ProjectDetailLoadedMsg{Session: session, Err: err, SwitchEpoch: epoch}
+			}
+		}
+		converted := api.ConvertToProject(*proj, active)
+		return model.ProjectDetailLoadedMsg{Session: session, Project: &converted, SwitchEpoch: epoch}
+	}
+}
+
+// handleProjectDetailLoaded stores the fetched project; it also refreshes the sync windows
+// used to flag blocked apps
+func (m *Model) handleProjectDetailLoaded(msg model.ProjectDetailLoadedMsg) (tea.Model, tea.Cmd) {
+	detail := m.state.ProjectDetail
+	if msg.SwitchEpoch != m.switchEpoch || detail == nil || detail.Session != msg.Session {
+		return m, nil
+	}
+	detail.Loading = false
+	if msg.Err != nil {
+		detail.Error = extractUserFriendlyError(msg.Err)
+		return m, nil
+	}
+	detail.Error = ""
+	detail.Project = msg.Project
+	if m.state.Projects == nil {
+		m.state.Projects = make(map[string]model.Project)
+	}
+	m.state.Projects[msg.Project.Name] = *msg.Project
+	m.evaluateSyncWindows()
+	return m, nil
+}
+
+// handleProjectDetailKeys handles input in the project detail view
+func (m *Model) handleProjectDetailKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	detail := m.state.ProjectDetail
+	if detail == nil {
+		m.state.Mode = model.ModeNormal
+		return m, nil
+	}
+	switch msg.String() {
+	case "q", "esc":
+		m.state.ProjectDetail = nil
+		m.state.Mode = model.ModeNormal
+		return m, nil
+	case "r":
+		return m, m.openProjectDetail(detail.Name)
+	}
+	return m, nil
+}
+
+// projectDetailPageSize returns the number of lines visible in the project detail view
+func (m *Model) projectDetailPageSize() int {
+	// title + status + content border + main container padding
+	overhead := 5
+	return max(3, m.state.Terminal.Rows-overhead)
+}
+
+// syncWindowWarning describes the sync windows blocking the apps in the sync confirmation,
+// or returns "" when none of them is blocked
+func (m *Model) syncWindowWarning(target string, isMulti bool) string {
+	if !isMulti {
+		for _, app := range m.state.Apps {
+			if app.Name == target {
+				switch block := m.syncWindowBlock(app); {
+				case block.Blocked:
+					return "Sync blocked: " + block.Reason
+				case block.Unknown:
+					return "Sync windows unknown: " + block.Reason
+				}
+				break
+			}
+		}
+		return ""
+	}
+	blocked, unknown := 0, 0
+	for _, app := range m.state.Apps {
+		if !m.state.Selections.HasSelectedApp(app.Name) {
+			continue
+		}
+		block := m.syncWindowBlock(app)
+		if block.Blocked {
+			blocked++
+		} else if block.Unknown {
+			unknown++
+		}
+	}
+	total := len(m.state.Selections.SelectedApps)
+	switch {
+	case blocked > 0:
+		return fmt.Sprintf("Sync blocked by sync windows for %d of %d app(s)", blocked, total)
+	case unknown > 0:
+		return fmt.Sprintf("Sync windows unknown for %d of %d app(s)", unknown, total)
+	}
+	return ""
+}
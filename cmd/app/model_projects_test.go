@@ -0,0 +1,126 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+var testDenyWindow = model.SyncWindow{Kind: "deny", Schedule: "0 22 * * *", Duration: "8h", Applications: []string{"test-*"}}
+
+func buildProjectsTestModel() *Model {
+	m := buildDeleteTestModel(140, 30)
+	m.state.Index = model.BuildAppIndex(m.state.Apps)
+	m.ensureProjects()
+	m.Update(model.ProjectsLoadedMsg{Session: m.projectsSession, SwitchEpoch: m.switchEpoch, Projects: []model.Project{
+		{Name: "test-project", SyncWindows: []model.SyncWindow{testDenyWindow}, ActiveWindows: []model.SyncWindow{testDenyWindow}},
+	}})
+	return m
+}
+
+func TestSyncWindows_FlagBlockedApps(t *testing.T) {
+	m := buildProjectsTestModel()
+
+	out := m.renderListView(20)
+	if strings.Count(out, strings.TrimSpace(syncBlockedTag)) != 1 {
+		t.Fatalf("Expected only test-app to be flagged, got:\n%s", out)
+	}
+
+	target := "test-app"
+	m.state.Modals.ConfirmTarget = &target
+	if modal := m.renderConfirmSyncModal(); !strings.Contains(modal, "deny window 0 22 * * * for 8h is active") {
+		t.Fatalf("Expected the sync modal to warn about the deny window, got:\n%s", modal)
+	}
+	other := "zzz-other-app"
+	m.state.Modals.ConfirmTarget = &other
+	if modal := m.renderConfirmSyncModal(); strings.Contains(modal, "Sync blocked") {
+		t.Fatalf("Expected no warning for an app without sync windows, got:\n%s", modal)
+	}
+}
+
+func TestSyncWindows_EvaluatedOnRefresh(t *testing.T) {
+	m := buildProjectsTestModel()
+	if !m.state.SyncBlocks["test-app"].Blocked {
+		t.Fatalf("Expected test-app to be stored as blocked, got %+v", m.state.SyncBlocks)
+	}
+
+	// Rendering reads the stored state; the windows are evaluated again on the next refresh
+	m.state.Projects["test-project"] = model.Project{Name: "test-project"}
+	if out := m.renderListView(20); !strings.Contains(out, strings.TrimSpace(syncBlockedTag)) {
+		t.Fatalf("Expected the stored state to be rendered, got:\n%s", out)
+	}
+	m.Update(model.ProjectsLoadedMsg{Session: m.projectsSession, SwitchEpoch: m.switchEpoch, Projects: []model.Project{{Name: "test-project"}}})
+	if out := m.renderListView(20); strings.Contains(out, strings.TrimSpace(syncBlockedTag)) {
+		t.Fatalf("Expected the refresh to clear the block, got:\n%s", out)
+	}
+}
+
+func TestSyncWindows_FetchFailureIsUnknown(t *testing.T) {
+	m := buildProjectsTestModel()
+	m.Update(model.ProjectsLoadedMsg{Session: m.projectsSession, SwitchEpoch: m.switchEpoch, Projects: []model.Project{
+		{Name: "test-project", SyncWindows: []model.SyncWindow{testDenyWindow}, ActiveWindowsErr: "permission denied"},
+	}})
+
+	out := m.renderListView(20)
+	if strings.Contains(out, strings.TrimSpace(syncBlockedTag)) || strings.Count(out, strings.TrimSpace(syncUnknownTag)) != 1 {
+		t.Fatalf("Expected test-app to be flagged as unknown, got:\n%s", out)
+	}
+	target := "test-app"
+	m.state.Modals.ConfirmTarget = &target
+	if modal := m.renderConfirmSyncModal(); !strings.Contains(modal, "Sync windows unknown") {
+		t.Fatalf("Expected the sync modal to warn about unknown windows, got:\n%s", modal)
+	}
+}
+
+func TestSyncWindows_StaleRefreshIgnored(t *testing.T) {
+	m := buildProjectsTestModel()
+
+	m.Update(model.ProjectsLoadedMsg{Session: m.projectsSession, SwitchEpoch: m.switchEpoch - 1})
+	if _, ok := m.state.Projects["test-project"]; !ok {
+		t.Fatal("Expected projects from a previous context to be ignored")
+	}
+	if _, cmd := m.handleProjectsRefreshTick(projectsRefreshTickMsg{session: m.projectsSession, epoch: m.switchEpoch - 1}); cmd != nil {
+		t.Fatal("Expected refresh ticks from a previous context to end the loop")
+	}
+	if cmd := m.ensureProjects(); cmd != nil {
+		t.Fatal("Expected the refresh to start only once")
+	}
+}
+
+func TestProjectDetail_OpenAndRender(t *testing.T) {
+	m := buildProjectsTestModel()
+	m.state.Navigation.View = model.ViewProjects
+	m.state.Navigation.SelectedIdx = 0
+
+	m.handleKeyMsg(testKeyMsg("i"))
+	detail := m.state.ProjectDetail
+	if m.state.Mode != model.ModeProjectDetail || detail == nil || detail.Name != "test-project" {
+		t.Fatalf("Expected project detail for test-project, got mode %s", m.state.Mode)
+	}
+
+	allow := model.SyncWindow{Kind: "allow", Schedule: "0 9 * * 1-5", Duration: "9h", Namespaces: []string{"team-*"}, ManualSync: true}
+	m.Update(model.ProjectDetailLoadedMsg{Session: detail.Session, SwitchEpoch: m.switchEpoch, Project: &model.Project{
+		Name:          "test-project",
+		SourceRepos:   []string{"https://github.com/example/apps.git"},
+		Destinations:  []model.ProjectDestination{{Server: "https://kubernetes.default.svc", Namespace: "test-*"}},
+		Roles:         []model.ProjectRole{{Name: "deployer", Policies: []string{"p1", "p2"}, Groups: []string{"ci"}}},
+		SyncWindows:   []model.SyncWindow{testDenyWindow, allow},
+		ActiveWindows: []model.SyncWindow{testDenyWindow},
+	}})
+
+	out := m.renderProjectDetailView()
+	for _, want := range []string{
+		"https://github.com/example/apps.git", "deployer", "2 policies", "SYNC WINDOWS (1 active)",
+		"ACTIVE", "manual sync allowed", "NAMESPACE RESOURCES", "Sync blocked for 1 app(s): test-app",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected %q in project detail, got:\n%s", want, out)
+		}
+	}
+
+	m.handleKeyMsg(testKeyMsg("esc"))
+	if m.state.Mode != model.ModeNormal || m.state.ProjectDetail != nil {
+		t.Fatalf("Expected esc to close the project detail, got mode %s", m.state.Mode)
+	}
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+func visibleAppNames(m *Model) []string {
+	var names []string
+	for _, it := range m.getVisibleItems() {
+		names = append(names, it.(model.App).Name)
+	}
+	return names
+}
+
+func TestAppsSearch_FilterQuery(t *testing.T) {
+	m := buildDeleteTestModel(140, 30)
+	m.state.Apps[0].Labels = map[string]string{"team": "core"}
+	m.state.Index = model.BuildAppIndex(m.state.Apps)
+
+	m.handleKeyMsg(testKeyMsg("/"))
+	typeText(m, "label:te")
+	m.handleKeyMsg(tea.KeyPressMsg{Code: tea.KeyTab})
+	if got := m.inputComponents.GetSearchValue(); got != "label:team=" {
+		t.Fatalf("Expected Tab to complete the label key, got %q", got)
+	}
+	typeText(m, "core")
+	if names := visibleAppNames(m); len(names) != 1 || names[0] != "test-app" {
+		t.Fatalf("Expected only test-app, got %v", names)
+	}
+
+	m.inputComponents.SetSearchValue("")
+	m.state.UI.SearchQuery = ""
+	typeText(m, "-health:Healthy OR project:test-project")
+	if names := visibleAppNames(m); len(names) != 2 {
+		t.Fatalf("Expected both apps, got %v", names)
+	}
+	m.handleKeyMsg(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if m.state.Mode != model.ModeNormal || m.state.UI.ActiveFilter != "-health:Healthy OR project:test-project" {
+		t.Fatalf("Expected Enter to keep the query as the filter, got mode %s filter %q", m.state.Mode, m.state.UI.ActiveFilter)
+	}
+}
+
+func TestAppsSearch_InvalidQueryFallsBackToText(t *testing.T) {
+	m := buildDeleteTestModel(140, 30)
+
+	m.handleKeyMsg(testKeyMsg("/"))
+	typeText(m, "age>soon")
+	if names := visibleAppNames(m); len(names) != 0 {
+		t.Fatalf("Expected no app to contain the text, got %v", names)
+	}
+	if bar := m.renderEnhancedSearchBar(); !strings.Contains(bar, `invalid duration "soon"`) {
+		t.Fatalf("Expected the search bar to explain the query error, got:\n%s", bar)
+	}
+}
+
+func TestAppsSearch_PlainTextIsLiteral(t *testing.T) {
+	m := buildDeleteTestModel(140, 30)
+	m.state.Apps[1].Name = "zzz-canary"
+
+	m.handleKeyMsg(testKeyMsg("/"))
+	typeText(m, "-canary")
+	if names := visibleAppNames(m); len(names) != 1 || names[0] != "zzz-canary" {
+		t.Fatalf("Expected a literal match for -canary, got %v", names)
+	}
+
+	m.inputComponents.SetSearchValue("")
+	m.state.UI.SearchQuery = ""
+	typeText(m, "test-app synced")
+	if names := visibleAppNames(m); len(names) != 0 {
+		t.Fatalf("Expected the whole text to be matched as one substring, got %v", names)
+	}
+}
+
+func TestAppsSearch_QueryParsedOncePerText(t *testing.T) {
+	m := buildDeleteTestModel(140, 30)
+	m.state.UI.ActiveFilter = "health:Healthy"
+
+	visibleAppNames(m)
+	cached := m.state.UI.AppQuery
+	if cached == nil || cached.Text != "health:Healthy" {
+		t.Fatalf("Expected the filter to be parsed and cached, got %+v", cached)
+	}
+	m.getVisibleItems()
+	if m.state.UI.AppQuery != cached {
+		t.Fatal("Expected the cached query to be reused while the text is unchanged")
+	}
+
+	m.state.UI.ActiveFilter = "health:Degraded"
+	if names := visibleAppNames(m); len(names) != 1 || names[0] != "zzz-other-app" {
+		t.Fatalf("Expected the changed filter to be parsed again, got %v", names)
+	}
+}
@@ -0,0 +1,187 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	cblog "github.com/charmbracelet/log"
+	"github.com/darksworm/argonaut/pkg/api"
+	appcontext "github.com/darksworm/argonaut/pkg/context"
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+// ensureRepositories (re)lists repositories whenever the repositories view is opened, so the
+// connection states are current
+func (m *Model) ensureRepositories() tea.Cmd {
+	m.repositoriesSession++
+	repos := m.state.Repositories
+	if repos == nil {
+		repos = &model.RepositoriesState{
+			Items:      map[string]model.Repository{},
+			Refreshing: map[string]bool{},
+		}
+		m.state.Repositories = repos
+	}
+	repos.Loading = true
+	repos.Session = m.repositoriesSession
+	return m.loadRepositories(repos.Session)
+}
+
+// loadRepositories lists all repositories
+func (m *Model) loadRepositories(session int) tea.Cmd {
+	epoch := m.switchEpoch // capture at call time
+	return func() tea.Msg {
+		if m.state.Server == nil {
+			return model.RepositoriesLoadedMsg{Session: session, Err: fmt.Errorf("no server configured"), SwitchEpoch: epoch}
+		}
+		ctx, cancel := appcontext.WithAPITimeout(context.Background())
+		defer cancel()
+
+		repos, err := api.NewRepositoryService(m.state.Server).ListRepositories(ctx)
+		if err != nil {
+			cblog.With("component", "repositories").Error("Failed to list repositories", "err", err)
+			return model.RepositoriesLoadedMsg{Session: session, Err: err, SwitchEpoch: epoch}
+		}
+		items := make([]model.Repository, 0, len(repos))
+		for _, r := range repos {
+			items = append(items, api.ConvertToRepository(r))
+		}
+		return model.RepositoriesLoadedMsg{Session: session, Items: items, SwitchEpoch: epoch}
+	}
+}
+
+// handleRepositoriesLoaded replaces the repositories with a fresh listing
+func (m *Model) handleRepositoriesLoaded(msg model.RepositoriesLoadedMsg) (tea.Model, tea.Cmd) {
+	repos := m.state.Repositories
+	if msg.SwitchEpoch != m.switchEpoch || repos == nil || repos.Session != msg.Session {
+		return m, nil
+	}
+	repos.Loading = false
+	if msg.Err != nil {
+		repos.Error = extractUserFriendlyError(msg.Err)
+		m.statusService.Error("Failed to list repositories: " + repos.Error)
+		return m, nil
+	}
+	repos.Error = ""
+	repos.Items = make(map[string]model.Repository, len(msg.Items))
+	for _, r := range msg.Items {
+		repos.Items[model.NormalizeRepoURL(r.URL)] = r
+	}
+	return m, nil
+}
+
+// repositoryURLs returns the URLs shown in the repositories view: every registered repository
+// plus repositories that apps use without them being registered (e.g. public repos)
+func (m *Model) repositoryURLs() []string {
+	seen := make(map[string]bool)
+	var urls []string
+	if repos := m.state.Repositories; repos != nil {
+		for key, r := range repos.Items {
+			seen[key] = true
+			urls = append(urls, r.URL)
+		}
+	}
+	if idx := m.state.Index; idx != nil {
+		for _, repoURL := range idx.Repos {
+			if key := model.NormalizeRepoURL(repoURL); !seen[key] {
+				seen[key] = true
+				urls = append(urls, repoURL)
+			}
+		}
+	}
+	sort.Slice(urls, func(i, j int) bool {
+		return model.NormalizeRepoURL(urls[i]) < model.NormalizeRepoURL(urls[j])
+	})
+	return urls
+}
+
+// resolveRepoArg matches a :repo argument against the known repositories. The scheme and a
+// trailing ".git" may be omitted.
+func (m *Model) resolveRepoArg(arg string) (string, bool) {
+	want := model.NormalizeRepoURL(arg)
+	for _, repoURL := range m.repositoryURLs() {
+		key := model.NormalizeRepoURL(repoURL)
+		if _, rest, ok := strings.Cut(key, "://"); key == want || (ok && rest == want) {
+			return repoURL, true
+		}
+	}
+	return "", false
+}
+
+// registeredRepository returns the registered repository for a URL
+func (m *Model) registeredRepository(repoURL string) (model.Repository, bool) {
+	if m.state.Repositories == nil {
+		return model.Repository{}, false
+	}
+	repo, ok := m.state.Repositories.Items[model.NormalizeRepoURL(repoURL)]
+	return repo, ok
+}
+
+// repositoryAppCount returns how many apps use a repository
+func (m *Model) repositoryAppCount(repoURL string) int {
+	if m.state.Index == nil {
+		return 0
+	}
+	return len(m.state.Index.ByRepo[model.NormalizeRepoURL(repoURL)])
+}
+
+// handleRefreshRepository re-checks the connection of the repository under the cursor
+func (m *Model) handleRefreshRepository() (tea.Model, tea.Cmd) {
+	items := m.getVisibleItemsForCurrentView()
+	if len(items) == 0 || m.state.Navigation.SelectedIdx >= len(items) {
+		return m, nil
+	}
+	repoURL, _ := items[m.state.Navigation.SelectedIdx].(string)
+	repo, ok := m.registeredRepository(repoURL)
+	if !ok {
+		return m, func() tea.Msg {
+			return model.StatusChangeMsg{Status: "Repository " + repoURL + " is not registered in ArgoCD"}
+		}
+	}
+	key := model.NormalizeRepoURL(repo.URL)
+	if m.state.Repositories.Refreshing[key] {
+		return m, nil
+	}
+	m.state.Repositories.Refreshing[key] = true
+	return m, m.refreshRepository(repo)
+}
+
+// refreshRepository forces ArgoCD to re-check the connection to a repository
+func (m *Model) refreshRepository(repo model.Repository) tea.Cmd {
+	epoch := m.switchEpoch // capture at call time
+	return func() tea.Msg {
+		if m.state.Server == nil {
+			return model.RepositoryRefreshedMsg{URL: repo.URL, Err: fmt.Errorf("no server configured"), SwitchEpoch: epoch}
+		}
+		ctx, cancel := appcontext.WithAPITimeout(context.Background())
+		defer cancel()
+
+		refreshed, err := api.NewRepositoryService(m.state.Server).RefreshRepository(ctx, repo.URL, repo.Project)
+		if err != nil {
+			cblog.With("component", "repositories").Error("Failed to refresh repository", "repo", repo.URL, "err", err)
+			return model.RepositoryRefreshedMsg{URL: repo.URL, Err: err, SwitchEpoch: epoch}
+		}
+		converted := api.ConvertToRepository(*refreshed)
+		return model.RepositoryRefreshedMsg{URL: repo.URL, Repo: &converted, SwitchEpoch: epoch}
+	}
+}
+
+// handleRepositoryRefreshed stores the re-checked connection state
+func (m *Model) handleRepositoryRefreshed(msg model.RepositoryRefreshedMsg) (tea.Model, tea.Cmd) {
+	repos := m.state.Repositories
+	if msg.SwitchEpoch != m.switchEpoch || repos == nil {
+		return m, nil
+	}
+	key := model.NormalizeRepoURL(msg.URL)
+	delete(repos.Refreshing, key)
+	if msg.Err != nil {
+		m.statusService.Error("Failed to refresh " + msg.URL + ": " + extractUserFriendlyError(msg.Err))
+		return m, nil
+	}
+	repos.Items[key] = *msg.Repo
+	m.statusService.Set(fmt.Sprintf("Connection to %s: %s", msg.URL, msg.Repo.ConnectionStatus))
+	return m, nil
+}
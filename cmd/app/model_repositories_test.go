@@ -0,0 +1,117 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+// runTestCommand types a command into the command bar and presses enter
+func runTestCommand(m *Model, command string) {
+	m.state.Mode = model.ModeCommand
+	m.inputComponents.SetCommandValue(command)
+	m.handleEnhancedCommandModeKeys(tea.KeyPressMsg{Code: tea.KeyEnter})
+}
+
+func buildRepositoriesTestModel() *Model {
+	m := buildDeleteTestModel(140, 30)
+	m.state.Apps[0].RepoURLs = []string{"https://github.com/example/apps.git"}
+	m.state.Apps[1].RepoURLs = []string{"https://github.com/example/public"}
+	m.state.Index = model.BuildAppIndex(m.state.Apps)
+	runTestCommand(m, "repos")
+	m.Update(model.RepositoriesLoadedMsg{Session: m.repositoriesSession, SwitchEpoch: m.switchEpoch, Items: []model.Repository{
+		{URL: "https://github.com/example/apps", Type: "git", Project: "test-project", ConnectionStatus: "Failed", ConnectionMessage: "authentication required"},
+		{URL: "https://github.com/example/unused.git", Type: "git", ConnectionStatus: "Successful"},
+	}})
+	return m
+}
+
+func TestRepositories_ListIncludesUnregisteredRepos(t *testing.T) {
+	m := buildRepositoriesTestModel()
+	if m.state.Navigation.View != model.ViewRepositories {
+		t.Fatalf("Expected repositories view, got %s", m.state.Navigation.View)
+	}
+
+	items := m.getVisibleItems()
+	want := []string{"https://github.com/example/apps", "https://github.com/example/public", "https://github.com/example/unused.git"}
+	if len(items) != len(want) {
+		t.Fatalf("Expected %v, got %v", want, items)
+	}
+	for i, w := range want {
+		if items[i] != w {
+			t.Errorf("Item %d = %v, want %s", i, items[i], w)
+		}
+	}
+
+	out := m.renderListView(20)
+	for _, s := range []string{"Failed", "authentication required", "not registered in ArgoCD", "Successful"} {
+		if !strings.Contains(out, s) {
+			t.Errorf("Expected %q in repositories view, got:\n%s", s, out)
+		}
+	}
+}
+
+func TestRepositories_DrillDownAndBack(t *testing.T) {
+	m := buildRepositoriesTestModel()
+	m.state.Navigation.SelectedIdx = 0
+
+	m.handleKeyMsg(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if m.state.Navigation.View != model.ViewApps {
+		t.Fatalf("Expected apps view after drill-down, got %s", m.state.Navigation.View)
+	}
+	apps := m.getVisibleItems()
+	if len(apps) != 1 || apps[0].(model.App).Name != "test-app" {
+		t.Fatalf("Expected only test-app in the repo scope, got %v", apps)
+	}
+	if !strings.Contains(m.renderStatusLine(), "<apps in https://github.com/example/apps>") {
+		t.Errorf("Expected the repo breadcrumb, got %s", m.renderStatusLine())
+	}
+
+	m.handleKeyMsg(testKeyMsg("esc"))
+	if m.state.Navigation.View != model.ViewRepositories || len(m.state.Selections.ScopeRepos) != 0 {
+		t.Fatalf("Expected esc to return to the repositories view, got %s", m.state.Navigation.View)
+	}
+}
+
+func TestRepositories_RepoCommandScopesApps(t *testing.T) {
+	m := buildRepositoriesTestModel()
+
+	runTestCommand(m, "repo github.com/example/public")
+	if m.state.Navigation.View != model.ViewApps || !m.state.Selections.HasRepo("https://github.com/example/public") {
+		t.Fatalf("Expected apps scoped to the public repo, got view %s scope %v", m.state.Navigation.View, m.state.Selections.ScopeRepos)
+	}
+	if m.validateCommand(":repo https://example.com/unknown") {
+		t.Error("Expected an unknown repository to be invalid")
+	}
+}
+
+func TestRepositories_RefreshConnection(t *testing.T) {
+	m := buildRepositoriesTestModel()
+	m.state.Navigation.SelectedIdx = 0
+
+	_, cmd := m.handleKeyMsg(testKeyMsg("r"))
+	if cmd == nil || !m.state.Repositories.Refreshing["https://github.com/example/apps"] {
+		t.Fatal("Expected r to start a connection refresh")
+	}
+	if out := m.renderListView(20); !strings.Contains(out, "Checking…") {
+		t.Errorf("Expected a checking state while refreshing, got:\n%s", out)
+	}
+
+	m.Update(model.RepositoryRefreshedMsg{URL: "https://github.com/example/apps", SwitchEpoch: m.switchEpoch, Repo: &model.Repository{
+		URL: "https://github.com/example/apps", Type: "git", Project: "test-project", ConnectionStatus: "Successful",
+	}})
+	if m.state.Repositories.Refreshing["https://github.com/example/apps"] {
+		t.Error("Expected the refresh to finish")
+	}
+	if got := m.state.Repositories.Items["https://github.com/example/apps"].ConnectionStatus; got != "Successful" {
+		t.Errorf("Expected the new connection state, got %s", got)
+	}
+
+	// Repos only referenced by apps cannot be refreshed
+	m.state.Navigation.SelectedIdx = 1
+	if _, cmd := m.handleKeyMsg(testKeyMsg("r")); cmd == nil || len(m.state.Repositories.Refreshing) != 0 {
+		t.Error("Expected a status message instead of a refresh for an unregistered repo")
+	}
+}
@@ -0,0 +1,185 @@
+package main
+
+import (
+	"fmt"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/darksworm/argonaut/pkg/diff"
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+// handleResourceDiffsLoaded opens the per-resource diff navigator for an app diff
+func (m *Model) handleResourceDiffsLoaded(msg model.ResourceDiffsLoadedMsg) (tea.Model, tea.Cmd) {
+	if msg.SwitchEpoch != m.switchEpoch {
+		return m, nil
+	}
+	m.state.ResourceDiffs = &model.ResourceDiffsState{AppName: msg.AppName, Items: msg.Items}
+	m.resourceDiffNav.Reset()
+	m.state.Mode = model.ModeResourceDiffs
+	return m, nil
+}
+
+// handleResourceDiffsKeys handles non-navigation keys in the per-resource diff navigator
+func (m *Model) handleResourceDiffsKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	state := m.state.ResourceDiffs
+	if state == nil {
+		m.state.Mode = model.ModeNormal
+		return m, nil
+	}
+	switch msg.String() {
+	case "q", "esc":
+		m.closeResourceDiffs()
+		return m, nil
+	case "enter":
+		return m, m.openResourceDiffItem(state.SelectedIdx)
+	case "n":
+		return m, m.stepResourceDiff(1)
+	case "p":
+		return m, m.stepResourceDiff(-1)
+	case "a":
+		return m, m.openAllResourceDiffs()
+	case "s":
+		return m.confirmResourceDiffSync()
+	}
+	return m, nil
+}
+
+// closeResourceDiffs leaves the navigator and drops any diff opened from it
+func (m *Model) closeResourceDiffs() {
+	m.state.Mode = model.ModeNormal
+	m.state.ResourceDiffs = nil
+	m.state.Diff = nil
+	m.diffSections = nil
+}
+
+// selectResourceDiff moves the navigator cursor to idx
+func (m *Model) selectResourceDiff(idx int) {
+	m.state.ResourceDiffs.SelectedIdx = idx
+	m.resourceDiffNav.SetItemCount(len(m.state.ResourceDiffs.Items))
+	m.resourceDiffNav.SetViewportHeight(m.resourceDiffsPageSize())
+	m.resourceDiffNav.SetCursor(idx)
+}
+
+// stepResourceDiff selects the next (dir > 0) or previous changed resource and opens its diff
+func (m *Model) stepResourceDiff(dir int) tea.Cmd {
+	state := m.state.ResourceDiffs
+	if state == nil {
+		return nil
+	}
+	next := state.SelectedIdx + dir
+	if next < 0 || next >= len(state.Items) {
+		return func() tea.Msg { return model.StatusChangeMsg{Status: "No more changed resources"} }
+	}
+	m.selectResourceDiff(next)
+	return m.openResourceDiffItem(next)
+}
+
+// openResourceDiffItem shows the diff of a single changed resource
+func (m *Model) openResourceDiffItem(idx int) tea.Cmd {
+	state := m.state.ResourceDiffs
+	if state == nil || idx < 0 || idx >= len(state.Items) {
+		return nil
+	}
+	item := state.Items[idx]
+	title := diffResourceTitle(item.Kind, item.Namespace, item.Name)
+	resourceTitle := fmt.Sprintf("%s/%s", item.Kind, item.Name)
+	if item.Namespace != "" {
+		resourceTitle = fmt.Sprintf("%s/%s/%s", item.Namespace, item.Kind, item.Name)
+	}
+	return m.presentDiffCmd(diffRequest{
+		Title:          fmt.Sprintf("%s - Live vs Desired (%d/%d)", resourceTitle, idx+1, len(state.Items)),
+		FormatterTitle: resourceTitle,
+		LeftPrefix:     "current-",
+		RightPrefix:    "predicted-",
+		Resources:      []diff.Resource{{Title: title, Left: item.Live, Right: item.Desired}},
+	})
+}
+
+// openAllResourceDiffs shows every changed resource in one diff
+func (m *Model) openAllResourceDiffs() tea.Cmd {
+	state := m.state.ResourceDiffs
+	if state == nil {
+		return nil
+	}
+	resources := make([]diff.Resource, len(state.Items))
+	for i, item := range state.Items {
+		resources[i] = diff.Resource{Title: diffResourceTitle(item.Kind, item.Namespace, item.Name), Left: item.Live, Right: item.Desired}
+	}
+	return m.presentDiffCmd(diffRequest{
+		Title:          fmt.Sprintf("%s - Live vs Desired", state.AppName),
+		FormatterTitle: state.AppName,
+		LeftPrefix:     "current-",
+		RightPrefix:    "predicted-",
+		Resources:      resources,
+	})
+}
+
+// presentDiffCmd runs presentDiff in the background
+func (m *Model) presentDiffCmd(req diffRequest) tea.Cmd {
+	epoch := m.switchEpoch // capture at call time
+	return func() tea.Msg {
+		msg, changed := m.presentDiff(req, epoch)
+		if !changed {
+			return model.StatusChangeMsg{Status: "No differences"}
+		}
+		return msg
+	}
+}
+
+// confirmResourceDiffSync opens the resource sync confirmation for the selected resource
+func (m *Model) confirmResourceDiffSync() (tea.Model, tea.Cmd) {
+	state := m.state.ResourceDiffs
+	if state == nil || state.SelectedIdx < 0 || state.SelectedIdx >= len(state.Items) {
+		return m, nil
+	}
+	if cmd := m.operationDenied(model.OpSync, state.AppName); cmd != nil {
+		return m, cmd
+	}
+	item := state.Items[state.SelectedIdx]
+	appName := state.AppName
+	m.state.Mode = model.ModeConfirmResourceSync
+	m.state.Modals.ResourceSyncAppName = &appName
+	m.state.Modals.ResourceSyncTargets = []model.ResourceSyncTarget{{
+		AppName:   appName,
+		Group:     item.Group,
+		Kind:      item.Kind,
+		Namespace: item.Namespace,
+		Name:      item.Name,
+	}}
+	m.state.Modals.ResourceSyncConfirmSelected = 0 // Default to Sync
+	m.state.Modals.ResourceSyncError = nil
+	m.state.Modals.ResourceSyncLoading = false
+	m.state.Modals.ResourceSyncPrune = false
+	m.state.Modals.ResourceSyncForce = false
+	return m, nil
+}
+
+// resourceDiffsReturnMode is the mode to return to when a diff or resource sync
+// modal closes: the diff navigator when it was opened from there
+func (m *Model) resourceDiffsReturnMode() model.Mode {
+	if m.state.ResourceDiffs != nil {
+		return model.ModeResourceDiffs
+	}
+	return model.ModeNormal
+}
+
+// markResourceDiffsSynced flags navigator entries that were just synced
+func (m *Model) markResourceDiffsSynced(targets []model.ResourceSyncTarget) {
+	if m.state.ResourceDiffs == nil {
+		return
+	}
+	for i := range m.state.ResourceDiffs.Items {
+		item := &m.state.ResourceDiffs.Items[i]
+		for _, t := range targets {
+			if t.Group == item.Group && t.Kind == item.Kind && t.Namespace == item.Namespace && t.Name == item.Name {
+				item.Synced = true
+			}
+		}
+	}
+}
+
+// resourceDiffsPageSize returns the number of visible rows in the diff navigator
+func (m *Model) resourceDiffsPageSize() int {
+	// Title, column header, border (2) and status line
+	return max(1, m.state.Terminal.Rows-6)
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/darksworm/argonaut/pkg/config"
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+func resourceDiffsTestModel(t *testing.T) *Model {
+	t.Helper()
+	m := buildDeleteTestModel(120, 30)
+	m.config = &config.ArgonautConfig{Diff: config.DiffConfig{Engine: "builtin"}}
+	m.Update(model.ResourceDiffsLoadedMsg{
+		AppName: "test-app",
+		Items: []model.ResourceDiffItem{
+			{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "demo-deploy", Added: 1, Removed: 1,
+				Live: "spec:\n  replicas: 1\n", Desired: "spec:\n  replicas: 3\n"},
+			{Kind: "ConfigMap", Namespace: "default", Name: "demo-config", Added: 1,
+				Live: "data:\n  a: one\n", Desired: "data:\n  a: one\n  b: two\n"},
+		},
+		SwitchEpoch: m.switchEpoch,
+	})
+	if m.state.Mode != model.ModeResourceDiffs {
+		t.Fatalf("Expected the diff navigator, got mode %s", m.state.Mode)
+	}
+	return m
+}
+
+// runCmd feeds the message produced by cmd back into the model
+func runCmd(m *Model, cmd tea.Cmd) {
+	if cmd != nil {
+		m.Update(cmd())
+	}
+}
+
+func TestResourceDiffs_ListsChangedResources(t *testing.T) {
+	m := resourceDiffsTestModel(t)
+	out := stripANSI(m.renderResourceDiffsView())
+	for _, want := range []string{"Diff · test-app · 2 changed resources", "Deployment", "demo-deploy", "ConfigMap", "+1 -0", "n/p next/prev diff"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected %q in the navigator:\n%s", want, out)
+		}
+	}
+}
+
+func TestResourceDiffs_OpenStepAndReturn(t *testing.T) {
+	m := resourceDiffsTestModel(t)
+
+	_, cmd := m.handleResourceDiffsKeys(tea.KeyPressMsg{Code: tea.KeyEnter})
+	runCmd(m, cmd)
+	if m.state.Mode != model.ModeDiff || len(m.diffSections) != 1 || m.diffSections[0].Title != "Deployment default/demo-deploy" {
+		t.Fatalf("Expected the Deployment diff, got mode %s sections %+v", m.state.Mode, m.diffSections)
+	}
+
+	_, cmd = m.handleDiffModeKeys(testKeyMsg("n"))
+	runCmd(m, cmd)
+	if m.state.ResourceDiffs.SelectedIdx != 1 || m.diffSections[0].Title != "ConfigMap default/demo-config" {
+		t.Fatalf("Expected n to open the ConfigMap diff, got %+v", m.diffSections)
+	}
+
+	m.handleDiffModeKeys(testKeyMsg("esc"))
+	if m.state.Mode != model.ModeResourceDiffs || m.state.Diff != nil {
+		t.Fatalf("Expected esc to return to the navigator, got mode %s", m.state.Mode)
+	}
+
+	m.handleResourceDiffsKeys(testKeyMsg("q"))
+	if m.state.Mode != model.ModeNormal || m.state.ResourceDiffs != nil {
+		t.Errorf("Expected q to close the navigator, got mode %s", m.state.Mode)
+	}
+}
+
+func TestResourceDiffs_SyncSelectedResource(t *testing.T) {
+	m := resourceDiffsTestModel(t)
+
+	m.handleResourceDiffsKeys(testKeyMsg("s"))
+	targets := m.state.Modals.ResourceSyncTargets
+	if m.state.Mode != model.ModeConfirmResourceSync || len(targets) != 1 || targets[0].Name != "demo-deploy" || targets[0].Group != "apps" {
+		t.Fatalf("Expected a sync confirmation for the Deployment, got mode %s targets %+v", m.state.Mode, targets)
+	}
+
+	m.Update(model.ResourceSyncSuccessMsg{Count: 1, AppNames: []string{"test-app"}, SwitchEpoch: m.switchEpoch})
+	if m.state.Mode != model.ModeResourceDiffs {
+		t.Fatalf("Expected to return to the navigator, got mode %s", m.state.Mode)
+	}
+	if !m.state.ResourceDiffs.Items[0].Synced || m.state.ResourceDiffs.Items[1].Synced {
+		t.Errorf("Expected only the Deployment to be marked synced: %+v", m.state.ResourceDiffs.Items)
+	}
+}
+
+func TestResourceDiffs_KeysOutsideNavigator(t *testing.T) {
+	m := buildDeleteTestModel(120, 30)
+	m.state.Diff = &model.DiffState{Title: "plain diff", Content: []string{"+a"}}
+	m.state.Mode = model.ModeDiff
+
+	for _, key := range []string{"n", "p", "s"} {
+		if _, cmd := m.handleDiffModeKeys(testKeyMsg(key)); cmd != nil || m.state.Mode != model.ModeDiff {
+			t.Errorf("Expected %q to do nothing in a plain diff, got mode %s", key, m.state.Mode)
+		}
+	}
+	if m.stepResourceDiff(1) != nil || m.openAllResourceDiffs() != nil {
+		t.Error("Expected no command without a resource diff list")
+	}
+}
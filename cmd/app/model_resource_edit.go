@@ -0,0 +1,365 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	cblog "github.com/charmbracelet/log"
+	"github.com/darksworm/argonaut/pkg/api"
+	appcontext "github.com/darksworm/argonaut/pkg/context"
+	"github.com/darksworm/argonaut/pkg/diff"
+	"github.com/darksworm/argonaut/pkg/mergepatch"
+	"github.com/darksworm/argonaut/pkg/model"
+	yaml "gopkg.in/yaml.v3"
+)
+
+// handleEditResource fetches the tree resource under the cursor and opens it in $EDITOR
+func (m *Model) handleEditResource() (tea.Model, tea.Cmd) {
+	if m.state.Navigation.View != model.ViewTree || m.treeView == nil {
+		return m, nil
+	}
+	res, ok := m.treeView.CurrentResource()
+	if !ok {
+		return m, func() tea.Msg {
+			return model.StatusChangeMsg{Status: "Select a resource to edit"}
+		}
+	}
+	if res.IsMissing() {
+		return m, func() tea.Msg {
+			return model.StatusChangeMsg{Status: "Cannot edit: resource is missing"}
+		}
+	}
+
+	target := model.ResourceActionTarget{
+		AppName:   res.AppName,
+		Group:     res.Group,
+		Version:   res.Version,
+		Kind:      res.Kind,
+		Namespace: res.Namespace,
+		Name:      res.Name,
+	}
+	for i := range m.state.Apps {
+		if m.state.Apps[i].Name == res.AppName {
+			target.AppNamespace = m.state.Apps[i].AppNamespace
+			break
+		}
+	}
+
+	m.resourceEditSession++
+	m.state.ResourceEdit = &model.ResourceEditState{Target: target, Session: m.resourceEditSession}
+	m.statusService.Set(fmt.Sprintf("Opening %s/%s in editor…", res.Kind, res.Name))
+
+	cblog.With("component", "resource-edit").Debug("Editing resource", "kind", res.Kind, "name", res.Name)
+	return m, m.editLiveResource(target, m.resourceEditSession)
+}
+
+// editLiveResource loads the live object and opens it in the editor
+func (m *Model) editLiveResource(target model.ResourceActionTarget, session int) tea.Cmd {
+	epoch := m.switchEpoch // capture at call time
+	return func() tea.Msg {
+		if m.state.Server == nil {
+			return model.ResourceEditedMsg{Session: session, Err: fmt.Errorf("no server configured"), SwitchEpoch: epoch}
+		}
+		ctx, cancel := appcontext.WithAPITimeout(context.Background())
+		defer cancel()
+
+		appService := api.NewApplicationService(m.state.Server)
+		live, err := appService.GetResource(ctx, resourceParams(target))
+		if err != nil {
+			cblog.With("component", "resource-edit").Error("Failed to get resource", "kind", target.Kind, "name", target.Name, "err", err)
+			return model.ResourceEditedMsg{Session: session, Err: err, SwitchEpoch: epoch}
+		}
+		original, err := editableYAML(live)
+		if err != nil {
+			return model.ResourceEditedMsg{Session: session, Err: err, SwitchEpoch: epoch}
+		}
+
+		edited, err := m.runEditor(original)
+		return model.ResourceEditedMsg{Session: session, Original: original, Edited: edited, Err: err, SwitchEpoch: epoch}
+	}
+}
+
+// reopenResourceEditor opens the previously edited YAML in the editor again
+func (m *Model) reopenResourceEditor(edit model.ResourceEditState) tea.Cmd {
+	epoch := m.switchEpoch // capture at call time
+	return func() tea.Msg {
+		edited, err := m.runEditor(edit.Edited)
+		return model.ResourceEditedMsg{Session: edit.Session, Original: edit.Original, Edited: edited, Err: err, SwitchEpoch: epoch}
+	}
+}
+
+// runEditor releases the terminal, opens text in $EDITOR and returns the saved content
+func (m *Model) runEditor(text string) (string, error) {
+	file, err := writeTempYAML("edit-", []string{text})
+	if err != nil {
+		return "", fmt.Errorf("failed to create temp file: %w", err)
+	}
+	defer os.Remove(file)
+
+	if m.program != nil {
+		m.program.Send(pauseRenderingMsg{})
+		_ = m.program.ReleaseTerminal()
+	}
+	defer func() {
+		fmt.Print("\x1b[2J\x1b[H")
+		time.Sleep(150 * time.Millisecond)
+		if m.program != nil {
+			_ = m.program.RestoreTerminal()
+			m.program.Send(resumeRenderingMsg{})
+		}
+	}()
+
+	editor := os.Getenv("EDITOR")
+	if strings.TrimSpace(editor) == "" {
+		editor = "vi"
+	}
+	// EDITOR may include arguments (e.g. "code --wait")
+	c := exec.Command("sh", "-lc", editor+" "+shellEscape(file))
+	c.Stdin = os.Stdin
+	c.Stdout = os.Stdout
+	c.Stderr = os.Stderr
+	if err := c.Run(); err != nil {
+		cblog.With("component", "resource-edit").Error("Editor failed", "editor", editor, "err", err)
+		return "", fmt.Errorf("editor %s failed: %w", editor, err)
+	}
+
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return "", fmt.Errorf("failed to read edited file: %w", err)
+	}
+	return string(data), nil
+}
+
+// handleResourceEdited validates the edit and shows the change preview
+func (m *Model) handleResourceEdited(msg model.ResourceEditedMsg) (tea.Model, tea.Cmd) {
+	edit := m.state.ResourceEdit
+	if msg.SwitchEpoch != m.switchEpoch || edit == nil || edit.Session != msg.Session {
+		return m, nil
+	}
+	if msg.Err != nil {
+		m.state.ResourceEdit = nil
+		m.state.Mode = model.ModeNormal
+		m.statusService.Set("Edit failed: " + extractUserFriendlyError(msg.Err))
+		return m, nil
+	}
+
+	edit.Original = msg.Original
+	edit.Edited = msg.Edited
+	edit.Offset = 0
+	edit.Error = ""
+	edit.Patch = ""
+	edit.Diff = nil
+
+	patch, err := buildResourcePatch(msg.Original, msg.Edited)
+	if err != nil {
+		// Keep the review screen open so the edit can be fixed
+		edit.Error = err.Error()
+		m.state.Mode = model.ModeResourceEdit
+		return m, nil
+	}
+	if patch == "" {
+		m.state.ResourceEdit = nil
+		m.state.Mode = model.ModeNormal
+		m.statusService.Set("Edit cancelled, no changes made")
+		return m, nil
+	}
+
+	edit.Patch = patch
+	edit.Diff = m.resourceEditDiff(edit)
+	m.state.Mode = model.ModeResourceEdit
+	return m, nil
+}
+
+// applyResourceEdit sends the merge patch for the reviewed edit
+func (m *Model) applyResourceEdit(edit model.ResourceEditState) tea.Cmd {
+	epoch := m.switchEpoch // capture at call time
+	return func() tea.Msg {
+		if m.state.Server == nil {
+			return model.ResourcePatchedMsg{Session: edit.Session, Err: fmt.Errorf("no server configured"), SwitchEpoch: epoch}
+		}
+		ctx, cancel := appcontext.WithAPITimeout(context.Background())
+		defer cancel()
+
+		appService := api.NewApplicationService(m.state.Server)
+		_, err := appService.PatchResource(ctx, resourceParams(edit.Target), edit.Patch, api.MergePatchType)
+		if err != nil {
+			cblog.With("component", "resource-edit").Error("Failed to patch resource", "kind", edit.Target.Kind, "name", edit.Target.Name, "err", err)
+		}
+		return model.ResourcePatchedMsg{Session: edit.Session, Err: err, SwitchEpoch: epoch}
+	}
+}
+
+// handleResourcePatched closes the review screen on success or shows the error inline
+func (m *Model) handleResourcePatched(msg model.ResourcePatchedMsg) (tea.Model, tea.Cmd) {
+	edit := m.state.ResourceEdit
+	if msg.SwitchEpoch != m.switchEpoch || edit == nil || edit.Session != msg.Session {
+		return m, nil
+	}
+	edit.Applying = false
+	if msg.Err != nil {
+		edit.Error = extractUserFriendlyError(msg.Err)
+		return m, nil
+	}
+	m.statusService.Set(fmt.Sprintf("Patched %s/%s", edit.Target.Kind, edit.Target.Name))
+	m.state.ResourceEdit = nil
+	m.state.Mode = model.ModeNormal
+	return m, nil
+}
+
+// handleResourceEditKeys handles input on the edit review screen.
+// Navigation keys (up/k, down/j, pgup, pgdown, g, G) are handled by the centralized router.
+func (m *Model) handleResourceEditKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	edit := m.state.ResourceEdit
+	if edit == nil {
+		m.state.Mode = model.ModeNormal
+		return m, nil
+	}
+	if edit.Applying {
+		return m, nil
+	}
+	switch msg.String() {
+	case "q", "esc":
+		m.state.ResourceEdit = nil
+		m.state.Mode = model.ModeNormal
+		m.statusService.Set("Edit discarded")
+		return m, nil
+	case "e":
+		return m, m.reopenResourceEditor(*edit)
+	case "y", "enter":
+		if edit.Patch == "" {
+			return m, nil
+		}
+		edit.Applying = true
+		edit.Error = ""
+		return m, m.applyResourceEdit(*edit)
+	}
+	return m, nil
+}
+
+// resourceEditPageSize returns the number of visible diff lines on the edit review screen
+func (m *Model) resourceEditPageSize() int {
+	// title + status + error line + content border + main container padding
+	overhead := 6
+	return max(3, m.state.Terminal.Rows-overhead)
+}
+
+// resourceParams converts a tree resource target to API parameters
+func resourceParams(target model.ResourceActionTarget) api.GetResourceParams {
+	return api.GetResourceParams{
+		AppName:      target.AppName,
+		AppNamespace: target.AppNamespace,
+		ResourceName: target.Name,
+		Namespace:    target.Namespace,
+		Kind:         target.Kind,
+		Group:        target.Group,
+		Version:      target.Version,
+	}
+}
+
+// editableYAML converts a live object to the YAML opened in the editor.
+// Status and managed fields are dropped; they cannot be changed through a patch.
+func editableYAML(liveJSON string) (string, error) {
+	var obj map[string]interface{}
+	if err := json.Unmarshal([]byte(liveJSON), &obj); err != nil {
+		return "", fmt.Errorf("failed to parse live resource: %w", err)
+	}
+	delete(obj, "status")
+	if meta, ok := obj["metadata"].(map[string]interface{}); ok {
+		delete(meta, "managedFields")
+	}
+	out, err := yaml.Marshal(obj)
+	if err != nil {
+		return "", fmt.Errorf("failed to convert resource to YAML: %w", err)
+	}
+	return string(out), nil
+}
+
+// buildResourcePatch computes the merge patch for an edit. It returns an empty
+// patch when nothing changed, and an error when the edited YAML is invalid or
+// changes the identity of the resource.
+func buildResourcePatch(originalYAML, editedYAML string) (string, error) {
+	var original, edited map[string]interface{}
+	if err := yaml.Unmarshal([]byte(originalYAML), &original); err != nil {
+		return "", fmt.Errorf("original resource is not valid YAML: %w", err)
+	}
+	if err := yaml.Unmarshal([]byte(editedYAML), &edited); err != nil {
+		return "", fmt.Errorf("edited resource is not valid YAML: %w", err)
+	}
+	if edited == nil {
+		return "", fmt.Errorf("edited resource is empty")
+	}
+
+	for _, field := range []string{"apiVersion", "kind"} {
+		if fmt.Sprint(original[field]) != fmt.Sprint(edited[field]) {
+			return "", fmt.Errorf("changing %s is not supported", field)
+		}
+	}
+	origMeta, _ := original["metadata"].(map[string]interface{})
+	editMeta, _ := edited["metadata"].(map[string]interface{})
+	for _, field := range []string{"name", "namespace"} {
+		if fmt.Sprint(origMeta[field]) != fmt.Sprint(editMeta[field]) {
+			return "", fmt.Errorf("changing metadata.%s is not supported", field)
+		}
+	}
+
+	origJSON, err := json.Marshal(original)
+	if err != nil {
+		return "", fmt.Errorf("failed to convert original resource: %w", err)
+	}
+	editJSON, err := json.Marshal(edited)
+	if err != nil {
+		return "", fmt.Errorf("failed to convert edited resource: %w", err)
+	}
+	patch, err := mergepatch.Create(origJSON, editJSON)
+	if err != nil {
+		return "", err
+	}
+	if mergepatch.IsEmpty(patch) {
+		return "", nil
+	}
+
+	// Pin the resourceVersion that was edited so concurrent changes surface as a conflict
+	if rv, ok := origMeta["resourceVersion"].(string); ok && rv != "" {
+		var obj map[string]interface{}
+		if err := json.Unmarshal(patch, &obj); err == nil {
+			meta, _ := obj["metadata"].(map[string]interface{})
+			if meta == nil {
+				meta = map[string]interface{}{}
+			}
+			meta["resourceVersion"] = rv
+			obj["metadata"] = meta
+			if pinned, err := json.Marshal(obj); err == nil {
+				patch = pinned
+			}
+		}
+	}
+	return string(patch), nil
+}
+
+// resourceEditDiff renders a colored unified diff between the original and edited YAML
+func (m *Model) resourceEditDiff(edit *model.ResourceEditState) []string {
+	if !m.useBuiltinDiff() {
+		leftFile, _ := writeTempYAML("original-", []string{edit.Original})
+		rightFile, _ := writeTempYAML("edited-", []string{edit.Edited})
+		defer os.Remove(leftFile)
+		defer os.Remove(rightFile)
+
+		cmd := exec.Command("git", "--no-pager", "diff", "--no-index", "--color=always", "--", leftFile, rightFile)
+		out, err := cmd.CombinedOutput()
+		if err == nil || (cmd.ProcessState != nil && cmd.ProcessState.ExitCode() == 1) {
+			cleaned := stripDiffHeader(string(out))
+			return strings.Split(strings.TrimRight(cleaned, "\n"), "\n")
+		}
+		// git failed: fall through to the built-in engine
+	}
+
+	t := edit.Target
+	sections := diff.Compute([]diff.Resource{{Title: diffResourceTitle(t.Kind, t.Namespace, t.Name), Left: edit.Original, Right: edit.Edited}})
+	return diff.Renderer{Palette: currentPalette, Width: m.contentInnerWidth()}.Unified(sections).Lines
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/darksworm/argonaut/pkg/config"
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+const testEditOriginal = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+  namespace: default
+  resourceVersion: "42"
+spec:
+  replicas: 2
+`
+
+func TestEditableYAML_DropsStatusAndManagedFields(t *testing.T) {
+	out, err := editableYAML(`{"kind":"ConfigMap","metadata":{"name":"cfg","managedFields":[{"manager":"kubectl"}]},"data":{"a":"1"},"status":{"x":1}}`)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if strings.Contains(out, "status") || strings.Contains(out, "managedFields") {
+		t.Errorf("Expected status and managedFields to be dropped, got:\n%s", out)
+	}
+	if !strings.Contains(out, "a: \"1\"") {
+		t.Errorf("Expected data to be kept, got:\n%s", out)
+	}
+}
+
+func TestBuildResourcePatch(t *testing.T) {
+	patch, err := buildResourcePatch(testEditOriginal, strings.Replace(testEditOriginal, "replicas: 2", "replicas: 3", 1))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if patch != `{"metadata":{"resourceVersion":"42"},"spec":{"replicas":3}}` {
+		t.Errorf("Unexpected patch: %s", patch)
+	}
+
+	if patch, err := buildResourcePatch(testEditOriginal, testEditOriginal); err != nil || patch != "" {
+		t.Errorf("Expected empty patch for unchanged resource, got %q (%v)", patch, err)
+	}
+	if _, err := buildResourcePatch(testEditOriginal, "spec: [\n"); err == nil {
+		t.Error("Expected error for invalid YAML")
+	}
+	if _, err := buildResourcePatch(testEditOriginal, strings.Replace(testEditOriginal, "name: web", "name: api", 1)); err == nil {
+		t.Error("Expected error when renaming the resource")
+	}
+}
+
+func TestResourceEdited_ReviewAndApply(t *testing.T) {
+	m := buildActionsTestModel()
+	m.handleEditResource()
+	if m.state.ResourceEdit == nil {
+		t.Fatal("Expected edit to start for the cursor resource")
+	}
+	session := m.state.ResourceEdit.Session
+	edited := strings.Replace(testEditOriginal, "replicas: 2", "replicas: 3", 1)
+
+	m.Update(model.ResourceEditedMsg{Session: session, Original: testEditOriginal, Edited: edited, SwitchEpoch: m.switchEpoch})
+	if m.state.Mode != model.ModeResourceEdit {
+		t.Fatalf("Expected review screen, got %s", m.state.Mode)
+	}
+	if m.state.ResourceEdit.Patch == "" || len(m.state.ResourceEdit.Diff) == 0 {
+		t.Fatal("Expected a patch and a diff preview")
+	}
+
+	_, cmd := m.handleResourceEditKeys(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if cmd == nil || !m.state.ResourceEdit.Applying {
+		t.Fatal("Expected patch to be applied after confirmation")
+	}
+
+	// Server errors are shown inline and the edit can be retried
+	m.Update(model.ResourcePatchedMsg{Session: session, Err: errors.New("the object has been modified"), SwitchEpoch: m.switchEpoch})
+	if m.state.Mode != model.ModeResourceEdit || m.state.ResourceEdit.Applying {
+		t.Fatal("Expected review screen to stay open on error")
+	}
+	if m.state.ResourceEdit.Error != "the object has been modified" {
+		t.Errorf("Expected inline error, got %q", m.state.ResourceEdit.Error)
+	}
+
+	m.handleResourceEditKeys(testKeyMsg("y"))
+	m.Update(model.ResourcePatchedMsg{Session: session, SwitchEpoch: m.switchEpoch})
+	if m.state.Mode != model.ModeNormal || m.state.ResourceEdit != nil {
+		t.Error("Expected review screen to close after a successful patch")
+	}
+}
+
+func TestResourceEdited_InvalidAndUnchanged(t *testing.T) {
+	m := buildActionsTestModel()
+	m.handleEditResource()
+	session := m.state.ResourceEdit.Session
+
+	m.Update(model.ResourceEditedMsg{Session: session, Original: testEditOriginal, Edited: "spec: [\n", SwitchEpoch: m.switchEpoch})
+	if m.state.Mode != model.ModeResourceEdit || m.state.ResourceEdit.Error == "" {
+		t.Fatal("Expected invalid edit to be reported inline")
+	}
+	if _, cmd := m.handleResourceEditKeys(testKeyMsg("y")); cmd != nil {
+		t.Error("Expected invalid edit not to be applied")
+	}
+
+	m.Update(model.ResourceEditedMsg{Session: session, Original: testEditOriginal, Edited: testEditOriginal, SwitchEpoch: m.switchEpoch})
+	if m.state.Mode != model.ModeNormal || m.state.ResourceEdit != nil {
+		t.Error("Expected unchanged edit to be discarded")
+	}
+}
+
+func TestResourceEditDiff_BuiltinEngine(t *testing.T) {
+	m := buildActionsTestModel()
+	m.config = &config.ArgonautConfig{Diff: config.DiffConfig{Engine: "builtin"}}
+	edit := &model.ResourceEditState{
+		Target:   model.ResourceActionTarget{Kind: "Deployment", Namespace: "default", Name: "web"},
+		Original: testEditOriginal,
+		Edited:   strings.Replace(testEditOriginal, "replicas: 2", "replicas: 3", 1),
+	}
+	got := stripANSI(strings.Join(m.resourceEditDiff(edit), "\n"))
+	for _, want := range []string{"━━ Deployment default/web +1 -1", " spec:", "-  replicas: 2", "+  replicas: 3"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Expected %q in the preview:\n%s", want, got)
+		}
+	}
+}
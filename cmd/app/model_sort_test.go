@@ -0,0 +1,42 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/darksworm/argonaut/pkg/config"
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+func TestSortCommand_MultiKeyPersists(t *testing.T) {
+	t.Setenv("ARGONAUT_CONFIG", filepath.Join(t.TempDir(), "config.toml"))
+	m := buildDeleteTestModel(140, 30)
+	recent, older := time.Now().Add(-time.Minute), time.Now().Add(-time.Hour)
+	m.state.Apps = append(m.state.Apps, model.App{Name: "aaa-app", Sync: "Synced", Health: "Healthy", LastSyncAt: &older})
+	m.state.Apps[0].LastSyncAt = &recent
+	m.state.Index = model.BuildAppIndex(m.state.Apps)
+	m.state.Navigation.View = model.ViewApps
+
+	runTestCommand(m, "sort health desc, lastSync desc")
+	want := "health desc, lastSync desc"
+	if got := m.state.UI.Sort.String(); got != want {
+		t.Fatalf("Expected sort %q, got %q", want, got)
+	}
+	// Both healthy apps come first, the most recently synced one leading
+	if got := visibleAppNames(m); len(got) != 3 || got[0] != "test-app" || got[1] != "aaa-app" || got[2] != "zzz-other-app" {
+		t.Errorf("Unexpected order %v", got)
+	}
+
+	cfg, err := config.LoadArgonautConfig()
+	if err != nil {
+		t.Fatalf("LoadArgonautConfig: %v", err)
+	}
+	if got := cfg.GetSortConfig().String(); got != want {
+		t.Errorf("Expected the sort to be saved, got %q", got)
+	}
+
+	if m.validateCommand(":sort health desc, lastSync") {
+		t.Error("Expected a key without direction to be invalid")
+	}
+}
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	cblog "github.com/charmbracelet/log"
+	"github.com/darksworm/argonaut/pkg/api"
+	appcontext "github.com/darksworm/argonaut/pkg/context"
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+// syncProgressTickInterval is how often the sync progress view redraws its elapsed time
+const syncProgressTickInterval = time.Second
+
+// syncProgressTickMsg redraws the sync progress view while it is open
+type syncProgressTickMsg struct{ session int }
+
+// handleOpenSyncProgress opens the sync progress view for the app under the cursor
+// (apps view) or the app of the selected tree node (tree view)
+func (m *Model) handleOpenSyncProgress() (tea.Model, tea.Cmd) {
+	appName := ""
+	switch m.state.Navigation.View {
+	case model.ViewApps:
+		items := m.getVisibleItemsForCurrentView()
+		if len(items) > 0 && m.state.Navigation.SelectedIdx < len(items) {
+			if app, ok := items[m.state.Navigation.SelectedIdx].(model.App); ok {
+				appName = app.Name
+			}
+		}
+	case model.ViewTree:
+		if m.treeView != nil {
+			if res, ok := m.treeView.CurrentResource(); ok {
+				appName = res.AppName
+			} else if _, kind, _, name, ok := m.treeView.SelectedResource(); ok && kind == "Application" {
+				appName = name
+			}
+		}
+		if appName == "" && m.state.UI.TreeAppName != nil {
+			appName = *m.state.UI.TreeAppName
+		}
+	}
+	if appName == "" {
+		return m, func() tea.Msg { return model.StatusChangeMsg{Status: "No app selected for sync progress"} }
+	}
+	return m, m.openSyncProgress(appName, true)
+}
+
+// openSyncProgress switches to the sync progress view for appName. With load set the
+// current operation state is fetched once; afterwards the app watch stream keeps it updated.
+func (m *Model) openSyncProgress(appName string, load bool) tea.Cmd {
+	m.syncProgressSession++
+	progress := &model.SyncProgressState{
+		AppName: appName,
+		Session: m.syncProgressSession,
+		Loading: load,
+	}
+	for i := range m.state.Apps {
+		if m.state.Apps[i].Name == appName {
+			progress.AppNamespace = m.state.Apps[i].AppNamespace
+			break
+		}
+	}
+	m.state.SyncProgress = progress
+	m.state.Mode = model.ModeSyncProgress
+
+	cmds := []tea.Cmd{scheduleSyncProgressTick(progress.Session)}
+	if load {
+		cmds = append(cmds, m.loadSyncProgress(*progress))
+	}
+	return tea.Batch(cmds...)
+}
+
+// loadSyncProgress fetches the app's current operation state
+func (m *Model) loadSyncProgress(progress model.SyncProgressState) tea.Cmd {
+	epoch := m.switchEpoch // capture at call time
+	return func() tea.Msg {
+		if m.state.Server == nil {
+			return model.SyncProgressLoadedMsg{Session: progress.Session, Err: fmt.Errorf("no server configured"), SwitchEpoch: epoch}
+		}
+		ctx, cancel := appcontext.WithAPITimeout(context.Background())
+		defer cancel()
+
+		appService := api.NewApplicationService(m.state.Server)
+		app, err := appService.GetApplication(ctx, progress.AppName, progress.AppNamespace)
+		if err != nil {
+			cblog.With("component", "sync-progress").Error("Failed to load operation state", "app", progress.AppName, "err", err)
+			return model.SyncProgressLoadedMsg{Session: progress.Session, Err: err, SwitchEpoch: epoch}
+		}
+		return model.SyncProgressLoadedMsg{Session: progress.Session, Operation: appService.ConvertSyncOperation(*app), SwitchEpoch: epoch}
+	}
+}
+
+// handleSyncProgressLoaded stores the fetched operation unless the watch stream already delivered a newer one
+func (m *Model) handleSyncProgressLoaded(msg model.SyncProgressLoadedMsg) (tea.Model, tea.Cmd) {
+	progress := m.state.SyncProgress
+	if msg.SwitchEpoch != m.switchEpoch || progress == nil || progress.Session != msg.Session {
+		return m, nil
+	}
+	progress.Loading = false
+	if msg.Err != nil {
+		progress.Error = extractUserFriendlyError(msg.Err)
+		return m, nil
+	}
+	progress.Error = ""
+	if progress.Operation == nil && progress.Accepts(msg.Operation) {
+		progress.Operation = msg.Operation
+	}
+	return m, nil
+}
+
+// updateSyncProgress applies an operation update from the app watch stream
+func (m *Model) updateSyncProgress(appName string, op *model.SyncOperation) {
+	progress := m.state.SyncProgress
+	if progress == nil || progress.AppName != appName || !progress.Accepts(op) {
+		return
+	}
+	progress.Operation = op
+	progress.Loading = false
+	progress.Error = ""
+}
+
+// scheduleSyncProgressTick queues the next redraw for a session
+func scheduleSyncProgressTick(session int) tea.Cmd {
+	return tea.Tick(syncProgressTickInterval, func(time.Time) tea.Msg {
+		return syncProgressTickMsg{session: session}
+	})
+}
+
+// handleSyncProgressTick keeps the elapsed time current while the view is open
+func (m *Model) handleSyncProgressTick(msg syncProgressTickMsg) (tea.Model, tea.Cmd) {
+	if m.state.SyncProgress == nil || m.state.SyncProgress.Session != msg.session {
+		// View closed or replaced; let the tick loop end
+		return m, nil
+	}
+	return m, scheduleSyncProgressTick(msg.session)
+}
+
+// handleSyncProgressKeys handles input in the sync progress view
+func (m *Model) handleSyncProgressKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	progress := m.state.SyncProgress
+	if progress == nil {
+		m.state.Mode = model.ModeNormal
+		return m, nil
+	}
+	switch msg.String() {
+	case "q", "esc":
+		m.state.SyncProgress = nil
+		m.state.Mode = model.ModeNormal
+		return m, nil
+	case "r":
+		return m, m.openSyncProgress(progress.AppName, true)
+	}
+	return m, nil
+}
+
+// syncProgressPageSize returns the number of result rows visible in the sync progress view
+func (m *Model) syncProgressPageSize() int {
+	// title + summary + operation message + column header + status + content border + main container padding
+	overhead := 8
+	return max(3, m.state.Terminal.Rows-overhead)
+}
+
+// syncPhaseOrder ranks sync phases in the order ArgoCD runs them
+var syncPhaseOrder = map[string]int{"PreSync": 0, "Sync": 1, "PostSync": 2, "SyncFail": 3}
+
+// sortedSyncResults orders results by sync phase, then wave, keeping ArgoCD's order otherwise
+func sortedSyncResults(results []model.SyncResourceResult) []model.SyncResourceResult {
+	sorted := append([]model.SyncResourceResult(nil), results...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		pi, pj := syncPhaseOrder[sorted[i].SyncPhase], syncPhaseOrder[sorted[j].SyncPhase]
+		if pi != pj {
+			return pi < pj
+		}
+		return sorted[i].Wave < sorted[j].Wave
+	})
+	return sorted
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+func TestOpenSyncProgress_FromAppsView(t *testing.T) {
+	m := buildDeleteTestModel(100, 30)
+
+	m.handleKeyMsg(testKeyMsg("O"))
+
+	if m.state.Mode != model.ModeSyncProgress || m.state.SyncProgress == nil {
+		t.Fatalf("Expected sync progress view to open, got mode %s", m.state.Mode)
+	}
+	if m.state.SyncProgress.AppName != "test-app" || !m.state.SyncProgress.Loading {
+		t.Fatalf("Expected loading progress for test-app, got %+v", m.state.SyncProgress)
+	}
+	if ns := m.state.SyncProgress.AppNamespace; ns == nil || *ns != "test-namespace" {
+		t.Fatalf("Expected app namespace test-namespace, got %v", ns)
+	}
+
+	m.handleKeyMsg(testKeyMsg("esc"))
+	if m.state.Mode != model.ModeNormal || m.state.SyncProgress != nil {
+		t.Fatal("Expected esc to close the sync progress view")
+	}
+}
+
+func TestSyncProgress_WatchUpdatesOperation(t *testing.T) {
+	m := buildDeleteTestModel(100, 30)
+	m.openSyncProgress("test-app", false)
+	previous := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	m.state.SyncProgress.IgnoreBefore = &previous
+
+	// The previous operation is still reported until the new one starts
+	m.applyBatchAppUpdate(model.AppUpdatedMsg{
+		App:       m.state.Apps[0],
+		Operation: &model.SyncOperation{Phase: "Succeeded", StartedAt: previous},
+	})
+	if m.state.SyncProgress.Operation != nil {
+		t.Fatal("Expected the previous operation to be ignored")
+	}
+
+	// Updates for other apps are ignored
+	m.applyBatchAppUpdate(model.AppUpdatedMsg{
+		App:       m.state.Apps[1],
+		Operation: &model.SyncOperation{Phase: "Running", StartedAt: previous.Add(time.Minute)},
+	})
+	if m.state.SyncProgress.Operation != nil {
+		t.Fatal("Expected updates for other apps to be ignored")
+	}
+
+	running := &model.SyncOperation{
+		Phase:     "Running",
+		StartedAt: previous.Add(time.Minute),
+		Resources: []model.SyncResourceResult{{Kind: "Deployment", Name: "web", SyncPhase: "Sync", Status: "Synced"}},
+	}
+	m.applyBatchAppUpdate(model.AppUpdatedMsg{App: m.state.Apps[0], Operation: running})
+	if m.state.SyncProgress.Operation != running {
+		t.Fatal("Expected the running operation to be shown")
+	}
+
+	out := m.renderSyncProgressView()
+	if !strings.Contains(out, "Running") || !strings.Contains(out, "Deployment") {
+		t.Fatalf("Expected phase and resource in view, got:\n%s", out)
+	}
+}
+
+func TestSyncProgressLoaded_DoesNotOverrideWatch(t *testing.T) {
+	m := buildDeleteTestModel(100, 30)
+	m.openSyncProgress("test-app", true)
+	session := m.state.SyncProgress.Session
+
+	fromWatch := &model.SyncOperation{Phase: "Running", StartedAt: time.Now()}
+	m.updateSyncProgress("test-app", fromWatch)
+
+	m.Update(model.SyncProgressLoadedMsg{Session: session - 1, Operation: &model.SyncOperation{Phase: "Failed"}, SwitchEpoch: m.switchEpoch})
+	m.Update(model.SyncProgressLoadedMsg{Session: session, Operation: &model.SyncOperation{Phase: "Succeeded"}, SwitchEpoch: m.switchEpoch})
+
+	if m.state.SyncProgress.Operation != fromWatch || m.state.SyncProgress.Loading {
+		t.Fatalf("Expected watch operation to be kept, got %+v", m.state.SyncProgress.Operation)
+	}
+}
+
+func TestSyncProgressTick_StopsWhenClosed(t *testing.T) {
+	m := buildDeleteTestModel(100, 30)
+	m.openSyncProgress("test-app", false)
+	session := m.state.SyncProgress.Session
+
+	if _, cmd := m.handleSyncProgressTick(syncProgressTickMsg{session: session}); cmd == nil {
+		t.Fatal("Expected tick to be rescheduled while open")
+	}
+	m.handleSyncProgressKeys(testKeyMsg("q"))
+	if _, cmd := m.handleSyncProgressTick(syncProgressTickMsg{session: session}); cmd != nil {
+		t.Fatal("Expected tick loop to end after closing")
+	}
+}
+
+func TestSyncCompletedMsg_WithWatchOpensProgress(t *testing.T) {
+	m := buildSyncTestModel(100, 30)
+	m.state.Modals.ConfirmSyncWatch = true
+	m.state.Apps = append(m.state.Apps, model.App{Name: "app-b"})
+	lastSync := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	m.Update(model.SyncCompletedMsg{AppName: "app-b", Success: true, PreviousOperationAt: &lastSync})
+
+	if m.state.Mode != model.ModeSyncProgress || m.state.SyncProgress == nil || m.state.SyncProgress.AppName != "app-b" {
+		t.Fatalf("Expected sync progress for app-b, got mode %s", m.state.Mode)
+	}
+	if m.state.SyncProgress.IgnoreBefore == nil || !m.state.SyncProgress.IgnoreBefore.Equal(lastSync) {
+		t.Fatalf("Expected IgnoreBefore to be the previous sync time, got %v", m.state.SyncProgress.IgnoreBefore)
+	}
+	if m.state.Navigation.View != model.ViewTree {
+		t.Fatalf("Expected the tree view behind the progress view, got %s", m.state.Navigation.View)
+	}
+}
+
+func TestSortedSyncResults_PhaseThenWave(t *testing.T) {
+	got := sortedSyncResults([]model.SyncResourceResult{
+		{Name: "post", SyncPhase: "PostSync"},
+		{Name: "sync-w2", SyncPhase: "Sync", Wave: 2},
+		{Name: "sync-w0", SyncPhase: "Sync"},
+		{Name: "pre", SyncPhase: "PreSync", Wave: 5},
+		{Name: "sync-w-1", SyncPhase: "Sync", Wave: -1},
+	})
+	var names []string
+	for _, r := range got {
+		names = append(names, r.Name)
+	}
+	want := "pre,sync-w-1,sync-w0,sync-w2,post"
+	if strings.Join(names, ",") != want {
+		t.Fatalf("Expected %s, got %s", want, strings.Join(names, ","))
+	}
+}
+
+func TestFormatSyncElapsed(t *testing.T) {
+	cases := map[time.Duration]string{
+		45 * time.Second:              "45s",
+		2*time.Minute + 5*time.Second: "2m05s",
+		time.Hour + 3*time.Minute:     "1h03m",
+	}
+	for d, want := range cases {
+		if got := formatSyncElapsed(d); got != want {
+			t.Errorf("formatSyncElapsed(%v) = %s, want %s", d, got, want)
+		}
+	}
+}
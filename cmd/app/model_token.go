@@ -0,0 +1,112 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/darksworm/argonaut/pkg/api"
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+// tokenExpiryTickMsg fires when the auth token enters the warning window and when it expires
+type tokenExpiryTickMsg struct {
+	token string
+	epoch int
+}
+
+// tokenExpiryWindow returns how long before expiry the status line warns
+func (m *Model) tokenExpiryWindow() time.Duration {
+	if m.config == nil {
+		return 30 * time.Minute
+	}
+	return m.config.GetTokenExpiryWarning()
+}
+
+// currentTokenExpiry returns the expiry of the token the current context uses. With a
+// credential command that is the expiry of the token it last printed.
+func (m *Model) currentTokenExpiry() (time.Time, bool) {
+	if m.state.Server == nil {
+		return time.Time{}, false
+	}
+	if m.state.Server.ExecAuth != nil {
+		return api.ExecTokenExpiry(m.state.Server)
+	}
+	return model.TokenExpiry(m.state.Server.Token)
+}
+
+// usesExecAuth reports whether the current context gets its tokens from a credential command,
+// which is asked for a new token before the old one expires
+func (m *Model) usesExecAuth() bool {
+	return m.state.Server != nil && m.state.Server.ExecAuth != nil
+}
+
+// scheduleTokenExpiry wakes up when the token enters the warning window and again when it
+// expires, so the warning shows and login is asked for before a request fails
+func (m *Model) scheduleTokenExpiry() tea.Cmd {
+	if m.usesExecAuth() {
+		return nil
+	}
+	exp, ok := m.currentTokenExpiry()
+	if !ok {
+		return nil
+	}
+	msg := tokenExpiryTickMsg{token: m.state.Server.Token, epoch: m.switchEpoch}
+	wait := time.Until(exp)
+	if warnIn := wait - m.tokenExpiryWindow(); warnIn > 0 {
+		wait = warnIn
+	}
+	if wait <= 0 {
+		return func() tea.Msg { return msg }
+	}
+	return tea.Tick(wait, func(time.Time) tea.Msg { return msg })
+}
+
+// handleTokenExpiryTick asks to log in once the token has expired, otherwise waits for expiry
+func (m *Model) handleTokenExpiryTick(msg tokenExpiryTickMsg) (tea.Model, tea.Cmd) {
+	if msg.epoch != m.switchEpoch || m.state.Server == nil || m.state.Server.Token != msg.token {
+		// The context changed or a new token was obtained; its own schedule is running
+		return m, nil
+	}
+	exp, ok := model.TokenExpiry(msg.token)
+	if !ok {
+		return m, nil
+	}
+	if time.Now().Before(exp) {
+		return m, m.scheduleTokenExpiry()
+	}
+	if m.state.Mode == model.ModeAuthRequired {
+		return m, nil
+	}
+	epoch := m.switchEpoch
+	err := fmt.Errorf("auth token expired at %s, please log in again", exp.Local().Format("15:04"))
+	return m, func() tea.Msg { return model.AuthErrorMsg{Error: err, SwitchEpoch: epoch} }
+}
+
+// tokenExpiryWarning returns the status line warning while the token is about to expire
+func (m *Model) tokenExpiryWarning(now time.Time) string {
+	if m.usesExecAuth() {
+		return ""
+	}
+	exp, ok := m.currentTokenExpiry()
+	if !ok || !now.Before(exp) || exp.Sub(now) > m.tokenExpiryWindow() {
+		return ""
+	}
+	return "Token expires in " + formatEventAge(now, exp)
+}
+
+// tokenStatusText describes a context's token for the contexts view
+func tokenStatusText(status model.TokenStatus, now time.Time) string {
+	switch {
+	case status.Exec:
+		return "exec credential"
+	case !status.HasToken:
+		return "no token — run argocd login"
+	case status.ExpiresAt.IsZero():
+		return "valid, no expiry"
+	case status.Expired(now):
+		return "expired " + formatEventAge(status.ExpiresAt, now) + " ago — run argocd login"
+	default:
+		return "valid, expires in " + formatEventAge(now, status.ExpiresAt)
+	}
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/darksworm/argonaut/pkg/api"
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+func buildTokenTestModel() *Model {
+	m := buildDeleteTestModel(140, 30)
+	m.state.Server = &model.Server{BaseURL: "https://argocd.example.com"}
+	return m
+}
+
+func testTokenExpiringIn(d time.Duration) string {
+	payload := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"exp":%d}`, time.Now().Add(d).Unix())))
+	return "header." + payload + ".signature"
+}
+
+func TestTokenExpiry_WarnsInsideWindow(t *testing.T) {
+	m := buildTokenTestModel()
+	now := time.Now()
+
+	m.state.Server.Token = testTokenExpiringIn(2 * time.Hour)
+	if warning := m.tokenExpiryWarning(now); warning != "" {
+		t.Fatalf("Expected no warning outside the window, got %q", warning)
+	}
+	m.state.Server.Token = testTokenExpiringIn(10*time.Minute + 30*time.Second)
+	if warning := m.tokenExpiryWarning(now); warning != "Token expires in 10m" {
+		t.Fatalf("Expected a warning inside the window, got %q", warning)
+	}
+	if block := m.renderContextBlock(false); !strings.Contains(block, "expires in 10m") {
+		t.Fatalf("Expected the context block to show the expiry, got:\n%s", block)
+	}
+}
+
+func TestTokenExpiry_ExpiredTokenRequiresLogin(t *testing.T) {
+	m := buildTokenTestModel()
+	m.state.Server.Token = testTokenExpiringIn(-time.Minute)
+
+	_, cmd := m.handleTokenExpiryTick(tokenExpiryTickMsg{token: m.state.Server.Token, epoch: m.switchEpoch})
+	if cmd == nil {
+		t.Fatal("Expected the expired token to require a login")
+	}
+	_, cmd = m.Update(cmd())
+	m.Update(cmd())
+	if m.state.Mode != model.ModeAuthRequired {
+		t.Fatalf("Expected auth-required mode, got %s", m.state.Mode)
+	}
+
+	if _, cmd := m.handleTokenExpiryTick(tokenExpiryTickMsg{token: "older-token", epoch: m.switchEpoch}); cmd != nil {
+		t.Fatal("Expected ticks for a replaced token to be ignored")
+	}
+}
+
+func TestContextsView_ShowsTokenValidity(t *testing.T) {
+	m := buildTokenTestModel()
+	m.state.Navigation.View = model.ViewContexts
+	m.state.ContextNames = []string{"dev", "production", "staging"}
+	m.state.ContextTokens = map[string]model.TokenStatus{
+		"dev":        model.TokenStatusOf(""),
+		"production": model.TokenStatusOf(testTokenExpiringIn(5*time.Hour + time.Minute)),
+		"staging":    model.TokenStatusOf(testTokenExpiringIn(-49 * time.Hour)),
+		"oncall":     {Exec: true},
+	}
+	m.state.ContextNames = append(m.state.ContextNames, "oncall")
+
+	out := m.renderListView(20)
+	for _, want := range []string{"TOKEN", "no token — run argocd login", "valid, expires in 5h", "expired 2d ago — run argocd login", "exec credential"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected %q in the contexts view, got:\n%s", want, out)
+		}
+	}
+}
+
+func TestTokenExpiry_ExecCredential(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("credential command needs sh")
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"items": []}`)
+	}))
+	defer server.Close()
+
+	expiry := time.Now().Add(2*time.Hour + time.Minute).UTC().Format(time.RFC3339)
+	m := buildTokenTestModel()
+	m.state.Server = &model.Server{BaseURL: server.URL, ExecAuth: &model.ExecAuth{
+		Command: "sh",
+		Args:    []string{"-c", `printf '{"token": "exec-token", "expirationTimestamp": "` + expiry + `"}'`},
+	}}
+	if _, err := api.NewClient(m.state.Server).Get(context.Background(), "/api/v1/clusters"); err != nil {
+		t.Fatalf("Expected the credential command to provide a token, got %v", err)
+	}
+
+	if block := m.renderContextBlock(false); !strings.Contains(block, "exec credential, expires in 2h") {
+		t.Errorf("Expected the context block to show the exec token expiry, got:\n%s", block)
+	}
+	if m.scheduleTokenExpiry() != nil || m.tokenExpiryWarning(time.Now().Add(2*time.Hour)) != "" {
+		t.Error("Expected no login prompt or warning for tokens the credential command renews")
+	}
+}
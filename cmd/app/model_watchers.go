@@ -24,6 +24,25 @@ func (m *Model) cleanupTreeWatchers() *Model {
 	return m
 }
 
+// cleanupPodLogs stops the active pod logs stream if present.
+func (m *Model) cleanupPodLogs() *Model {
+	if m.podLogsCleanup != nil {
+		m.podLogsCleanup()
+		m.podLogsCleanup = nil
+	}
+	m.podLogsCh = nil
+	return m
+}
+
+// cleanupAppSetsWatcher stops the ApplicationSets watch stream if present.
+func (m *Model) cleanupAppSetsWatcher() *Model {
+	if m.appSetsCleanup != nil {
+		m.appSetsCleanup()
+		m.appSetsCleanup = nil
+	}
+	return m
+}
+
 // safeChangeView changes navigation view and cleans up tree watchers if leaving tree view.
 func (m *Model) safeChangeView(newView model.View) *Model {
 	if m.state.Navigation.View == model.ViewTree && newView != model.ViewTree {
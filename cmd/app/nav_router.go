@@ -83,6 +83,22 @@ func (m *Model) getNavigatorContext() *NavigatorContext {
 			SupportsNavigation: true,
 		}
 
+	case model.ModeResourceDiffs:
+		if m.state.ResourceDiffs == nil {
+			return &NavigatorContext{SupportsNavigation: false}
+		}
+		return &NavigatorContext{
+			Navigator:         m.resourceDiffNav,
+			GetItemCount:      func() int { return len(m.state.ResourceDiffs.Items) },
+			GetViewportHeight: m.resourceDiffsPageSize,
+			OnNavigate: func(changed bool) {
+				if changed {
+					m.state.ResourceDiffs.SelectedIdx = m.resourceDiffNav.Cursor()
+				}
+			},
+			SupportsNavigation: true,
+		}
+
 	case model.ModeDiff:
 		if m.state.Diff == nil {
 			return &NavigatorContext{SupportsNavigation: false}
@@ -93,6 +109,80 @@ func (m *Model) getNavigatorContext() *NavigatorContext {
 			PageSize:           m.diffPageSize,
 		}
 
+	case model.ModeLogs:
+		if m.state.Logs == nil || m.state.Logs.Picker != "" {
+			// Picker handles its own up/down keys
+			return &NavigatorContext{SupportsNavigation: false}
+		}
+		return &NavigatorContext{
+			SupportsNavigation: true,
+			DirectOffset:       &m.state.Logs.Offset,
+			PageSize:           m.logsPageSize,
+			OnNavigate: func(changed bool) {
+				m.syncLogsFollow()
+			},
+		}
+
+	case model.ModeEvents:
+		if m.state.Events == nil {
+			return &NavigatorContext{SupportsNavigation: false}
+		}
+		return &NavigatorContext{
+			SupportsNavigation: true,
+			DirectOffset:       &m.state.Events.Offset,
+			PageSize:           m.eventsPageSize,
+		}
+
+	case model.ModeManifest:
+		if m.state.Manifest == nil {
+			return &NavigatorContext{SupportsNavigation: false}
+		}
+		return &NavigatorContext{
+			SupportsNavigation: true,
+			DirectOffset:       &m.state.Manifest.Offset,
+			PageSize:           m.manifestPageSize,
+		}
+
+	case model.ModeResourceEdit:
+		if m.state.ResourceEdit == nil {
+			return &NavigatorContext{SupportsNavigation: false}
+		}
+		return &NavigatorContext{
+			SupportsNavigation: true,
+			DirectOffset:       &m.state.ResourceEdit.Offset,
+			PageSize:           m.resourceEditPageSize,
+		}
+
+	case model.ModeSyncProgress:
+		if m.state.SyncProgress == nil {
+			return &NavigatorContext{SupportsNavigation: false}
+		}
+		return &NavigatorContext{
+			SupportsNavigation: true,
+			DirectOffset:       &m.state.SyncProgress.Offset,
+			PageSize:           m.syncProgressPageSize,
+		}
+
+	case model.ModeProjectDetail:
+		if m.state.ProjectDetail == nil {
+			return &NavigatorContext{SupportsNavigation: false}
+		}
+		return &NavigatorContext{
+			SupportsNavigation: true,
+			DirectOffset:       &m.state.ProjectDetail.Offset,
+			PageSize:           m.projectDetailPageSize,
+		}
+
+	case model.ModeAppDetail:
+		if m.state.AppDetail == nil {
+			return &NavigatorContext{SupportsNavigation: false}
+		}
+		return &NavigatorContext{
+			SupportsNavigation: true,
+			DirectOffset:       &m.state.AppDetail.Offset,
+			PageSize:           m.appDetailPageSize,
+		}
+
 	case model.ModeNormal:
 		// Check for tree view first
 		if m.state.Navigation.View == model.ViewTree {
@@ -181,7 +271,7 @@ func (m *Model) executeNavigation(ctx *NavigatorContext, msg tea.KeyMsg) (tea.Mo
 	return m, nil
 }
 
-// executeDirectOffsetNavigation handles navigation for views using direct offset (Diff mode).
+// executeDirectOffsetNavigation handles navigation for views using direct offset (Diff, Logs, Events, Manifest, ResourceEdit and SyncProgress modes).
 func (m *Model) executeDirectOffsetNavigation(ctx *NavigatorContext, msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	switch msg.String() {
 	case "up", "k":
@@ -204,5 +294,8 @@ func (m *Model) executeDirectOffsetNavigation(ctx *NavigatorContext, msg tea.Key
 		// Set to large value; clamped on render
 		*ctx.DirectOffset = 1 << 30
 	}
+	if ctx.OnNavigate != nil {
+		ctx.OnNavigate(true)
+	}
 	return m, nil
 }
@@ -5,6 +5,7 @@ import (
 	"image/color"
 	"os"
 	"regexp"
+	"slices"
 	"strings"
 	"time"
 
@@ -267,6 +268,18 @@ const (
 	dotIcon   = "."
 )
 
+// runningOperationTag follows the name of apps with an operation in progress
+const runningOperationTag = " (running)"
+
+// clusterFailedTag follows the name of apps whose cluster ArgoCD cannot connect to
+const clusterFailedTag = " (cluster unreachable)"
+
+// syncBlockedTag follows the name of apps whose sync is blocked by a sync window
+const syncBlockedTag = " (sync blocked)"
+
+// syncUnknownTag follows the name of apps whose project's active sync windows could not be fetched
+const syncUnknownTag = " (sync windows unknown)"
+
 // View implements tea.Model.View - 1:1 mapping from React App.tsx
 func (m *Model) View() tea.View {
 	m.renderCount++
@@ -278,6 +291,13 @@ func (m *Model) View() tea.View {
 	} else {
 		// Map React App.tsx switch statement exactly
 		switch m.state.Mode {
+		case model.ModeSearch:
+			if m.state.Logs != nil {
+				// Logs filtering keeps the logs pane visible under the search bar
+				content = m.renderLogsView()
+			} else {
+				content = m.renderMainLayout()
+			}
 		case model.ModeLoading:
 			// Show regular layout with the initial loading modal overlay instead of a separate loading view
 			content = m.renderMainLayout()
@@ -293,6 +313,22 @@ func (m *Model) View() tea.View {
 			content = ""
 		case model.ModeDiff:
 			content = m.renderDiffView()
+		case model.ModeLogs:
+			content = m.renderLogsView()
+		case model.ModeEvents:
+			content = m.renderEventsView()
+		case model.ModeManifest:
+			content = m.renderManifestView()
+		case model.ModeResourceEdit:
+			content = m.renderResourceEditView()
+		case model.ModeSyncProgress:
+			content = m.renderSyncProgressView()
+		case model.ModeProjectDetail:
+			content = m.renderProjectDetailView()
+		case model.ModeAppDetail:
+			content = m.renderAppDetailView()
+		case model.ModeResourceDiffs:
+			content = m.renderResourceDiffsView()
 		case model.ModeRulerLine:
 			content = m.renderOfficeSupplyManager()
 		case model.ModeError:
@@ -461,11 +497,9 @@ func (m *Model) getVisibleItems() []interface{} {
 	var base []interface{}
 	switch m.state.Navigation.View {
 	case model.ViewClusters:
-		// Pre-computed sorted unique clusters from ALL apps
-		if idx != nil {
-			for _, c := range idx.Clusters {
-				base = append(base, c)
-			}
+		// Registered clusters merged with the clusters targeted by ALL apps
+		for _, c := range m.clusterLabels() {
+			base = append(base, c)
 		}
 	case model.ViewNamespaces:
 		// Unique namespaces from apps filtered by cluster scope
@@ -484,11 +518,19 @@ func (m *Model) getVisibleItems() []interface{} {
 			}
 		}
 	case model.ViewApplicationSets:
-		// Pre-computed sorted unique ApplicationSets from ALL apps
-		if idx != nil {
-			for _, as := range idx.ApplicationSets {
-				base = append(base, as)
-			}
+		// ApplicationSets from the API merged with the owners of ALL apps
+		for _, as := range m.applicationSetNames() {
+			base = append(base, as)
+		}
+	case model.ViewRepositories:
+		// Registered repositories merged with the repositories used by ALL apps
+		for _, repoURL := range m.repositoryURLs() {
+			base = append(base, repoURL)
+		}
+	case model.ViewLabels:
+		// Label keys, or the key=value selectors of the chosen key
+		for _, item := range m.labelItems() {
+			base = append(base, item)
 		}
 	case model.ViewApps:
 		// Get scoped apps using index-based filtering, then sort
@@ -524,19 +566,14 @@ func (m *Model) getVisibleItems() []interface{} {
 
 	filtered := make([]interface{}, 0, len(base))
 	if m.state.Navigation.View == model.ViewApps {
+		query, err := m.appQuery(filter)
+		if err != nil {
+			// Not a valid query (e.g. while typing age>7): match the text as typed
+			query = model.LiteralAppQuery(filter)
+		}
+		now := time.Now()
 		for _, it := range base {
-			app := it.(model.App)
-			name := strings.ToLower(app.Name)
-			sync := strings.ToLower(app.Sync)
-			health := strings.ToLower(app.Health)
-			var ns, prj string
-			if app.Namespace != nil {
-				ns = strings.ToLower(*app.Namespace)
-			}
-			if app.Project != nil {
-				prj = strings.ToLower(*app.Project)
-			}
-			if strings.Contains(name, f) || strings.Contains(sync, f) || strings.Contains(health, f) || strings.Contains(ns, f) || strings.Contains(prj, f) {
+			if query.Matches(it.(model.App), now) {
 				filtered = append(filtered, it)
 			}
 		}
@@ -551,6 +588,16 @@ func (m *Model) getVisibleItems() []interface{} {
 	return filtered
 }
 
+// appQuery returns the parsed apps filter for text, parsing it only when the text changed
+func (m *Model) appQuery(text string) (*model.AppQuery, error) {
+	if cached := m.state.UI.AppQuery; cached != nil && cached.Text == text {
+		return cached.Query, cached.Err
+	}
+	query, err := model.ParseAppQuery(text)
+	m.state.UI.AppQuery = &model.ParsedAppQuery{Text: text, Query: query, Err: err}
+	return query, err
+}
+
 // sortStrings sorts a slice of strings in-place (lexicographically)
 func sortStrings(items []string) {
 	// Simple insertion sort to avoid pulling extra deps; lists are small
@@ -594,6 +641,16 @@ func (m *Model) renderAuthRequiredView() string {
 		Render(authHeaderStyled)
 	contentSections = append(contentSections, authHeaderCentered)
 
+	// Log in right here when the context names a server
+	if login := m.ensureLoginForm(); login != nil {
+		contentSections = append(contentSections, m.loginFormLines(login)...)
+		status := statusStyle.Render("Enter log in • Tab switch field • Ctrl+L logs • Ctrl+C quit")
+		return m.renderFullScreenViewWithOptions(header, strings.Join(contentSections, "\n"), status, FullScreenViewOptions{
+			ContentBordered: true,
+			BorderColor:     outOfSyncColor,
+		})
+	}
+
 	contentSections = append(contentSections, "")
 	contentSections = append(contentSections, lipgloss.NewStyle().
 		Foreground(outOfSyncColor).
@@ -635,9 +692,9 @@ func (m *Model) renderConfirmSyncModal() string {
 	target := *m.state.Modals.ConfirmTarget
 	isMulti := target == "__MULTI__"
 
-	// Modal width: compact and centered
+	// Modal width: compact and centered, wide enough for two options per line
 	half := m.state.Terminal.Cols / 2
-	modalWidth := min(max(36, half), m.state.Terminal.Cols-6)
+	modalWidth := min(max(60, half), m.state.Terminal.Cols-6)
 	innerWidth := max(0, modalWidth-4) // border(2)+padding(2)
 
 	// Message: de-emphasize the "Sync" verb and highlight the subject
@@ -686,28 +743,61 @@ func (m *Model) renderConfirmSyncModal() string {
 	buttons := lipgloss.JoinHorizontal(lipgloss.Center, yesBtn, strings.Repeat(" ", 4), cancelBtn)
 	buttons = center.Render(buttons)
 
-	// Options line rendered piecewise to avoid ANSI resets affecting following text
+	// Options lines rendered piecewise to avoid ANSI resets affecting following text
 	dim := lipgloss.NewStyle().Foreground(dimColor)
 	on := lipgloss.NewStyle().Foreground(yellowBright).Bold(true)
-	var optsLine strings.Builder
-	optsLine.WriteString(dim.Render("p: Prune "))
-	if m.state.Modals.ConfirmSyncPrune {
-		optsLine.WriteString(on.Render("On"))
-	} else {
-		optsLine.WriteString(dim.Render("Off"))
+	option := func(label string, value string, enabled bool) string {
+		if enabled {
+			return dim.Render(label+" ") + on.Render(value)
+		}
+		return dim.Render(label+" ") + dim.Render(value)
+	}
+	toggle := func(label string, enabled bool) string {
+		if enabled {
+			return option(label, "On", true)
+		}
+		return option(label, "Off", false)
+	}
+	sep := dim.Render(" • ")
+
+	opts := m.state.Modals.ConfirmSyncOptions
+	revision := option("v: Revision", "default", false)
+	if m.state.Modals.ConfirmSyncEditingRevision {
+		revision = dim.Render("v: Revision ") + on.Render(opts.Revision+"▏")
+	} else if opts.Revision != "" {
+		revision = option("v: Revision", opts.Revision, true)
+	}
+	retry := toggle("t: Retry", false)
+	if opts.RetryLimit != 0 {
+		retry = option("t: Retry", fmt.Sprintf("%dx", opts.RetryLimit), true)
 	}
+	backoff := option("b: Backoff", "default", false)
+	if opts.RetryBackoffDuration != "" {
+		backoff = option("b: Backoff", opts.RetryBackoffDuration, opts.RetryLimit != 0)
+	}
+
 	// Always show watch toggle (single and multi)
-	optsLine.WriteString(dim.Render(" • w: Watch "))
-	if m.state.Modals.ConfirmSyncWatch {
-		optsLine.WriteString(on.Render("On"))
-	} else {
-		optsLine.WriteString(dim.Render("Off"))
+	optLines := []string{
+		toggle("p: Prune", m.state.Modals.ConfirmSyncPrune) + sep + toggle("w: Watch", m.state.Modals.ConfirmSyncWatch),
+		toggle("o: Out-of-sync only", opts.ApplyOutOfSyncOnly) + sep + toggle("s: Server-side apply", opts.ServerSideApply),
+		toggle("r: Replace", opts.Replace) + sep + toggle("P: Prune last", opts.PruneLast),
+		toggle("i: Respect ignore diffs", opts.RespectIgnoreDifferences) + sep + toggle("n: Create namespace", opts.CreateNamespace),
+		revision,
+		retry + sep + backoff,
+	}
+	for i, line := range optLines {
+		optLines[i] = center.Render(line)
 	}
-	aux := center.Render(optsLine.String())
 
 	// Lines are already centered to innerWidth; avoid re-normalizing which can
 	// introduce asymmetric trailing padding.
-	body := strings.Join([]string{title, "", buttons, "", aux}, "\n")
+	header := []string{title}
+	// Warn before a sync that ArgoCD will reject because of a sync window
+	if warning := m.syncWindowWarning(target, isMulti); warning != "" {
+		warnStyle := lipgloss.NewStyle().Foreground(outOfSyncColor).Bold(true)
+		header = append(header, center.Render(warnStyle.Render(truncateWithEllipsis("⚠ "+warning, innerWidth))))
+	}
+	body := strings.Join(append(append(header, "", buttons, ""), optLines...), "\n")
 
 	// Add outer whitespace so the modal doesn't sit directly on top of content
 	outer := lipgloss.NewStyle().Padding(1, 1) // 1 blank line top/bottom, 1 space left/right
@@ -755,7 +845,14 @@ func (m *Model) renderDiffView() string {
 	body := strings.Join(lines[start:end], "\n")
 
 	title := headerStyle.Render(m.state.Diff.Title)
-	status := statusStyle.Render(fmt.Sprintf("%d-%d/%d  j/k, g/G, / search, esc/q back", start+1, end, len(lines)))
+	keys := "j/k, g/G, / search, esc/q back"
+	if m.diffSections != nil {
+		keys = "j/k, [/] hunk, {/} resource, v split, / search, esc/q back"
+	}
+	if m.state.ResourceDiffs != nil {
+		keys = "n/p next/prev diff, s sync, " + keys
+	}
+	status := statusStyle.Render(fmt.Sprintf("%d-%d/%d  %s", start+1, end, len(lines), keys))
 
 	// Width should account for main container padding (2) and content border padding (2)
 	contentWidth := max(0, m.state.Terminal.Cols-4)
@@ -1144,6 +1241,14 @@ func (m *Model) renderRollbackHistory(rollback *model.RollbackState) string {
 		// Build single-line summary: id, short rev, date, author, and message
 		idStyle := lipgloss.NewStyle().Foreground(whiteBright)
 		revisionStyle := lipgloss.NewStyle().Foreground(cyanBright)
+		if len(rollback.Marked) > 0 {
+			// Leave a gutter for the comparison marks once any row is marked
+			if slices.Contains(rollback.Marked, row.ID) {
+				line += lipgloss.NewStyle().Foreground(yellowBright).Render("●") + " "
+			} else {
+				line += "  "
+			}
+		}
 		line += fmt.Sprintf("%s %s",
 			idStyle.Render(fmt.Sprintf("#%d", row.ID)),
 			revisionStyle.Render(row.Revision[:min(8, len(row.Revision))]))
@@ -1214,6 +1319,12 @@ func (m *Model) renderRollbackConfirmation(rollback *model.RollbackState, innerH
 	targetStyle := lipgloss.NewStyle().Foreground(yellowBright)
 	content += fmt.Sprintf("Rollback to: %s\n", targetStyle.Render(selectedRow.Revision[:min(8, len(selectedRow.Revision))]))
 
+	// ArgoCD rejects rollbacks while automated sync is enabled
+	if rollback.AutoSync != nil {
+		warn := lipgloss.NewStyle().Foreground(outOfSyncColor)
+		content += warn.Render("Automated sync is enabled; ArgoCD rejects rollbacks until it is disabled") + "\n"
+	}
+
 	// Git metadata if available
 	if selectedRow.Author != nil && selectedRow.Message != nil {
 		content += fmt.Sprintf("Author: %s\n", *selectedRow.Author)
@@ -1243,6 +1354,14 @@ func (m *Model) renderRollbackConfirmation(rollback *model.RollbackState, innerH
 	} else {
 		opts.WriteString(dim.Render("No"))
 	}
+	if rollback.AutoSync != nil {
+		opts.WriteString(dim.Render("   [a] Disable auto-sync: "))
+		if rollback.DisableAutoSync {
+			opts.WriteString(on.Render("Yes"))
+		} else {
+			opts.WriteString(dim.Render("No"))
+		}
+	}
 	// Build inner confirmation modal (bordered) with title
 	inactiveFG := ensureContrastingForeground(inactiveBG, whiteBright)
 	active := lipgloss.NewStyle().Background(magentaBright).Foreground(textOnAccent).Bold(true).Padding(0, 2)
@@ -0,0 +1,187 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"charm.land/lipgloss/v2"
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+// renderAppDetailView renders an application's sources, status, conditions and sync policy
+// (full screen, like the project detail view)
+func (m *Model) renderAppDetailView() string {
+	state := m.state.AppDetail
+	if state == nil {
+		return contentBorderStyle.Render("No application loaded")
+	}
+
+	// Width should account for main container padding (2) and content border padding (2)
+	contentWidth := max(0, m.state.Terminal.Cols-4)
+	innerWidth := max(1, contentWidth-4)
+
+	var lines []string
+	switch {
+	case state.Detail != nil:
+		lines = m.appDetailLines(*state.Detail, innerWidth, time.Now())
+	case state.Error != "":
+		lines = []string{statusStyle.Render("Error: " + state.Error)}
+	default:
+		lines = []string{statusStyle.Render("Loading application…")}
+	}
+
+	contentHeight := m.appDetailPageSize()
+	maxOffset := max(0, len(lines)-contentHeight)
+	state.Offset = min(max(0, state.Offset), maxOffset)
+	start := state.Offset
+	end := min(len(lines), start+contentHeight)
+
+	title := headerStyle.Render("Application · " + state.AppName)
+	status := statusStyle.Render(fmt.Sprintf("%d-%d/%d  j/k, g/G, r reload, esc/q back",
+		min(start+1, end), end, len(lines)))
+
+	content := contentBorderStyle.Width(contentWidth).Render(strings.Join(lines[start:end], "\n"))
+
+	var sections []string
+	sections = append(sections, title)
+	sections = append(sections, content)
+	sections = append(sections, status)
+
+	return mainContainerStyle.Width(m.state.Terminal.Cols).Render(strings.Join(sections, "\n"))
+}
+
+// appDetailLines renders the sections of the app detail view, one entry per line
+func (m *Model) appDetailLines(d model.AppDetail, width int, now time.Time) []string {
+	section := lipgloss.NewStyle().Foreground(yellowBright).Bold(true)
+	dim := lipgloss.NewStyle().Foreground(dimColor)
+	var lines []string
+	add := func(s string) {
+		lines = append(lines, clipAnsiToWidth(s, width))
+	}
+	// wrapped adds a message that may be long, indented and wrapped to the width
+	wrapped := func(indent, s string, style lipgloss.Style) {
+		s = strings.Join(strings.Fields(s), " ")
+		for _, line := range wrapAnsiToWidth(s, max(1, width-len(indent))) {
+			lines = append(lines, indent+style.Render(line))
+		}
+	}
+	field := func(label, value string) {
+		add("  " + dim.Render(padRight(label, 13)) + value)
+	}
+
+	project := "default"
+	if d.App.Project != nil && *d.App.Project != "" {
+		project = *d.App.Project
+	}
+	field("project", project)
+	destination := d.DestinationName
+	if destination == "" {
+		destination = d.DestinationServer
+	} else if d.DestinationServer != "" {
+		destination += dim.Render(" (" + d.DestinationServer + ")")
+	}
+	if d.DestinationNamespace != "" {
+		destination += dim.Render(" / ") + d.DestinationNamespace
+	}
+	field("destination", destination)
+	if d.App.AppNamespace != nil && *d.App.AppNamespace != "" {
+		field("namespace", *d.App.AppNamespace+dim.Render(" (application)"))
+	}
+	field("status", m.getColorForStatus(d.App.Sync).Render(d.App.Sync)+"  "+m.getColorForStatus(d.App.Health).Render(d.App.Health))
+	if d.HealthMessage != "" {
+		wrapped("               ", d.HealthMessage, dim)
+	}
+	if d.App.AutoSync == nil {
+		field("auto-sync", dim.Render("disabled (manual sync)"))
+	} else {
+		flags := []string{"prune " + onOff(d.App.AutoSync.Prune), "self-heal " + onOff(d.App.AutoSync.SelfHeal), "allow-empty " + onOff(d.App.AutoSync.AllowEmpty)}
+		field("auto-sync", lipgloss.NewStyle().Foreground(syncedColor).Render("enabled")+dim.Render("  "+strings.Join(flags, ", ")))
+	}
+	if len(d.SyncOptions) > 0 {
+		field("sync options", strings.Join(d.SyncOptions, ", "))
+	}
+	add("")
+
+	title := "SOURCE"
+	if d.MultiSource {
+		title = fmt.Sprintf("SOURCES (%d)", len(d.Sources))
+	}
+	add(section.Render(title))
+	if len(d.Sources) == 0 {
+		add("  " + dim.Render("none"))
+	}
+	for i, src := range d.Sources {
+		prefix := "  "
+		if d.MultiSource {
+			prefix = fmt.Sprintf("  %d  ", i+1)
+		}
+		add(prefix + src.RepoURL)
+		var parts []string
+		if src.Chart != "" {
+			parts = append(parts, dim.Render("chart ")+src.Chart)
+		}
+		if src.Path != "" {
+			parts = append(parts, dim.Render("path ")+src.Path)
+		}
+		target := src.TargetRevision
+		if target == "" {
+			target = "HEAD"
+		}
+		parts = append(parts, dim.Render("target ")+target)
+		if i < len(d.SyncedRevisions) && d.SyncedRevisions[i] != "" {
+			parts = append(parts, dim.Render("synced ")+shortRevision(d.SyncedRevisions[i]))
+		}
+		add(strings.Repeat(" ", lipgloss.Width(prefix)+2) + strings.Join(parts, dim.Render(" • ")))
+	}
+	add("")
+
+	add(section.Render("CONDITIONS"))
+	if len(d.Conditions) == 0 {
+		add("  " + dim.Render("none"))
+	}
+	for _, c := range d.Conditions {
+		color := yellowBright
+		if c.IsError() {
+			color = outOfSyncColor
+		}
+		add("  " + lipgloss.NewStyle().Foreground(color).Bold(true).Render(c.Type))
+		if c.Message != "" {
+			wrapped("    ", c.Message, lipgloss.NewStyle())
+		}
+	}
+	add("")
+
+	add(section.Render("LAST OPERATION"))
+	op := d.Operation
+	if op == nil {
+		add("  " + dim.Render("none"))
+		return lines
+	}
+	summary := "  " + syncResultStyle(op.Phase).Bold(true).Render(op.Phase)
+	if !op.StartedAt.IsZero() {
+		summary += dim.Render(" • started ") + formatEventAge(op.StartedAt, now) + dim.Render(" ago")
+	}
+	switch {
+	case op.InitiatedBy != "":
+		summary += dim.Render(" • by ") + op.InitiatedBy
+	case op.Automated:
+		summary += dim.Render(" • by ") + "automated sync"
+	}
+	if op.Revision != "" {
+		summary += dim.Render(" • revision ") + shortRevision(op.Revision)
+	}
+	add(summary)
+	if op.Message != "" {
+		wrapped("    ", op.Message, dim)
+	}
+	return lines
+}
+
+// onOff formats a policy flag
+func onOff(b bool) string {
+	if b {
+		return "on"
+	}
+	return "off"
+}
@@ -0,0 +1,194 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+// appSetHealthOrder is the order health counts are shown in the ApplicationSets APPS column
+var appSetHealthOrder = []string{"Healthy", "Progressing", "Degraded", "Suspended", "Missing", "Unknown"}
+
+// appSetColumnWidths splits the content width between the ApplicationSets columns.
+// Narrow terminals only get NAME, STATUS and APPS.
+func appSetColumnWidths(availableWidth int) (name, generators, policy, status, apps, message int) {
+	status, apps = 11, 16
+	if availableWidth < 80 {
+		name = max(1, availableWidth-status-apps-2)
+		return
+	}
+	generators, policy = 26, 24
+	name = max(16, (availableWidth-generators-policy-status-apps-5)/2)
+	message = max(0, availableWidth-name-generators-policy-status-apps-5)
+	return
+}
+
+// renderAppSetHeader renders the ApplicationSets table header
+func (m *Model) renderAppSetHeader() string {
+	contentWidth := m.contentInnerWidth()
+	nameW, genW, policyW, statusW, appsW, msgW := appSetColumnWidths(contentWidth)
+	cells := []string{padRight("NAME", nameW)}
+	if genW > 0 {
+		cells = append(cells, padRight("GENERATORS", genW), padRight("SYNC POLICY", policyW))
+	}
+	cells = append(cells, padRight("STATUS", statusW), padRight("APPS", appsW))
+	if msgW > 0 {
+		cells = append(cells, padRight("MESSAGE", msgW))
+	}
+	return padRight(clipAnsiToWidth(headerStyle.Render(strings.Join(cells, " ")), contentWidth), contentWidth)
+}
+
+// renderAppSetRow renders one ApplicationSet with its generators, policy, conditions and app health rollup
+func (m *Model) renderAppSetRow(name string, isCursor bool) string {
+	contentWidth := m.contentInnerWidth()
+	nameW, genW, policyW, statusW, appsW, msgW := appSetColumnWidths(contentWidth)
+
+	var set model.ApplicationSet
+	fromAPI := false
+	if m.state.ApplicationSets != nil {
+		set, fromAPI = m.state.ApplicationSets.Items[name]
+	}
+
+	style := func(st lipgloss.Style, s string) string {
+		if isCursor {
+			// Active row: avoid inner color styles so background highlight spans the whole row
+			return s
+		}
+		return st.Render(s)
+	}
+	dim := lipgloss.NewStyle().Foreground(dimColor)
+
+	// STATUS and MESSAGE come from the controller's conditions
+	statusText, statusColor, message := "-", unknownColor, ""
+	rollout, upToDate := set.Condition("RolloutProgressing"), set.Condition("ResourcesUpToDate")
+	switch {
+	case !fromAPI:
+		message = "not returned by the ApplicationSet API"
+	case set.HasError():
+		statusText, statusColor = "Error", outOfSyncColor
+		message = set.Condition("ErrorOccurred").Message
+	case rollout != nil && rollout.Status == "True":
+		statusText, statusColor = "Progressing", progressColor
+		message = rollout.Message
+	case upToDate != nil && upToDate.Status == "True":
+		statusText, statusColor = "UpToDate", syncedColor
+	case upToDate != nil:
+		statusText, statusColor = "Pending", progressColor
+		message = upToDate.Message
+	}
+	message = strings.Join(strings.Fields(message), " ")
+
+	// APPS: total followed by per-health counts
+	counts := m.appSetHealthRollup(name)
+	total := 0
+	for _, n := range counts {
+		total += n
+	}
+	appsCell := padRight(fmt.Sprintf("%d", total), 3)
+	for _, h := range appSetHealthOrder {
+		if counts[h] > 0 {
+			appsCell += " " + style(m.getColorForStatus(h), fmt.Sprintf("%s%d", m.getHealthIcon(h), counts[h]))
+		}
+	}
+
+	cells := []string{padRight(truncateWithEllipsis(name, nameW), nameW)}
+	if genW > 0 {
+		policy := ""
+		if fromAPI {
+			policy = set.SyncPolicy
+			if set.Strategy != "" {
+				policy += " " + strings.ToLower(strings.TrimSuffix(set.Strategy, "Sync"))
+			}
+			if set.PreserveResourcesOnDeletion {
+				policy += " keep"
+			}
+		}
+		cells = append(cells,
+			padRight(truncateWithEllipsis(strings.Join(set.Generators, ","), genW), genW),
+			padRight(truncateWithEllipsis(policy, policyW), policyW))
+	}
+	cells = append(cells,
+		padRight(style(lipgloss.NewStyle().Foreground(statusColor), statusText), statusW),
+		padRight(clipAnsiToWidth(appsCell, appsW), appsW))
+	if msgW > 0 {
+		msgStyle := dim
+		if set.HasError() {
+			msgStyle = lipgloss.NewStyle().Foreground(outOfSyncColor)
+		}
+		cells = append(cells, padRight(style(msgStyle, truncateWithEllipsis(message, msgW)), msgW))
+	}
+
+	row := padRight(clipAnsiToWidth(strings.Join(cells, " "), contentWidth), contentWidth)
+	if isCursor {
+		row = selectedStyle.Render(row)
+	}
+	return row
+}
+
+// renderConfirmAppSetDeleteModal renders the confirmation for deleting an ApplicationSet
+func (m *Model) renderConfirmAppSetDeleteModal() string {
+	name := m.state.Modals.AppSetDeleteName
+
+	// Modal width: compact and centered (matches the terminate confirmation)
+	half := m.state.Terminal.Cols / 2
+	modalWidth := min(max(44, half), m.state.Terminal.Cols-6)
+	innerWidth := max(0, modalWidth-4) // border(2)+padding(2)
+	center := lipgloss.NewStyle().Width(innerWidth).Align(lipgloss.Center)
+
+	titleLine := statusStyle.Render("Delete ApplicationSet ") +
+		lipgloss.NewStyle().Foreground(whiteBright).Bold(true).Render(name) +
+		statusStyle.Render("?")
+	lines := []string{center.Render(titleLine)}
+
+	// Deleting the set also deletes its apps unless the sync policy preserves them
+	preserve := false
+	if m.state.ApplicationSets != nil {
+		preserve = m.state.ApplicationSets.Items[name].PreserveResourcesOnDeletion
+	}
+	total := 0
+	for _, n := range m.appSetHealthRollup(name) {
+		total += n
+	}
+	if preserve {
+		lines = append(lines, center.Render(statusStyle.Render(fmt.Sprintf("Its %d generated app(s) are preserved", total))))
+	} else {
+		warn := lipgloss.NewStyle().Foreground(outOfSyncColor).Bold(true)
+		lines = append(lines, center.Render(warn.Render(fmt.Sprintf("This also deletes its %d generated app(s)", total))))
+	}
+	lines = append(lines, "")
+
+	if m.state.Modals.AppSetDeleteLoading {
+		lines = append(lines, center.Render(fmt.Sprintf("%s %s", m.spinner.View(), statusStyle.Render("Deleting…"))))
+	} else {
+		inactiveFG := ensureContrastingForeground(inactiveBG, whiteBright)
+		active := lipgloss.NewStyle().Background(outOfSyncColor).Foreground(textOnAccent).Bold(true).Padding(0, 2)
+		inactive := lipgloss.NewStyle().Background(inactiveBG).Foreground(inactiveFG).Padding(0, 2)
+		deleteBtn := inactive.Render("Delete")
+		cancelBtn := inactive.Render("Cancel")
+		if m.state.Modals.AppSetDeleteSelected == 0 {
+			deleteBtn = active.Render("Delete")
+		} else {
+			cancelBtn = active.Render("Cancel")
+		}
+		lines = append(lines, center.Render(lipgloss.JoinHorizontal(lipgloss.Center, deleteBtn, strings.Repeat(" ", 4), cancelBtn)))
+	}
+
+	if m.state.Modals.AppSetDeleteError != nil {
+		errStyle := lipgloss.NewStyle().Foreground(outOfSyncColor)
+		lines = append(lines, "")
+		for _, ln := range strings.Split(*m.state.Modals.AppSetDeleteError, "\n") {
+			lines = append(lines, center.Render(errStyle.Render(truncateWithEllipsis("Error: "+ln, innerWidth))))
+		}
+	}
+
+	wrapper := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(outOfSyncColor).
+		Padding(1, 2).
+		Width(modalWidth)
+
+	outer := lipgloss.NewStyle().Padding(1, 1)
+	return outer.Render(wrapper.Render(strings.Join(lines, "\n")))
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/url"
 	"strings"
+	"time"
 
 	"charm.land/lipgloss/v2"
 )
@@ -203,6 +204,24 @@ func (m *Model) renderContextBlock(isNarrow bool) string {
 	if !isNarrow && m.state.APIVersion != "" {
 		lines = append(lines, fmt.Sprintf("%s %s", label.Render("ArgoCD:"), green.Render(m.state.APIVersion)))
 	}
+	if exp, ok := m.currentTokenExpiry(); ok {
+		now := time.Now()
+		switch {
+		case m.usesExecAuth():
+			// The credential command is run again before the token expires
+			text := "exec credential"
+			if now.Before(exp) {
+				text += ", expires in " + formatEventAge(now, exp)
+			}
+			lines = append(lines, fmt.Sprintf("%s  %s", label.Render("Token:"), green.Render(text)))
+		case !now.Before(exp):
+			lines = append(lines, fmt.Sprintf("%s  %s", label.Render("Token:"), lipgloss.NewStyle().Foreground(outOfSyncColor).Render("expired")))
+		case exp.Sub(now) <= m.tokenExpiryWindow():
+			lines = append(lines, fmt.Sprintf("%s  %s", label.Render("Token:"), lipgloss.NewStyle().Foreground(yellowBright).Render("expires in "+formatEventAge(now, exp))))
+		default:
+			lines = append(lines, fmt.Sprintf("%s  %s", label.Render("Token:"), green.Render("expires in "+formatEventAge(now, exp))))
+		}
+	}
 	block := strings.Join(lines, "\n")
 	return lipgloss.NewStyle().PaddingRight(2).Render(block)
 }
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"charm.land/lipgloss/v2"
+)
+
+// clusterColumnWidths splits the content width between the clusters columns.
+// Narrow terminals only get NAME, STATUS and APPS.
+func clusterColumnWidths(availableWidth int) (name, server, version, status, resources, apis, cache, apps, message int) {
+	status, apps = 11, 5
+	if availableWidth < 100 {
+		name = max(1, availableWidth-status-apps-2)
+		return
+	}
+	version, resources, apis, cache = 8, 6, 5, 6
+	rest := availableWidth - version - status - resources - apis - cache - apps - 8
+	name = max(12, rest/3)
+	server = max(16, rest/3)
+	message = max(0, rest-name-server)
+	return
+}
+
+// renderClusterHeader renders the clusters table header
+func (m *Model) renderClusterHeader() string {
+	contentWidth := m.contentInnerWidth()
+	nameW, serverW, versionW, statusW, resW, apisW, cacheW, appsW, msgW := clusterColumnWidths(contentWidth)
+	cells := []string{padRight("NAME", nameW)}
+	if serverW > 0 {
+		cells = append(cells, padRight("SERVER", serverW), padRight("VERSION", versionW))
+	}
+	cells = append(cells, padRight("STATUS", statusW))
+	if serverW > 0 {
+		cells = append(cells, padLeft("RES", resW), padLeft("APIS", apisW), padLeft("CACHE", cacheW))
+	}
+	cells = append(cells, padLeft("APPS", appsW))
+	if msgW > 0 {
+		cells = append(cells, padRight("MESSAGE", msgW))
+	}
+	return padRight(clipAnsiToWidth(headerStyle.Render(strings.Join(cells, " ")), contentWidth), contentWidth)
+}
+
+// renderClusterRow renders one cluster with its connection state, cache info and app count
+func (m *Model) renderClusterRow(label string, isCursor bool) string {
+	contentWidth := m.contentInnerWidth()
+	nameW, serverW, versionW, statusW, resW, apisW, cacheW, appsW, msgW := clusterColumnWidths(contentWidth)
+
+	cluster, registered := m.clusterForLabel(label)
+	active := isCursor || m.state.Selections.HasCluster(label)
+
+	style := func(st lipgloss.Style, s string) string {
+		if active {
+			// Active row: avoid inner color styles so background highlight spans the whole row
+			return s
+		}
+		return st.Render(s)
+	}
+	orDash := func(s string) string {
+		if s == "" {
+			return "-"
+		}
+		return s
+	}
+	count := func(n int) string {
+		if !registered {
+			return "-"
+		}
+		return fmt.Sprintf("%d", n)
+	}
+
+	statusText, statusColor, message := "-", unknownColor, cluster.ConnectionMessage
+	switch {
+	case !registered:
+		if m.state.Clusters != nil {
+			message = "not returned by the clusters API"
+		}
+	case cluster.ConnectionStatus == "Successful":
+		statusText, statusColor = cluster.ConnectionStatus, syncedColor
+	case cluster.Failed():
+		statusText, statusColor = cluster.ConnectionStatus, outOfSyncColor
+	default:
+		statusText = cluster.ConnectionStatus
+	}
+	message = strings.Join(strings.Fields(message), " ")
+
+	cells := []string{padRight(truncateWithEllipsis(label, nameW), nameW)}
+	if serverW > 0 {
+		cells = append(cells,
+			padRight(truncateWithEllipsis(orDash(cluster.Server), serverW), serverW),
+			padRight(truncateWithEllipsis(orDash(cluster.Version), versionW), versionW))
+	}
+	cells = append(cells, padRight(style(lipgloss.NewStyle().Foreground(statusColor), statusText), statusW))
+	if serverW > 0 {
+		cacheAge := "-"
+		if cluster.CacheSyncedAt != nil {
+			cacheAge = formatEventAge(*cluster.CacheSyncedAt, time.Now())
+		}
+		cells = append(cells,
+			padLeft(count(cluster.ResourcesCount), resW),
+			padLeft(count(cluster.APIsCount), apisW),
+			padLeft(cacheAge, cacheW))
+	}
+	cells = append(cells, padLeft(fmt.Sprintf("%d", m.clusterAppCount(label)), appsW))
+	if msgW > 0 {
+		msgStyle := lipgloss.NewStyle().Foreground(dimColor)
+		if cluster.Failed() {
+			msgStyle = lipgloss.NewStyle().Foreground(outOfSyncColor)
+		}
+		cells = append(cells, padRight(style(msgStyle, truncateWithEllipsis(message, msgW)), msgW))
+	}
+
+	row := padRight(clipAnsiToWidth(strings.Join(cells, " "), contentWidth), contentWidth)
+	if active {
+		row = selectedStyle.Render(row)
+	}
+	return row
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"charm.land/lipgloss/v2"
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+// minAppNameWidth is the narrowest the NAME column gets before optional columns are hidden
+const minAppNameWidth = 20
+
+// appColumnWidths fits the enabled optional columns between NAME and SYNC. Columns are added
+// in order while NAME keeps at least minAppNameWidth; the ones that no longer fit get width 0.
+func appColumnWidths(availableWidth int, columns []string) (nameWidth, syncWidth, healthWidth int, widths []int) {
+	nameWidth, syncWidth, healthWidth = calculateColumnWidths(availableWidth)
+	widths = make([]int, len(columns))
+	if syncWidth < 5 {
+		// Icon-only layout: no room for anything else
+		return
+	}
+	for i, name := range columns {
+		col, ok := model.LookupAppColumn(name)
+		if !ok || nameWidth-col.Width-1 < minAppNameWidth {
+			continue
+		}
+		widths[i] = col.Width
+		nameWidth -= col.Width + 1
+	}
+	return
+}
+
+// appColumnsWidth returns the width the optional columns take including their separators
+func appColumnsWidth(widths []int) int {
+	total := 0
+	for _, w := range widths {
+		if w > 0 {
+			total += w + 1
+		}
+	}
+	return total
+}
+
+// renderAppColumnHeaders renders the titles of the shown optional columns, each followed by a separator
+func (m *Model) renderAppColumnHeaders(widths []int) string {
+	var b strings.Builder
+	for i, name := range m.state.UI.Columns {
+		if widths[i] == 0 {
+			continue
+		}
+		col, _ := model.LookupAppColumn(name)
+		title := col.Title
+		if strings.EqualFold(string(m.state.UI.Sort.Field), name) {
+			// Sort fields share their name with the column (lastSync/lastsync)
+			title = m.state.UI.Sort.Direction.Indicator() + title
+		}
+		b.WriteString(padRight(clipAnsiToWidth(headerStyle.Render(title), widths[i]), widths[i]))
+		b.WriteString(" ")
+	}
+	return b.String()
+}
+
+// renderAppColumnCells renders an app's values of the shown optional columns, each followed by a separator
+func (m *Model) renderAppColumnCells(app model.App, widths []int, active bool, now time.Time) string {
+	var b strings.Builder
+	for i, name := range m.state.UI.Columns {
+		if widths[i] == 0 {
+			continue
+		}
+		value := appColumnValue(app, name, now)
+		if value == "" {
+			value = "-"
+		}
+		cell := truncateWithEllipsis(value, widths[i])
+		if !active {
+			// Active row: avoid inner color styles so background highlight spans the whole row
+			if name == "phase" {
+				cell = m.getColorForStatus(app.OperationPhase).Render(cell)
+			} else if value == "-" {
+				cell = lipgloss.NewStyle().Foreground(dimColor).Render(cell)
+			}
+		}
+		b.WriteString(padRight(cell, widths[i]))
+		b.WriteString(" ")
+	}
+	return b.String()
+}
+
+// appColumnValue returns the text of an optional column for an app
+func appColumnValue(app model.App, column string, now time.Time) string {
+	if key, ok := model.LabelColumnKey(column); ok {
+		return app.Labels[key]
+	}
+	deref := func(s *string) string {
+		if s == nil {
+			return ""
+		}
+		return *s
+	}
+	switch column {
+	case "project":
+		return deref(app.Project)
+	case "cluster":
+		return deref(app.ClusterLabel)
+	case "namespace":
+		return deref(app.Namespace)
+	case "repo":
+		if len(app.RepoURLs) == 0 {
+			return ""
+		}
+		repo := model.NormalizeRepoURL(app.RepoURLs[0])
+		if _, rest, ok := strings.Cut(repo, "://"); ok {
+			repo = rest
+		}
+		if n := len(app.RepoURLs) - 1; n > 0 {
+			// Multi-source apps list their first repository
+			repo = fmt.Sprintf("%s (+%d)", repo, n)
+		}
+		return repo
+	case "path":
+		return app.SourcePath
+	case "revision":
+		return app.TargetRevision
+	case "synced":
+		return model.ShortRevision(app.SyncedRevision)
+	case "lastsync":
+		if app.LastSyncAt == nil {
+			return ""
+		}
+		return formatEventAge(*app.LastSyncAt, now) + " ago"
+	case "phase":
+		return app.OperationPhase
+	case "appset":
+		return deref(app.ApplicationSet)
+	}
+	return ""
+}
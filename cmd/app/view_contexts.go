@@ -0,0 +1,56 @@
+package main
+
+import (
+	"strings"
+	"time"
+
+	"charm.land/lipgloss/v2"
+)
+
+// contextColumnWidths splits the content width between the NAME and TOKEN columns
+func contextColumnWidths(availableWidth int) (name, token int) {
+	token = min(40, availableWidth/2)
+	name = max(1, availableWidth-token-1)
+	return
+}
+
+// renderContextHeader renders the contexts table header
+func (m *Model) renderContextHeader() string {
+	contentWidth := m.contentInnerWidth()
+	nameW, tokenW := contextColumnWidths(contentWidth)
+	header := padRight("NAME", nameW) + " " + padRight("TOKEN", tokenW)
+	return padRight(clipAnsiToWidth(headerStyle.Render(header), contentWidth), contentWidth)
+}
+
+// renderContextRow renders one ArgoCD context with the validity of its stored token, so
+// contexts that need `argocd login` stand out
+func (m *Model) renderContextRow(name string, isCursor bool) string {
+	contentWidth := m.contentInnerWidth()
+	nameW, tokenW := contextColumnWidths(contentWidth)
+
+	tokenText, tokenColor := "-", dimColor
+	if status, ok := m.state.ContextTokens[name]; ok {
+		now := time.Now()
+		tokenText = tokenStatusText(status, now)
+		switch {
+		case status.Expired(now):
+			tokenColor = outOfSyncColor
+		case !status.ExpiresAt.IsZero() && status.ExpiresAt.Sub(now) <= m.tokenExpiryWindow():
+			tokenColor = yellowBright
+		default:
+			tokenColor = syncedColor
+		}
+	}
+	tokenText = truncateWithEllipsis(tokenText, tokenW)
+	if !isCursor {
+		// Active row: avoid inner color styles so background highlight spans the whole row
+		tokenText = lipgloss.NewStyle().Foreground(tokenColor).Render(tokenText)
+	}
+
+	cells := []string{padRight(truncateWithEllipsis(name, nameW), nameW), padRight(tokenText, tokenW)}
+	row := padRight(clipAnsiToWidth(strings.Join(cells, " "), contentWidth), contentWidth)
+	if isCursor {
+		row = selectedStyle.Render(row)
+	}
+	return row
+}
@@ -0,0 +1,147 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"charm.land/lipgloss/v2"
+)
+
+// renderEventsView renders the Kubernetes events view (full screen, like the diff view)
+func (m *Model) renderEventsView() string {
+	events := m.state.Events
+	if events == nil {
+		return contentBorderStyle.Render("No events loaded")
+	}
+	rows := events.Events
+
+	contentHeight := m.eventsPageSize()
+	maxOffset := max(0, len(rows)-contentHeight)
+	events.Offset = min(max(0, events.Offset), maxOffset)
+	start := events.Offset
+	end := min(len(rows), start+contentHeight)
+
+	// Width should account for main container padding (2) and content border padding (2)
+	contentWidth := max(0, m.state.Terminal.Cols-4)
+	innerWidth := max(1, contentWidth-4)
+
+	// Object column only matters when events span the whole application
+	showObject := events.Resource == ""
+	const (
+		typeW   = 7
+		reasonW = 22
+		ageW    = 5
+		countW  = 5
+	)
+	objectW := 0
+	if showObject {
+		objectW = min(36, max(16, innerWidth/4))
+	}
+	fixed := typeW + reasonW + ageW + countW + 4
+	if showObject {
+		fixed += objectW + 1
+	}
+	messageW := max(10, innerWidth-fixed)
+
+	pad := func(s string, w int) string {
+		s = truncateWithEllipsis(s, w)
+		if d := w - lipgloss.Width(s); d > 0 {
+			s += strings.Repeat(" ", d)
+		}
+		return s
+	}
+	padLeft := func(s string, w int) string {
+		s = truncateWithEllipsis(s, w)
+		if d := w - lipgloss.Width(s); d > 0 {
+			s = strings.Repeat(" ", d) + s
+		}
+		return s
+	}
+
+	headerCols := []string{pad("TYPE", typeW), pad("REASON", reasonW), padLeft("AGE", ageW), padLeft("COUNT", countW)}
+	if showObject {
+		headerCols = append(headerCols, pad("OBJECT", objectW))
+	}
+	headerCols = append(headerCols, "MESSAGE")
+	bodyLines := []string{lipgloss.NewStyle().Foreground(yellowBright).Bold(true).Render(strings.Join(headerCols, " "))}
+
+	now := time.Now()
+	warnStyle := lipgloss.NewStyle().Foreground(progressColor).Bold(true)
+	normalStyle := lipgloss.NewStyle().Foreground(dimColor)
+	for _, ev := range rows[start:end] {
+		typeCol := pad(ev.Type, typeW)
+		if ev.Type == "Warning" {
+			typeCol = warnStyle.Render(typeCol)
+		} else {
+			typeCol = normalStyle.Render(typeCol)
+		}
+		cols := []string{
+			typeCol,
+			pad(ev.Reason, reasonW),
+			padLeft(formatEventAge(ev.LastSeen, now), ageW),
+			padLeft(fmt.Sprintf("%d", ev.Count), countW),
+		}
+		if showObject {
+			cols = append(cols, pad(ev.Object, objectW))
+		}
+		// Multi-line messages are folded onto one row
+		message := strings.Join(strings.Fields(ev.Message), " ")
+		cols = append(cols, truncateWithEllipsis(message, messageW))
+		bodyLines = append(bodyLines, strings.Join(cols, " "))
+	}
+	if len(rows) == 0 {
+		placeholder := "No events"
+		switch {
+		case events.Error != "":
+			placeholder = "Error: " + events.Error
+		case events.Loading:
+			placeholder = "Loading events…"
+		}
+		bodyLines = append(bodyLines, statusStyle.Render(placeholder))
+	}
+	body := strings.Join(bodyLines, "\n")
+
+	subject := events.AppName
+	if events.Resource != "" {
+		subject += " › " + events.Resource
+	}
+	title := headerStyle.Render("Events · " + subject)
+
+	updated := "loading…"
+	if events.UpdatedAt != nil {
+		updated = "updated " + formatEventAge(*events.UpdatedAt, now) + " ago"
+	}
+	if events.Error != "" && len(rows) > 0 {
+		updated = "refresh failed: " + events.Error
+	}
+	status := statusStyle.Render(fmt.Sprintf("%d-%d/%d  %s  j/k, g/G, r refresh, esc/q back",
+		min(start+1, end), end, len(rows), updated))
+
+	content := contentBorderStyle.Width(contentWidth).Render(body)
+
+	var sections []string
+	sections = append(sections, title)
+	sections = append(sections, content)
+	sections = append(sections, status)
+
+	return mainContainerStyle.Width(m.state.Terminal.Cols).Render(strings.Join(sections, "\n"))
+}
+
+// formatEventAge formats the time since t in the compact kubectl style (45s, 3m, 2h, 4d)
+func formatEventAge(t time.Time, now time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+	d := now.Sub(t)
+	switch {
+	case d < time.Minute:
+		return fmt.Sprintf("%ds", max(0, int(d.Seconds())))
+	case d < time.Hour:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	case d < 24*time.Hour:
+		return fmt.Sprintf("%dh", int(d.Hours()))
+	default:
+		return fmt.Sprintf("%dd", int(d.Hours()/24))
+	}
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+)
+
+// labelColumnWidths splits the content width between the labels columns. The values column is
+// only shown while listing label keys.
+func labelColumnWidths(availableWidth int, keys bool) (name, values, apps int) {
+	apps = 5
+	if keys && availableWidth >= 40 {
+		values = 7
+		name = max(1, availableWidth-values-apps-2)
+		return
+	}
+	name = max(1, availableWidth-apps-1)
+	return
+}
+
+// renderLabelHeader renders the labels table header
+func (m *Model) renderLabelHeader() string {
+	contentWidth := m.contentInnerWidth()
+	keys := m.state.Selections.LabelKey == ""
+	nameW, valuesW, appsW := labelColumnWidths(contentWidth, keys)
+	cells := []string{padRight("LABEL", nameW)}
+	if keys {
+		cells[0] = padRight("KEY", nameW)
+	}
+	if valuesW > 0 {
+		cells = append(cells, padLeft("VALUES", valuesW))
+	}
+	cells = append(cells, padLeft("APPS", appsW))
+	return padRight(clipAnsiToWidth(headerStyle.Render(strings.Join(cells, " ")), contentWidth), contentWidth)
+}
+
+// renderLabelRow renders a label key with its number of values, or a key=value selector, and
+// how many apps carry it
+func (m *Model) renderLabelRow(item string, isCursor bool) string {
+	contentWidth := m.contentInnerWidth()
+	nameW, valuesW, appsW := labelColumnWidths(contentWidth, m.state.Selections.LabelKey == "")
+
+	cells := []string{padRight(truncateWithEllipsis(item, nameW), nameW)}
+	if valuesW > 0 {
+		count := 0
+		if m.state.Index != nil {
+			count = len(m.state.Index.LabelValues[item])
+		}
+		values := fmt.Sprintf("%d", count)
+		if !isCursor {
+			// Active row: avoid inner color styles so background highlight spans the whole row
+			values = lipgloss.NewStyle().Foreground(dimColor).Render(values)
+		}
+		cells = append(cells, padLeft(values, valuesW))
+	}
+	cells = append(cells, padLeft(fmt.Sprintf("%d", m.labelAppCount(item)), appsW))
+
+	row := padRight(clipAnsiToWidth(strings.Join(cells, " "), contentWidth), contentWidth)
+	if isCursor {
+		row = selectedStyle.Render(row)
+	}
+	return row
+}
@@ -136,6 +136,7 @@ func (m *Model) renderMainLayout() string {
 	if m.treeView != nil && m.state.Navigation.View == model.ViewTree {
 		willDesaturate := m.state.Mode == model.ModeConfirmResourceDelete ||
 			m.state.Mode == model.ModeConfirmResourceSync ||
+			m.state.Mode == model.ModeResourceActions ||
 			m.state.Mode == model.ModeConfirmAppDelete ||
 			m.state.Mode == model.ModeConfirmSync ||
 			m.state.Modals.ConfirmSyncLoading ||
@@ -213,6 +214,28 @@ func (m *Model) renderMainLayout() string {
 		canvas := lipgloss.NewCanvas(baseLayer, modalLayer)
 		return canvas.Render()
 	}
+	// Terminate operation modal (confirmation or loading state)
+	if m.state.Mode == model.ModeConfirmTerminate {
+		modal := m.renderConfirmTerminateModal()
+		grayBase := desaturateANSI(baseView)
+		baseLayer := lipgloss.NewLayer(grayBase)
+		modalX := (m.state.Terminal.Cols - lipgloss.Width(modal)) / 2
+		modalY := (m.state.Terminal.Rows - lipgloss.Height(modal)) / 2
+		modalLayer := lipgloss.NewLayer(modal).X(modalX).Y(modalY).Z(1)
+		canvas := lipgloss.NewCanvas(baseLayer, modalLayer)
+		return canvas.Render()
+	}
+	// ApplicationSet delete modal (confirmation or loading state)
+	if m.state.Mode == model.ModeConfirmAppSetDelete {
+		modal := m.renderConfirmAppSetDeleteModal()
+		grayBase := desaturateANSI(baseView)
+		baseLayer := lipgloss.NewLayer(grayBase)
+		modalX := (m.state.Terminal.Cols - lipgloss.Width(modal)) / 2
+		modalY := (m.state.Terminal.Rows - lipgloss.Height(modal)) / 2
+		modalLayer := lipgloss.NewLayer(modal).X(modalX).Y(modalY).Z(1)
+		canvas := lipgloss.NewCanvas(baseLayer, modalLayer)
+		return canvas.Render()
+	}
 	// Changelog loading modal
 	if m.state.Modals.ChangelogLoading {
 		modal := m.renderChangelogLoadingModal()
@@ -325,6 +348,17 @@ func (m *Model) renderMainLayout() string {
 		canvas := lipgloss.NewCanvas(baseLayer, modalLayer)
 		return canvas.Render()
 	}
+	// Resource actions modal (picker, confirmation or running state)
+	if m.state.Mode == model.ModeResourceActions {
+		modal := m.renderResourceActionsModal()
+		grayBase := desaturateANSI(baseView)
+		baseLayer := lipgloss.NewLayer(grayBase)
+		modalX := (m.state.Terminal.Cols - lipgloss.Width(modal)) / 2
+		modalY := (m.state.Terminal.Rows - lipgloss.Height(modal)) / 2
+		modalLayer := lipgloss.NewLayer(modal).X(modalX).Y(modalY).Z(1)
+		canvas := lipgloss.NewCanvas(baseLayer, modalLayer)
+		return canvas.Render()
+	}
 	if m.state.Mode == model.ModeLoading && m.state.Navigation.View != model.ViewContexts {
 		modal := m.renderInitialLoadingModal()
 		grayBase := desaturateANSI(baseView)
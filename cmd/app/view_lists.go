@@ -3,6 +3,7 @@ package main
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"charm.land/lipgloss/v2"
 	"github.com/darksworm/argonaut/pkg/model"
@@ -70,7 +71,12 @@ func (m *Model) renderListView(availableRows int) string {
 			}
 			tableView = b.String()
 
-		case model.ViewClusters, model.ViewNamespaces, model.ViewProjects, model.ViewApplicationSets, model.ViewContexts:
+		case model.ViewClusters, model.ViewNamespaces, model.ViewProjects, model.ViewApplicationSets, model.ViewRepositories, model.ViewLabels, model.ViewContexts:
+			isAppSets := m.state.Navigation.View == model.ViewApplicationSets
+			isRepos := m.state.Navigation.View == model.ViewRepositories
+			isLabels := m.state.Navigation.View == model.ViewLabels
+			isClusters := m.state.Navigation.View == model.ViewClusters
+			isContexts := m.state.Navigation.View == model.ViewContexts
 			// Custom-render single-column lists with full-row highlight
 			total := len(visibleItems)
 			visibleRows := max(0, tableHeight-1)
@@ -99,7 +105,19 @@ func (m *Model) renderListView(availableRows int) string {
 			for i := start; i < end; i++ {
 				label := fmt.Sprintf("%v", visibleItems[i])
 				isCursor := (i == cursor)
-				b.WriteString(m.renderSimpleRow(label, isCursor))
+				if isAppSets {
+					b.WriteString(m.renderAppSetRow(label, isCursor))
+				} else if isRepos {
+					b.WriteString(m.renderRepositoryRow(label, isCursor))
+				} else if isLabels {
+					b.WriteString(m.renderLabelRow(label, isCursor))
+				} else if isClusters {
+					b.WriteString(m.renderClusterRow(label, isCursor))
+				} else if isContexts {
+					b.WriteString(m.renderContextRow(label, isCursor))
+				} else {
+					b.WriteString(m.renderSimpleRow(label, isCursor))
+				}
 				if i < end-1 {
 					b.WriteString("\n")
 				}
@@ -132,7 +150,7 @@ func (m *Model) renderListHeader() string {
 	if m.state.Navigation.View == model.ViewApps {
 		// Responsive widths matching row rendering
 		contentWidth := m.contentInnerWidth()
-		nameWidth, syncWidth, healthWidth := calculateColumnWidths(contentWidth)
+		nameWidth, syncWidth, healthWidth, columnWidths := appColumnWidths(contentWidth, m.state.UI.Columns)
 
 		// Get sort indicator for the active column
 		sortIndicator := m.state.UI.Sort.Direction.Indicator()
@@ -187,9 +205,9 @@ func (m *Model) renderListHeader() string {
 		syncCell := padLeft(clipAnsiToWidth(syncHeader, syncWidth), syncWidth)
 		healthCell := padLeft(clipAnsiToWidth(healthHeader, healthWidth), healthWidth)
 
-		header := fmt.Sprintf("%s %s %s", nameCell, syncCell, healthCell)
+		header := fmt.Sprintf("%s %s%s %s", nameCell, m.renderAppColumnHeaders(columnWidths), syncCell, healthCell)
 		// Use same width calculation as rows to ensure perfect alignment
-		fullRowWidth := nameWidth + syncWidth + healthWidth + 2 // +2 for separators
+		fullRowWidth := nameWidth + appColumnsWidth(columnWidths) + syncWidth + healthWidth + 2 // +2 for separators
 		headerWidth := lipgloss.Width(header)
 		if headerWidth < fullRowWidth {
 			header = padRight(header, fullRowWidth)
@@ -199,6 +217,22 @@ func (m *Model) renderListHeader() string {
 		return header
 	}
 
+	if m.state.Navigation.View == model.ViewApplicationSets {
+		return m.renderAppSetHeader()
+	}
+	if m.state.Navigation.View == model.ViewRepositories {
+		return m.renderRepositoryHeader()
+	}
+	if m.state.Navigation.View == model.ViewLabels {
+		return m.renderLabelHeader()
+	}
+	if m.state.Navigation.View == model.ViewClusters {
+		return m.renderClusterHeader()
+	}
+	if m.state.Navigation.View == model.ViewContexts {
+		return m.renderContextHeader()
+	}
+
 	// Simple header for other views padded to full content width
 	contentWidth := m.contentInnerWidth()
 	hdr := headerStyle.Render("NAME")
@@ -227,7 +261,7 @@ func (m *Model) renderAppRow(app model.App, isCursor bool) string {
 	healthIcon := m.getHealthIcon(app.Health)
 
 	contentWidth := m.contentInnerWidth() // Match header/content inner width
-	nameWidth, syncWidth, healthWidth := calculateColumnWidths(contentWidth)
+	nameWidth, syncWidth, healthWidth, columnWidths := appColumnWidths(contentWidth, m.state.UI.Columns)
 
 	// Generate text based on available width (either full text or icons only)
 	// Colored status strings with icons (as before)
@@ -237,6 +271,45 @@ func (m *Model) renderAppRow(app model.App, isCursor bool) string {
 	// Truncate app name with ellipsis if it's too long
 	truncatedName := truncateWithEllipsis(app.Name, nameWidth)
 
+	// Tag every state that applies: a running operation (which can be terminated), a cluster
+	// ArgoCD cannot reach and a sync window that blocks syncing. When the name column is too
+	// narrow for all of them, the last ones are dropped first.
+	type nameTag struct {
+		text  string
+		style lipgloss.Style
+	}
+	var tags []nameTag
+	if app.HasRunningOperation() {
+		tags = append(tags, nameTag{runningOperationTag, lipgloss.NewStyle().Foreground(progressColor)})
+	}
+	if m.appClusterFailed(app) {
+		tags = append(tags, nameTag{clusterFailedTag, lipgloss.NewStyle().Foreground(outOfSyncColor)})
+	}
+	switch block := m.syncWindowBlock(app); {
+	case block.Blocked:
+		tags = append(tags, nameTag{syncBlockedTag, lipgloss.NewStyle().Foreground(outOfSyncColor)})
+	case block.Unknown:
+		tags = append(tags, nameTag{syncUnknownTag, lipgloss.NewStyle().Foreground(unknownColor)})
+	}
+	tagsWidth := 0
+	for _, tag := range tags {
+		tagsWidth += lipgloss.Width(tag.text)
+	}
+	for len(tags) > 0 && nameWidth <= tagsWidth+3 {
+		tagsWidth -= lipgloss.Width(tags[len(tags)-1].text)
+		tags = tags[:len(tags)-1]
+	}
+	if len(tags) > 0 {
+		truncatedName = truncateWithEllipsis(app.Name, nameWidth-tagsWidth)
+		for _, tag := range tags {
+			if active {
+				truncatedName += tag.text
+			} else {
+				truncatedName += tag.style.Render(tag.text)
+			}
+		}
+	}
+
 	var nameCell, syncCell, healthCell string
 	// Build cells with clipping to assigned widths to prevent wrapping
 	nameCell = padRight(truncateWithEllipsis(truncatedName, nameWidth), nameWidth)
@@ -265,10 +338,10 @@ func (m *Model) renderAppRow(app model.App, isCursor bool) string {
 		healthCell = padLeft(healthStyled, healthWidth)
 	}
 
-	row := fmt.Sprintf("%s %s %s", nameCell, syncCell, healthCell)
+	row := fmt.Sprintf("%s %s%s %s", nameCell, m.renderAppColumnCells(app, columnWidths, active, time.Now()), syncCell, healthCell)
 
 	// Ensure row is exactly the content width to avoid wrapping
-	fullRowWidth := nameWidth + syncWidth + healthWidth + 2 // +2 for separators
+	fullRowWidth := nameWidth + appColumnsWidth(columnWidths) + syncWidth + healthWidth + 2 // +2 for separators
 	if lipgloss.Width(row) < fullRowWidth {
 		row = padRight(row, fullRowWidth)
 	} else if lipgloss.Width(row) > fullRowWidth {
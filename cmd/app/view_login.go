@@ -0,0 +1,46 @@
+package main
+
+import (
+	"strings"
+
+	"charm.land/lipgloss/v2"
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+// loginFormLines renders the username/password form of the auth-required view
+func (m *Model) loginFormLines(login *model.LoginState) []string {
+	center := lipgloss.NewStyle().Align(lipgloss.Center)
+	title := "Log in to " + login.Server.BaseURL
+	if len(m.state.Apps) > 0 {
+		// Apps were loaded before, so the token expired or was revoked mid-session
+		title = "Your session expired. Log in to " + login.Server.BaseURL + " to continue."
+	}
+
+	field := func(label, value string, focused bool) string {
+		labelStyle := statusStyle
+		if focused {
+			labelStyle = lipgloss.NewStyle().Foreground(yellowBright).Bold(true)
+			value += "▌"
+		}
+		return labelStyle.Render(padRight(label, 10)) + " " + lipgloss.NewStyle().Foreground(whiteBright).Render(value)
+	}
+
+	lines := []string{
+		"",
+		center.Render(lipgloss.NewStyle().Foreground(outOfSyncColor).Bold(true).Render(title)),
+		"",
+		field("Username", login.Username, login.Field == model.LoginFieldUsername),
+		field("Password", strings.Repeat("•", len([]rune(login.Password))), login.Field == model.LoginFieldPassword),
+		"",
+	}
+	switch {
+	case login.Submitting:
+		lines = append(lines, lipgloss.NewStyle().Foreground(progressColor).Render("Logging in…"))
+	case login.Error != "":
+		lines = append(lines, lipgloss.NewStyle().Foreground(outOfSyncColor).Render("✗ "+login.Error))
+	default:
+		lines = append(lines, "")
+	}
+	lines = append(lines, "", statusStyle.Render("SSO users: run argocd login --sso in another shell and restart argonaut."))
+	return lines
+}
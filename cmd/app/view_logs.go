@@ -0,0 +1,175 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+// renderLogsView renders the pod logs pane (full screen, like the diff view)
+func (m *Model) renderLogsView() string {
+	logs := m.state.Logs
+	if logs == nil {
+		return contentBorderStyle.Render("No logs loaded")
+	}
+	lines := m.filteredLogLines()
+
+	// The handlers keep the offset clamped; clamp the window again without touching state
+	contentHeight := m.logsPageSize()
+	start := min(max(0, logs.Offset), max(0, len(lines)-contentHeight))
+	end := min(len(lines), start+contentHeight)
+
+	// Width should account for main container padding (2) and content border padding (2)
+	contentWidth := max(0, m.state.Terminal.Cols-4)
+	innerWidth := max(1, contentWidth-4)
+
+	var bodyLines []string
+	for _, ln := range lines[start:end] {
+		// Truncate before highlighting so ANSI sequences are never cut in half
+		if lipgloss.Width(ln) > innerWidth {
+			ln = truncateWithEllipsis(ln, innerWidth)
+		}
+		bodyLines = append(bodyLines, HighlightLogLine(ln))
+	}
+	if len(bodyLines) == 0 {
+		placeholder := "Waiting for log lines…"
+		switch {
+		case logs.Error != "":
+			placeholder = "Error: " + logs.Error
+		case logs.SearchQuery != "" && len(logs.Lines) > 0:
+			placeholder = "No lines match the filter"
+		case !logs.Streaming:
+			placeholder = "No log lines"
+		}
+		bodyLines = append(bodyLines, statusStyle.Render(placeholder))
+	}
+	body := strings.Join(bodyLines, "\n")
+
+	target := logs.Pod
+	if logs.Container != "" {
+		target += "/" + logs.Container
+	}
+	title := headerStyle.Render(fmt.Sprintf("Logs · %s › %s › %s", logs.AppName, logs.Resource, target))
+
+	state := "live"
+	if !logs.Streaming {
+		state = "ended"
+	}
+	if logs.Error != "" {
+		state = "error"
+	}
+	follow := "off"
+	if logs.Follow {
+		follow = "on"
+	}
+	rangeText := fmt.Sprintf("%d-%d/%d", min(start+1, end), end, len(lines))
+	hints := "f follow, s since, t tail"
+	if len(logs.Containers) > 1 {
+		hints += ", c container"
+	}
+	if len(logs.Pods) > 1 {
+		hints += ", p pod"
+	}
+	hints += ", / search, esc/q back"
+	status := statusStyle.Render(fmt.Sprintf("%s  %s  follow:%s  since:%s  tail:%s  %s",
+		rangeText, state, follow, logsSinceLabel(logs.SinceSeconds), logsTailLabel(logs.TailLines), hints))
+
+	content := contentBorderStyle.Width(contentWidth).Render(body)
+
+	var sections []string
+	sections = append(sections, title)
+	if m.state.Mode == model.ModeSearch {
+		sections = append(sections, m.renderEnhancedSearchBar())
+	}
+	sections = append(sections, content)
+	sections = append(sections, status)
+
+	view := mainContainerStyle.Width(m.state.Terminal.Cols).Render(strings.Join(sections, "\n"))
+
+	if logs.Picker != "" {
+		modal := m.renderLogsPickerModal()
+		baseLayer := lipgloss.NewLayer(desaturateANSI(view))
+		modalX := (m.state.Terminal.Cols - lipgloss.Width(modal)) / 2
+		modalY := (m.state.Terminal.Rows - lipgloss.Height(modal)) / 2
+		modalLayer := lipgloss.NewLayer(modal).X(modalX).Y(modalY).Z(1)
+		return lipgloss.NewCanvas(baseLayer, modalLayer).Render()
+	}
+	return view
+}
+
+// renderLogsPickerModal renders the pod/container picker overlay
+func (m *Model) renderLogsPickerModal() string {
+	logs := m.state.Logs
+	options := m.logsPickerOptions()
+
+	heading := "Select Container"
+	current := logs.Container
+	if logs.Picker == "pod" {
+		heading = "Select Pod"
+		current = logs.Pod
+	}
+	title := lipgloss.NewStyle().Foreground(yellowBright).Bold(true).Render(heading)
+
+	lines := []string{title, ""}
+	maxVisible := min(10, len(options))
+	startIdx := 0
+	if logs.PickerIdx >= maxVisible {
+		startIdx = logs.PickerIdx - maxVisible + 1
+	}
+	endIdx := min(len(options), startIdx+maxVisible)
+	if startIdx > 0 {
+		lines = append(lines, lipgloss.NewStyle().Foreground(cyanBright).Render("  ▲ more above"))
+	}
+	for i := startIdx; i < endIdx; i++ {
+		label := options[i]
+		if label == current {
+			label += " (current)"
+		}
+		if i == logs.PickerIdx {
+			lines = append(lines, lipgloss.NewStyle().
+				Background(cyanBright).
+				Foreground(textOnAccent).
+				Padding(0, 1).
+				Render("► "+label))
+		} else {
+			lines = append(lines, "  "+label)
+		}
+	}
+	if endIdx < len(options) {
+		lines = append(lines, lipgloss.NewStyle().Foreground(cyanBright).Render("  ▼ more below"))
+	}
+	lines = append(lines, "", lipgloss.NewStyle().Foreground(dimColor).Render("Enter to select • Esc to cancel"))
+
+	modalStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(cyanBright).
+		Padding(1, 2).
+		Width(min(70, max(40, m.state.Terminal.Cols-10))).
+		AlignHorizontal(lipgloss.Left)
+
+	return modalStyle.Render(strings.Join(lines, "\n"))
+}
+
+// logsSinceLabel formats a "since" window for the logs status line
+func logsSinceLabel(seconds int64) string {
+	switch {
+	case seconds <= 0:
+		return "all"
+	case seconds%3600 == 0:
+		return fmt.Sprintf("%dh", seconds/3600)
+	case seconds%60 == 0:
+		return fmt.Sprintf("%dm", seconds/60)
+	default:
+		return fmt.Sprintf("%ds", seconds)
+	}
+}
+
+// logsTailLabel formats a tail size for the logs status line
+func logsTailLabel(lines int64) string {
+	if lines <= 0 {
+		return "all"
+	}
+	return fmt.Sprintf("%d", lines)
+}
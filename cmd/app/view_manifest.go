@@ -0,0 +1,62 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// renderManifestView renders the live manifest viewer (full screen, like the diff view)
+func (m *Model) renderManifestView() string {
+	manifest := m.state.Manifest
+	if manifest == nil {
+		return contentBorderStyle.Render("No manifest loaded")
+	}
+	lines := manifest.Content
+	if len(lines) == 0 {
+		placeholder := "No manifest"
+		switch {
+		case manifest.Loading:
+			placeholder = "Loading manifest…"
+		case manifest.Error != "":
+			placeholder = "Error: " + manifest.Error
+		}
+		lines = []string{statusStyle.Render(placeholder)}
+	}
+
+	contentHeight := m.manifestPageSize()
+	maxOffset := max(0, len(lines)-contentHeight)
+	manifest.Offset = min(max(0, manifest.Offset), maxOffset)
+	start := manifest.Offset
+	end := min(len(lines), start+contentHeight)
+
+	// Width should account for main container padding (2) and content border padding (2)
+	contentWidth := max(0, m.state.Terminal.Cols-4)
+	innerWidth := max(1, contentWidth-4)
+	visible := make([]string, 0, end-start)
+	for _, ln := range lines[start:end] {
+		visible = append(visible, truncateWithEllipsis(ln, innerWidth))
+	}
+	body := strings.Join(visible, "\n")
+
+	title := headerStyle.Render(manifestTitle(manifest))
+
+	toggle := "d desired"
+	if manifest.ShowDesired {
+		toggle = "d live"
+	}
+	neatToggle := "n neat"
+	if manifest.Neat {
+		neatToggle = "n raw"
+	}
+	status := statusStyle.Render(fmt.Sprintf("%d-%d/%d  j/k, g/G, %s, %s, o pager, r reload, esc/q back",
+		min(start+1, end), end, len(manifest.Content), neatToggle, toggle))
+
+	content := contentBorderStyle.Width(contentWidth).Render(body)
+
+	var sections []string
+	sections = append(sections, title)
+	sections = append(sections, content)
+	sections = append(sections, status)
+
+	return mainContainerStyle.Width(m.state.Terminal.Cols).Render(strings.Join(sections, "\n"))
+}
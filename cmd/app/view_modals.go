@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"charm.land/lipgloss/v2"
+	"github.com/darksworm/argonaut/pkg/model"
 )
 
 func (m *Model) renderHelpModal() string {
@@ -19,6 +20,23 @@ func (m *Model) renderHelpModal() string {
 	}
 	mono := func(s string) string { return lipgloss.NewStyle().Foreground(cyanBright).Render(s) }
 	bullet := func() string { return lipgloss.NewStyle().Foreground(dimColor).Render("•") }
+	// Operations the user's ArgoCD role forbids in every project are struck through
+	unavailable := lipgloss.NewStyle().Foreground(dimColor).Strikethrough(true)
+	anyUnavailable := false
+	gatedKey := func(op model.AppOperation, key, label string) string {
+		if m.operationAvailable(op) {
+			return keycap(key) + label
+		}
+		anyUnavailable = true
+		return unavailable.Render(key+label)
+	}
+	gatedCmd := func(op model.AppOperation, cmd, args string) string {
+		if m.operationAvailable(op) {
+			return mono(cmd) + args
+		}
+		anyUnavailable = true
+		return unavailable.Render(cmd+args)
+	}
 
 	// GENERAL
 	general := strings.Join([]string{
@@ -39,6 +57,12 @@ func (m *Model) renderHelpModal() string {
 		mono(":appsets"), "|", mono(":applicationsets"), " ", bullet(), " ", mono(":theme"), " ", bullet(), " ", mono(":logs"),
 		"\n",
 		mono(":context"), "|", mono(":contexts"), "|", mono(":ctx"), "|", mono(":argocd"), " [name] ",
+		"\n",
+		keycap("i"), " project details and sync windows (projects view)",
+		"\n",
+		mono(":repos"), " [url] ", bullet(), " ", keycap("r"), " refresh connection (repositories view)",
+		"\n",
+		mono(":labels"), " [key[=value]]",
 	}, "")
 
 	// COMMANDS
@@ -48,20 +72,37 @@ func (m *Model) renderHelpModal() string {
 
 	// APPS VIEW - hotkeys and commands specific to apps view
 	appsView := strings.Join([]string{
-		keycap("s"), " sync ", bullet(), " ", keycap("R"), " rollback ", bullet(), " ", keycap("r"), " resources ", bullet(), " ", keycap("d"), " diff ", bullet(), " ", keycap("K"), " open in k9s ", bullet(), " ", keycap("Ctrl+D"), " delete",
+		gatedKey(model.OpSync, "s", " sync "), bullet(), " ", gatedKey(model.OpRollback, "R", " rollback "), bullet(), " ", keycap("r"), " resources ", bullet(), " ", keycap("d"), " diff ", bullet(), " ", keycap("K"), " open in k9s ", bullet(), " ", gatedKey(model.OpDelete, "Ctrl+D", " delete"),
+		"\n",
+		keycap("e"), " events ", bullet(), " ", keycap("T"), " terminate operation ", bullet(), " ", keycap("O"), " sync progress ", bullet(), " ", keycap("i"), " details",
+		"\n",
+		mono(":diff"), " [app] ", bullet(), " ", gatedCmd(model.OpSync, ":sync", " [app] [--flags] "), bullet(), " ", gatedCmd(model.OpRollback, ":rollback", " [app] "), bullet(), " ", gatedCmd(model.OpDelete, ":delete", " [app]"),
 		"\n",
-		mono(":diff"), " [app] ", bullet(), " ", mono(":sync"), " [app] ", bullet(), " ", mono(":rollback"), " [app] ", bullet(), " ", mono(":delete"), " [app]",
+		gatedCmd(model.OpRefresh, ":refresh", " [app] "), bullet(), " ", gatedCmd(model.OpRefresh, ":refresh!", " [app] (hard) "), bullet(), " ", mono(":sort"), " field asc|desc[, …]",
 		"\n",
-		mono(":refresh"), " [app] ", bullet(), " ", mono(":refresh!"), " [app] (hard) ", bullet(), " ", mono(":sort"), " health|sync asc|desc",
+		mono(":resources"), " [app] ", bullet(), " ", mono(":terminate"), " [app] ", bullet(), " ", mono(":up"), " ", bullet(), " ", mono(":all"),
 		"\n",
-		mono(":resources"), " [app] ", bullet(), " ", mono(":up"), " ", bullet(), " ", mono(":all"),
+		mono(":columns"), " name[,name…] toggle list columns",
+		"\n",
+		gatedCmd(model.OpUpdate, ":autosync", " on|off "), bullet(), " ", gatedCmd(model.OpUpdate, ":autosync", " selfheal|prune|allowempty on|off"),
 	}, "")
 
 	// TREE VIEW - hotkeys specific to tree/resources view
 	treeView := strings.Join([]string{
 		mono("/"), " filter ", bullet(), " ", mono("n"), "/", mono("N"), " next/prev match ", bullet(), " ", keycap("d"), " diff ", bullet(), " ", mono("K"), " open in k9s",
 		"\n",
-		keycap("Space"), " select ", bullet(), " ", keycap("s"), " sync ", bullet(), " ", keycap("Ctrl+D"), " delete ", bullet(), " ", mono(":refresh"), "|", mono(":refresh!"), " ", bullet(), " ", mono(":up"),
+		keycap("L"), " logs ", bullet(), " ", keycap("a"), " actions ", bullet(), " ", keycap("e"), " events ", bullet(), " ", keycap("y"), " manifest ", bullet(), " ", keycap("E"), " edit",
+		"\n",
+		keycap("Space"), " select ", bullet(), " ", gatedKey(model.OpSync, "s", " sync "), bullet(), " ", gatedKey(model.OpDelete, "Ctrl+D", " delete "), bullet(), " ", gatedCmd(model.OpRefresh, ":refresh", ""), "|", gatedCmd(model.OpRefresh, ":refresh!", " "), bullet(), " ", mono(":up"),
+		"\n",
+		keycap("O"), " sync progress",
+	}, "")
+
+	// DIFF VIEW - hotkeys of the in-app diff and the app diff resource list
+	diffView := strings.Join([]string{
+		mono("]"), "/", mono("["), " next/prev hunk ", bullet(), " ", mono("}"), "/", mono("{"), " next/prev resource ", bullet(), " ", keycap("v"), " side-by-side",
+		"\n",
+		mono("n"), "/", mono("p"), " next/prev changed resource ", bullet(), " ", keycap("a"), " all ", bullet(), " ", gatedKey(model.OpSync, "s", " sync it"), " (app diff)",
 	}, "")
 
 	var helpSections []string
@@ -76,8 +117,14 @@ func (m *Model) renderHelpModal() string {
 	helpSections = append(helpSections, "")
 	helpSections = append(helpSections, m.renderHelpSection("TREE VIEW", treeView, isWide))
 	helpSections = append(helpSections, "")
+	helpSections = append(helpSections, m.renderHelpSection("DIFF VIEW", diffView, isWide))
+	helpSections = append(helpSections, "")
 	helpSections = append(helpSections, m.renderHelpSection("COMMANDS", commands, isWide))
 	helpSections = append(helpSections, "")
+	if anyUnavailable {
+		helpSections = append(helpSections, unavailable.Render("struck out")+statusStyle.Render(": not permitted by your ArgoCD role"))
+		helpSections = append(helpSections, "")
+	}
 	helpSections = append(helpSections, statusStyle.Render("Press ?, q or Esc to close"))
 
 	body := "\n" + strings.Join(helpSections, "\n") + "\n"
@@ -239,7 +286,7 @@ func (m *Model) renderRollbackModal() string {
 
 	if rollback.Mode != "confirm" {
 		instructionStyle := lipgloss.NewStyle().Foreground(cyanBright)
-		instructions := "j/k: Navigate • Enter: Select • Esc: Cancel"
+		instructions := "j/k: Navigate • Space: Mark • d: Diff • Enter: Select • Esc: Cancel"
 		modalContent += "\n\n" + instructionStyle.Render(instructions)
 	}
 
@@ -886,6 +933,100 @@ func (m *Model) renderResourceSyncLoadingModal() string {
 	return outer.Render(wrapper.Render(content))
 }
 
+// renderResourceActionsModal renders the resource actions picker and its confirmation step
+func (m *Model) renderResourceActionsModal() string {
+	target := m.state.Modals.ResourceActionTarget
+	if target == nil {
+		return ""
+	}
+
+	// Modal width: compact and centered (like sync modal)
+	half := m.state.Terminal.Cols / 2
+	modalWidth := min(max(36, half), m.state.Terminal.Cols-6)
+	innerWidth := max(0, modalWidth-4) // border(2)+padding(2)
+	center := lipgloss.NewStyle().Width(innerWidth).Align(lipgloss.Center)
+	dim := lipgloss.NewStyle().Foreground(dimColor)
+
+	subject := fmt.Sprintf("%s/%s", target.Kind, target.Name)
+	if target.Namespace != "" {
+		subject = fmt.Sprintf("%s/%s/%s", target.Kind, target.Namespace, target.Name)
+	}
+	subjectStyled := lipgloss.NewStyle().Foreground(whiteBright).Bold(true).Render(subject)
+
+	var lines []string
+	if m.state.Modals.ResourceActionRunning {
+		action := m.state.Modals.ResourceActions[m.state.Modals.ResourceActionSelected]
+		lines = append(lines, fmt.Sprintf("%s %s", m.spinner.View(), statusStyle.Render(fmt.Sprintf("Running %s...", action))))
+	} else if m.state.Modals.ResourceActionConfirm {
+		action := m.state.Modals.ResourceActions[m.state.Modals.ResourceActionSelected]
+		titleLine := lipgloss.NewStyle().Foreground(whiteBright).Render("Run ") +
+			lipgloss.NewStyle().Foreground(yellowBright).Bold(true).Render(action) +
+			lipgloss.NewStyle().Foreground(whiteBright).Render(" on ") +
+			subjectStyled +
+			lipgloss.NewStyle().Foreground(whiteBright).Render("?")
+
+		inactiveFG := ensureContrastingForeground(inactiveBG, whiteBright)
+		active := lipgloss.NewStyle().Background(magentaBright).Foreground(textOnAccent).Bold(true).Padding(0, 2)
+		inactive := lipgloss.NewStyle().Background(inactiveBG).Foreground(inactiveFG).Padding(0, 2)
+		var runBtn, cancelBtn string
+		if m.state.Modals.ResourceActionConfirmSelected == 0 {
+			runBtn = active.Render("Run")
+			cancelBtn = inactive.Render("Cancel")
+		} else {
+			runBtn = inactive.Render("Run")
+			cancelBtn = active.Render("Cancel")
+		}
+		lines = append(lines, center.Render(titleLine), "", center.Render(runBtn+"  "+cancelBtn))
+	} else {
+		lines = append(lines, center.Render(lipgloss.NewStyle().Foreground(whiteBright).Render("Actions for ")+subjectStyled), "")
+		switch {
+		case m.state.Modals.ResourceActionsLoading:
+			lines = append(lines, center.Render(fmt.Sprintf("%s %s", m.spinner.View(), statusStyle.Render("Loading actions..."))))
+		case len(m.state.Modals.ResourceActions) == 0 && m.state.Modals.ResourceActionError == nil:
+			lines = append(lines, center.Render(dim.Render("No actions available for this resource")))
+		default:
+			for i, action := range m.state.Modals.ResourceActions {
+				label := action
+				if !m.resourceActionAllowed(action) {
+					label += " (not permitted)"
+				}
+				if i == m.state.Modals.ResourceActionSelected {
+					lines = append(lines, lipgloss.NewStyle().
+						Background(magentaBright).
+						Foreground(textOnAccent).
+						Padding(0, 1).
+						Render("► "+label))
+				} else if label != action {
+					lines = append(lines, dim.Render("  "+label))
+				} else {
+					lines = append(lines, "  "+action)
+				}
+			}
+		}
+		lines = append(lines, "", center.Render(dim.Render("Enter to choose • Esc to cancel")))
+	}
+
+	body := strings.Join(lines, "\n")
+
+	// Error display if any
+	if m.state.Modals.ResourceActionError != nil {
+		errorMsg := center.Render(lipgloss.NewStyle().
+			Foreground(outOfSyncColor).
+			Render("Error: " + *m.state.Modals.ResourceActionError))
+		body += "\n\n" + errorMsg
+	}
+
+	wrapper := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(magentaBright).
+		Padding(1, 2).
+		Width(modalWidth)
+
+	// Add outer whitespace so the modal doesn't sit directly on top of content
+	outer := lipgloss.NewStyle().Padding(1, 1)
+	return outer.Render(wrapper.Render(body))
+}
+
 // renderNoDiffModal renders a simple modal for when there are no differences
 func (m *Model) renderNoDiffModal() string {
 	msg := "✓ " + statusStyle.Render("No differences found")
@@ -1194,3 +1335,56 @@ func (m *Model) renderThemeSelectionModal() string {
 
 	return modalStyle.Render(content)
 }
+
+// renderConfirmTerminateModal renders the confirmation for terminating running operations
+func (m *Model) renderConfirmTerminateModal() string {
+	apps := m.state.Modals.TerminateApps
+
+	// Modal width: compact and centered (matches the sync confirmation)
+	half := m.state.Terminal.Cols / 2
+	modalWidth := min(max(36, half), m.state.Terminal.Cols-6)
+	innerWidth := max(0, modalWidth-4) // border(2)+padding(2)
+	center := lipgloss.NewStyle().Width(innerWidth).Align(lipgloss.Center)
+
+	subject := fmt.Sprintf("%d application(s)", len(apps))
+	if len(apps) == 1 {
+		subject = apps[0]
+	}
+	titleLine := statusStyle.Render("Terminate operation of ") +
+		lipgloss.NewStyle().Foreground(whiteBright).Bold(true).Render(subject) +
+		statusStyle.Render("?")
+
+	lines := []string{center.Render(titleLine), ""}
+	if m.state.Modals.TerminateLoading {
+		lines = append(lines, center.Render(fmt.Sprintf("%s %s", m.spinner.View(), statusStyle.Render("Terminating…"))))
+	} else {
+		inactiveFG := ensureContrastingForeground(inactiveBG, whiteBright)
+		active := lipgloss.NewStyle().Background(magentaBright).Foreground(textOnAccent).Bold(true).Padding(0, 2)
+		inactive := lipgloss.NewStyle().Background(inactiveBG).Foreground(inactiveFG).Padding(0, 2)
+		yesBtn := inactive.Render("Yes")
+		cancelBtn := inactive.Render("Cancel")
+		if m.state.Modals.TerminateSelected == 0 {
+			yesBtn = active.Render("Yes")
+		} else {
+			cancelBtn = active.Render("Cancel")
+		}
+		lines = append(lines, center.Render(lipgloss.JoinHorizontal(lipgloss.Center, yesBtn, strings.Repeat(" ", 4), cancelBtn)))
+	}
+
+	if m.state.Modals.TerminateError != nil {
+		errStyle := lipgloss.NewStyle().Foreground(outOfSyncColor)
+		lines = append(lines, "")
+		for _, ln := range strings.Split(*m.state.Modals.TerminateError, "\n") {
+			lines = append(lines, center.Render(errStyle.Render(truncateWithEllipsis("Error: "+ln, innerWidth))))
+		}
+	}
+
+	wrapper := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(outOfSyncColor).
+		Padding(1, 2).
+		Width(modalWidth)
+
+	outer := lipgloss.NewStyle().Padding(1, 1)
+	return outer.Render(wrapper.Render(strings.Join(lines, "\n")))
+}
@@ -0,0 +1,183 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+// renderProjectDetailView renders a project's details and sync windows (full screen, like the events view)
+func (m *Model) renderProjectDetailView() string {
+	detail := m.state.ProjectDetail
+	if detail == nil {
+		return contentBorderStyle.Render("No project loaded")
+	}
+
+	// Width should account for main container padding (2) and content border padding (2)
+	contentWidth := max(0, m.state.Terminal.Cols-4)
+	innerWidth := max(1, contentWidth-4)
+
+	var lines []string
+	switch {
+	case detail.Project != nil:
+		lines = m.projectDetailLines(*detail.Project, innerWidth)
+	case detail.Error != "":
+		lines = []string{statusStyle.Render("Error: " + detail.Error)}
+	default:
+		lines = []string{statusStyle.Render("Loading project…")}
+	}
+
+	contentHeight := m.projectDetailPageSize()
+	maxOffset := max(0, len(lines)-contentHeight)
+	detail.Offset = min(max(0, detail.Offset), maxOffset)
+	start := detail.Offset
+	end := min(len(lines), start+contentHeight)
+
+	title := headerStyle.Render("Project · " + detail.Name)
+	status := statusStyle.Render(fmt.Sprintf("%d-%d/%d  j/k, g/G, r reload, esc/q back",
+		min(start+1, end), end, len(lines)))
+
+	content := contentBorderStyle.Width(contentWidth).Render(strings.Join(lines[start:end], "\n"))
+
+	var sections []string
+	sections = append(sections, title)
+	sections = append(sections, content)
+	sections = append(sections, status)
+
+	return mainContainerStyle.Width(m.state.Terminal.Cols).Render(strings.Join(sections, "\n"))
+}
+
+// projectDetailLines renders the sections of the project detail view, one entry per line
+func (m *Model) projectDetailLines(p model.Project, width int) []string {
+	section := lipgloss.NewStyle().Foreground(yellowBright).Bold(true)
+	dim := lipgloss.NewStyle().Foreground(dimColor)
+	var lines []string
+	add := func(s string) {
+		lines = append(lines, clipAnsiToWidth(s, width))
+	}
+	list := func(items []string, empty string) string {
+		if len(items) == 0 {
+			return dim.Render(empty)
+		}
+		return strings.Join(items, ", ")
+	}
+
+	if p.Description != "" {
+		add(dim.Render(strings.Join(strings.Fields(p.Description), " ")))
+		add("")
+	}
+
+	add(section.Render("SOURCE REPOS"))
+	if len(p.SourceRepos) == 0 {
+		add("  " + dim.Render("none"))
+	}
+	for _, repo := range p.SourceRepos {
+		add("  " + repo)
+	}
+	add("")
+
+	add(section.Render("DESTINATIONS"))
+	if len(p.Destinations) == 0 {
+		add("  " + dim.Render("none"))
+	}
+	clusterW := 0
+	for _, d := range p.Destinations {
+		clusterW = max(clusterW, lipgloss.Width(projectDestinationCluster(d)))
+	}
+	clusterW = min(clusterW, width/2)
+	for _, d := range p.Destinations {
+		add("  " + padRight(truncateWithEllipsis(projectDestinationCluster(d), clusterW), clusterW) + "  " + d.Namespace)
+	}
+	add("")
+
+	// An empty cluster allow list permits no cluster-scoped resources; an empty namespace
+	// allow list permits every namespaced resource
+	add(section.Render("CLUSTER RESOURCES"))
+	add("  allow  " + list(p.ClusterResourceAllow, "none"))
+	add("  deny   " + list(p.ClusterResourceDeny, "none"))
+	add("")
+	add(section.Render("NAMESPACE RESOURCES"))
+	add("  allow  " + list(p.NamespaceResourceAllow, "all"))
+	add("  deny   " + list(p.NamespaceResourceDeny, "none"))
+	add("")
+
+	add(section.Render("ROLES"))
+	if len(p.Roles) == 0 {
+		add("  " + dim.Render("none"))
+	}
+	for _, r := range p.Roles {
+		line := fmt.Sprintf("  %s  %s", r.Name, dim.Render(fmt.Sprintf("%d policies", len(r.Policies))))
+		if len(r.Groups) > 0 {
+			line += dim.Render("  groups ") + strings.Join(r.Groups, ", ")
+		}
+		if r.Description != "" {
+			line += dim.Render("  " + r.Description)
+		}
+		add(line)
+	}
+	add("")
+
+	add(section.Render(fmt.Sprintf("SYNC WINDOWS (%d active)", len(p.ActiveWindows))))
+	if len(p.SyncWindows) == 0 {
+		add("  " + dim.Render("none"))
+	}
+	for _, w := range p.SyncWindows {
+		marker := dim.Render("        ")
+		if p.IsActive(w) {
+			color := syncedColor
+			if w.Kind == "deny" {
+				color = outOfSyncColor
+			}
+			marker = lipgloss.NewStyle().Foreground(color).Bold(true).Render("ACTIVE  ")
+		}
+		line := "  " + marker + w.Describe()
+		var scope []string
+		if len(w.Applications) > 0 {
+			scope = append(scope, "apps "+strings.Join(w.Applications, ","))
+		}
+		if len(w.Namespaces) > 0 {
+			scope = append(scope, "namespaces "+strings.Join(w.Namespaces, ","))
+		}
+		if len(w.Clusters) > 0 {
+			scope = append(scope, "clusters "+strings.Join(w.Clusters, ","))
+		}
+		joiner := "; "
+		if w.AndOperator {
+			joiner = " and "
+		}
+		if len(scope) > 0 {
+			line += dim.Render("  " + strings.Join(scope, joiner))
+		}
+		if w.ManualSync {
+			line += dim.Render("  manual sync allowed")
+		}
+		add(line)
+	}
+
+	// Apps of this project that cannot be synced right now, as evaluated when the project loaded
+	var blocked []string
+	for _, app := range m.state.Apps {
+		if app.Project == nil || *app.Project != p.Name {
+			continue
+		}
+		if m.syncWindowBlock(app).Blocked {
+			blocked = append(blocked, app.Name)
+		}
+	}
+	if len(blocked) > 0 {
+		sortStrings(blocked)
+		add("")
+		add(lipgloss.NewStyle().Foreground(outOfSyncColor).Render(fmt.Sprintf("Sync blocked for %d app(s): %s", len(blocked), strings.Join(blocked, ", "))))
+	}
+	return lines
+}
+
+// projectDestinationCluster shows a destination by cluster name, falling back to the server URL
+func projectDestinationCluster(d model.ProjectDestination) string {
+	if d.Name != "" {
+		return d.Name
+	}
+	return d.Server
+}
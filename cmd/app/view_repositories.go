@@ -0,0 +1,93 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+// repositoryColumnWidths splits the content width between the repositories columns.
+// Narrow terminals only get URL, STATUS and APPS.
+func repositoryColumnWidths(availableWidth int) (url, repoType, project, status, apps, message int) {
+	status, apps = 11, 5
+	if availableWidth < 80 {
+		url = max(1, availableWidth-status-apps-2)
+		return
+	}
+	repoType, project = 4, 14
+	url = max(24, (availableWidth-repoType-project-status-apps-5)/2)
+	message = max(0, availableWidth-url-repoType-project-status-apps-5)
+	return
+}
+
+// renderRepositoryHeader renders the repositories table header
+func (m *Model) renderRepositoryHeader() string {
+	contentWidth := m.contentInnerWidth()
+	urlW, typeW, projectW, statusW, appsW, msgW := repositoryColumnWidths(contentWidth)
+	cells := []string{padRight("URL", urlW)}
+	if typeW > 0 {
+		cells = append(cells, padRight("TYPE", typeW), padRight("PROJECT", projectW))
+	}
+	cells = append(cells, padRight("STATUS", statusW), padLeft("APPS", appsW))
+	if msgW > 0 {
+		cells = append(cells, padRight("MESSAGE", msgW))
+	}
+	return padRight(clipAnsiToWidth(headerStyle.Render(strings.Join(cells, " ")), contentWidth), contentWidth)
+}
+
+// renderRepositoryRow renders one repository with its connection state and how many apps use it
+func (m *Model) renderRepositoryRow(repoURL string, isCursor bool) string {
+	contentWidth := m.contentInnerWidth()
+	urlW, typeW, projectW, statusW, appsW, msgW := repositoryColumnWidths(contentWidth)
+
+	repo, registered := m.registeredRepository(repoURL)
+	refreshing := registered && m.state.Repositories.Refreshing[model.NormalizeRepoURL(repo.URL)]
+
+	style := func(st lipgloss.Style, s string) string {
+		if isCursor {
+			// Active row: avoid inner color styles so background highlight spans the whole row
+			return s
+		}
+		return st.Render(s)
+	}
+
+	statusText, statusColor, message := "-", unknownColor, repo.ConnectionMessage
+	switch {
+	case !registered:
+		message = "not registered in ArgoCD"
+	case refreshing:
+		statusText, statusColor = "Checking…", progressColor
+	case repo.ConnectionStatus == "Successful":
+		statusText, statusColor = repo.ConnectionStatus, syncedColor
+	case repo.ConnectionStatus == "Failed":
+		statusText, statusColor = repo.ConnectionStatus, outOfSyncColor
+	default:
+		statusText = repo.ConnectionStatus
+	}
+	message = strings.Join(strings.Fields(message), " ")
+
+	cells := []string{padRight(truncateWithEllipsis(repoURL, urlW), urlW)}
+	if typeW > 0 {
+		cells = append(cells,
+			padRight(truncateWithEllipsis(repo.Type, typeW), typeW),
+			padRight(truncateWithEllipsis(repo.Project, projectW), projectW))
+	}
+	cells = append(cells,
+		padRight(style(lipgloss.NewStyle().Foreground(statusColor), statusText), statusW),
+		padLeft(fmt.Sprintf("%d", m.repositoryAppCount(repoURL)), appsW))
+	if msgW > 0 {
+		msgStyle := lipgloss.NewStyle().Foreground(dimColor)
+		if repo.ConnectionStatus == "Failed" {
+			msgStyle = lipgloss.NewStyle().Foreground(outOfSyncColor)
+		}
+		cells = append(cells, padRight(style(msgStyle, truncateWithEllipsis(message, msgW)), msgW))
+	}
+
+	row := padRight(clipAnsiToWidth(strings.Join(cells, " "), contentWidth), contentWidth)
+	if isCursor {
+		row = selectedStyle.Render(row)
+	}
+	return row
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+)
+
+// renderResourceDiffsView renders the changed resources of an app diff as a selectable list
+func (m *Model) renderResourceDiffsView() string {
+	state := m.state.ResourceDiffs
+	if state == nil {
+		return contentBorderStyle.Render("No diff loaded")
+	}
+	items := state.Items
+
+	// Width should account for main container padding (2) and content border padding (2)
+	contentWidth := max(0, m.state.Terminal.Cols-4)
+	innerWidth := max(1, contentWidth-4)
+
+	const (
+		kindW    = 22
+		changesW = 13
+	)
+	namespaceW := min(24, max(10, innerWidth/5))
+	nameW := max(10, innerWidth-kindW-namespaceW-changesW-3)
+
+	pad := func(s string, w int) string {
+		s = truncateWithEllipsis(s, w)
+		if d := w - lipgloss.Width(s); d > 0 {
+			s += strings.Repeat(" ", d)
+		}
+		return s
+	}
+
+	header := strings.Join([]string{pad("KIND", kindW), pad("NAMESPACE", namespaceW), pad("NAME", nameW), "CHANGES"}, " ")
+	bodyLines := []string{lipgloss.NewStyle().Foreground(yellowBright).Bold(true).Render(header)}
+
+	m.resourceDiffNav.SetItemCount(len(items))
+	m.resourceDiffNav.SetViewportHeight(m.resourceDiffsPageSize())
+	m.resourceDiffNav.SetCursor(state.SelectedIdx)
+	start := m.resourceDiffNav.ScrollOffset()
+	end := min(len(items), start+m.resourceDiffsPageSize())
+
+	addedStyle := lipgloss.NewStyle().Foreground(syncedColor)
+	removedStyle := lipgloss.NewStyle().Foreground(outOfSyncColor)
+	dimStyle := lipgloss.NewStyle().Foreground(dimColor)
+	for i := start; i < end; i++ {
+		item := items[i]
+		changes := addedStyle.Render(fmt.Sprintf("+%d", item.Added)) + " " + removedStyle.Render(fmt.Sprintf("-%d", item.Removed))
+		if item.Synced {
+			changes += " " + dimStyle.Render("synced")
+		}
+		row := strings.Join([]string{pad(item.Kind, kindW), pad(item.Namespace, namespaceW), pad(item.Name, nameW), changes}, " ")
+		row = padRight(clipAnsiToWidth(row, innerWidth), innerWidth)
+		if i == state.SelectedIdx {
+			row = selectedStyle.Render(stripANSI(row))
+		}
+		bodyLines = append(bodyLines, row)
+	}
+
+	title := headerStyle.Render(fmt.Sprintf("Diff · %s · %d changed resources", state.AppName, len(items)))
+	status := statusStyle.Render(fmt.Sprintf("%d/%d  j/k, enter open, n/p next/prev diff, a all, s sync resource, esc/q back",
+		min(state.SelectedIdx+1, len(items)), len(items)))
+
+	content := contentBorderStyle.Width(contentWidth).Render(strings.Join(bodyLines, "\n"))
+
+	var sections []string
+	sections = append(sections, title)
+	sections = append(sections, content)
+	sections = append(sections, status)
+
+	return mainContainerStyle.Width(m.state.Terminal.Cols).Render(strings.Join(sections, "\n"))
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+)
+
+// renderResourceEditView renders the review screen for a resource edited in $EDITOR
+func (m *Model) renderResourceEditView() string {
+	edit := m.state.ResourceEdit
+	if edit == nil {
+		return contentBorderStyle.Render("No edit in progress")
+	}
+	lines := edit.Diff
+	if len(lines) == 0 {
+		lines = []string{statusStyle.Render("No changes to preview")}
+	}
+
+	contentHeight := m.resourceEditPageSize()
+	maxOffset := max(0, len(lines)-contentHeight)
+	edit.Offset = min(max(0, edit.Offset), maxOffset)
+	start := edit.Offset
+	end := min(len(lines), start+contentHeight)
+	body := strings.Join(lines[start:end], "\n")
+
+	resource := edit.Target.Kind + "/" + edit.Target.Name
+	if edit.Target.Namespace != "" {
+		resource = edit.Target.Namespace + "/" + resource
+	}
+	title := headerStyle.Render("Edit " + resource + " - review changes")
+
+	// Width should account for main container padding (2) and content border padding (2)
+	contentWidth := max(0, m.state.Terminal.Cols-4)
+	content := contentBorderStyle.Width(contentWidth).Render(body)
+
+	var feedback string
+	switch {
+	case edit.Applying:
+		feedback = fmt.Sprintf("%s %s", m.spinner.View(), statusStyle.Render("Applying patch…"))
+	case edit.Error != "":
+		feedback = lipgloss.NewStyle().Foreground(outOfSyncColor).Render(truncateWithEllipsis("Error: "+edit.Error, contentWidth))
+	default:
+		feedback = statusStyle.Render("Changes are applied as a merge patch to the live resource")
+	}
+
+	keys := "y apply, e edit again, esc discard"
+	if edit.Patch == "" {
+		keys = "e edit again, esc discard"
+	}
+	status := statusStyle.Render(fmt.Sprintf("%d-%d/%d  j/k, g/G, %s", min(start+1, end), end, len(edit.Diff), keys))
+
+	var sections []string
+	sections = append(sections, title)
+	sections = append(sections, content)
+	sections = append(sections, feedback)
+	sections = append(sections, status)
+
+	return mainContainerStyle.Width(m.state.Terminal.Cols).Render(strings.Join(sections, "\n"))
+}
@@ -30,6 +30,32 @@ func (m *Model) renderStatusLine() string {
 		} else {
 			leftText = fmt.Sprintf("<apps in %s>", appsetName)
 		}
+	} else if m.state.Navigation.View == model.ViewApps && len(m.state.Selections.ScopeRepos) > 0 {
+		// Same breadcrumb for the repository drill-down
+		repoURLs := make([]string, 0, len(m.state.Selections.ScopeRepos))
+		for repoURL := range m.state.Selections.ScopeRepos {
+			repoURLs = append(repoURLs, repoURL)
+		}
+		sort.Strings(repoURLs)
+		if m.state.UI.ActiveFilter != "" {
+			leftText = fmt.Sprintf("<apps in %s:%s>", repoURLs[0], m.state.UI.ActiveFilter)
+		} else {
+			leftText = fmt.Sprintf("<apps in %s>", repoURLs[0])
+		}
+	} else if m.state.Navigation.View == model.ViewApps && len(m.state.Selections.ScopeLabels) > 0 {
+		// And for the label drill-down
+		selectors := make([]string, 0, len(m.state.Selections.ScopeLabels))
+		for selector := range m.state.Selections.ScopeLabels {
+			selectors = append(selectors, selector)
+		}
+		sort.Strings(selectors)
+		if m.state.UI.ActiveFilter != "" {
+			leftText = fmt.Sprintf("<apps in %s:%s>", selectors[0], m.state.UI.ActiveFilter)
+		} else {
+			leftText = fmt.Sprintf("<apps in %s>", selectors[0])
+		}
+	} else if m.state.Navigation.View == model.ViewLabels && m.state.Selections.LabelKey != "" {
+		leftText = fmt.Sprintf("<labels:%s>", m.state.Selections.LabelKey)
 	} else if m.state.UI.ActiveFilter != "" && m.state.Navigation.View == model.ViewApps {
 		leftText = fmt.Sprintf("<%s:%s>", m.state.Navigation.View, m.state.UI.ActiveFilter)
 	}
@@ -65,7 +91,10 @@ func (m *Model) renderStatusLine() string {
 	var rightText string
 
 	// Get upgrade notification text based on screen width
-	if m.state.UI.IsVersionOutdated && m.shouldShowUpgradeNotification() {
+	if warning := m.tokenExpiryWarning(time.Now()); warning != "" {
+		// An expiring token takes priority: the next request after expiry needs a login
+		rightText = lipgloss.NewStyle().Foreground(yellowBright).Render(warning) + " • "
+	} else if m.state.UI.IsVersionOutdated && m.shouldShowUpgradeNotification() {
 		available := max(0, m.state.Terminal.Cols-2)
 
 		// Progressive text shortening based on available space
@@ -0,0 +1,166 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"charm.land/lipgloss/v2"
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+// renderSyncProgressView renders the sync operation progress view (full screen, like the events view)
+func (m *Model) renderSyncProgressView() string {
+	progress := m.state.SyncProgress
+	if progress == nil {
+		return contentBorderStyle.Render("No sync operation")
+	}
+	var rows []model.SyncResourceResult
+	if progress.Operation != nil {
+		rows = sortedSyncResults(progress.Operation.Resources)
+	}
+
+	contentHeight := m.syncProgressPageSize()
+	maxOffset := max(0, len(rows)-contentHeight)
+	progress.Offset = min(max(0, progress.Offset), maxOffset)
+	start := progress.Offset
+	end := min(len(rows), start+contentHeight)
+
+	// Width should account for main container padding (2) and content border padding (2)
+	contentWidth := max(0, m.state.Terminal.Cols-4)
+	innerWidth := max(1, contentWidth-4)
+
+	const (
+		phaseW  = 8
+		waveW   = 4
+		hookW   = 8
+		statusW = 12
+	)
+	kindW := min(24, max(12, innerWidth/8))
+	nameW := min(40, max(16, innerWidth/4))
+	messageW := max(10, innerWidth-(phaseW+waveW+kindW+nameW+hookW+statusW+6))
+
+	pad := func(s string, w int) string {
+		s = truncateWithEllipsis(s, w)
+		if d := w - lipgloss.Width(s); d > 0 {
+			s += strings.Repeat(" ", d)
+		}
+		return s
+	}
+	padLeft := func(s string, w int) string {
+		s = truncateWithEllipsis(s, w)
+		if d := w - lipgloss.Width(s); d > 0 {
+			s = strings.Repeat(" ", d) + s
+		}
+		return s
+	}
+
+	now := time.Now()
+	dim := lipgloss.NewStyle().Foreground(dimColor)
+	var bodyLines []string
+
+	// Summary: operation phase, elapsed time, revision and how many resources have a result
+	if op := progress.Operation; op != nil {
+		summary := syncResultStyle(op.Phase).Bold(true).Render(op.Phase) +
+			dim.Render(" • elapsed ") + formatSyncElapsed(op.Elapsed(now))
+		if op.Revision != "" {
+			summary += dim.Render(" • revision ") + shortRevision(op.Revision)
+		}
+		summary += dim.Render(fmt.Sprintf(" • %d resource(s)", len(op.Resources)))
+		bodyLines = append(bodyLines, summary)
+		message := strings.Join(strings.Fields(op.Message), " ")
+		bodyLines = append(bodyLines, dim.Render(truncateWithEllipsis(message, innerWidth)))
+	} else {
+		placeholder := "Waiting for the operation to start…"
+		switch {
+		case progress.Error != "":
+			placeholder = "Error: " + progress.Error
+		case progress.Loading:
+			placeholder = "Loading operation…"
+		case !progress.Loading && progress.IgnoreBefore == nil:
+			placeholder = "No operation has run for this app"
+		}
+		bodyLines = append(bodyLines, statusStyle.Render(placeholder), "")
+	}
+
+	header := strings.Join([]string{
+		pad("PHASE", phaseW), padLeft("WAVE", waveW), pad("KIND", kindW), pad("NAME", nameW),
+		pad("HOOK", hookW), pad("STATUS", statusW), "MESSAGE",
+	}, " ")
+	bodyLines = append(bodyLines, lipgloss.NewStyle().Foreground(yellowBright).Bold(true).Render(header))
+
+	for _, r := range rows[start:end] {
+		status := r.Status
+		if r.HookType != "" && r.HookPhase != "" {
+			// Hooks report their progress via the hook phase
+			status = r.HookPhase
+		}
+		name := r.Name
+		if r.Namespace != "" {
+			name = r.Namespace + "/" + r.Name
+		}
+		message := strings.Join(strings.Fields(r.Message), " ")
+		cols := []string{
+			pad(r.SyncPhase, phaseW),
+			padLeft(fmt.Sprintf("%d", r.Wave), waveW),
+			pad(r.Kind, kindW),
+			pad(name, nameW),
+			pad(r.HookType, hookW),
+			syncResultStyle(status).Render(pad(status, statusW)),
+			truncateWithEllipsis(message, messageW),
+		}
+		bodyLines = append(bodyLines, strings.Join(cols, " "))
+	}
+	if len(rows) == 0 && progress.Operation != nil {
+		bodyLines = append(bodyLines, statusStyle.Render("No resource results yet"))
+	}
+	body := strings.Join(bodyLines, "\n")
+
+	title := headerStyle.Render("Sync · " + progress.AppName)
+	status := statusStyle.Render(fmt.Sprintf("%d-%d/%d  j/k, g/G, r reload, esc/q back",
+		min(start+1, end), end, len(rows)))
+
+	content := contentBorderStyle.Width(contentWidth).Render(body)
+
+	var sections []string
+	sections = append(sections, title)
+	sections = append(sections, content)
+	sections = append(sections, status)
+
+	return mainContainerStyle.Width(m.state.Terminal.Cols).Render(strings.Join(sections, "\n"))
+}
+
+// syncResultStyle colors operation phases and per-resource sync/hook results
+func syncResultStyle(status string) lipgloss.Style {
+	switch status {
+	case "Succeeded", "Synced", "Pruned":
+		return lipgloss.NewStyle().Foreground(syncedColor)
+	case "Failed", "Error", "SyncFailed":
+		return lipgloss.NewStyle().Foreground(outOfSyncColor)
+	case "Running", "Terminating", "Pending":
+		return lipgloss.NewStyle().Foreground(progressColor)
+	default:
+		return lipgloss.NewStyle().Foreground(unknownColor)
+	}
+}
+
+// formatSyncElapsed formats an operation duration compactly (45s, 2m05s, 1h03m)
+func formatSyncElapsed(d time.Duration) string {
+	d = max(0, d).Round(time.Second)
+	switch {
+	case d < time.Minute:
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	case d < time.Hour:
+		return fmt.Sprintf("%dm%02ds", int(d.Minutes()), int(d.Seconds())%60)
+	default:
+		return fmt.Sprintf("%dh%02dm", int(d.Hours()), int(d.Minutes())%60)
+	}
+}
+
+// shortRevision shortens git SHAs for display; tags and branches are kept as-is
+func shortRevision(rev string) string {
+	if len(rev) == 40 && strings.Trim(rev, "0123456789abcdef") == "" {
+		return rev[:7]
+	}
+	return rev
+}
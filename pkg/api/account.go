@@ -0,0 +1,47 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+// AccountService provides ArgoCD account operations
+type AccountService struct {
+	client *Client
+}
+
+// NewAccountService creates a new account service
+func NewAccountService(server *model.Server) *AccountService {
+	return &AccountService{
+		client: NewClient(server),
+	}
+}
+
+// CanI asks ArgoCD whether the current user may perform action on resource (e.g. "applications").
+// subresource is matched like an RBAC policy object, e.g. "my-project/*" for all apps of a project.
+func (s *AccountService) CanI(ctx context.Context, resource, action, subresource string) (bool, error) {
+	// The object's own slashes separate path segments, so escape the parts between them
+	parts := strings.Split(subresource, "/")
+	for i, part := range parts {
+		parts[i] = url.PathEscape(part)
+	}
+	endpoint := fmt.Sprintf("/api/v1/account/can-i/%s/%s/%s",
+		url.PathEscape(resource), url.PathEscape(action), strings.Join(parts, "/"))
+	data, err := s.client.Get(ctx, endpoint)
+	if err != nil {
+		return false, fmt.Errorf("failed to check %s %s permission: %w", action, resource, err)
+	}
+
+	var resp struct {
+		Value string `json:"value"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return false, fmt.Errorf("failed to parse can-i response: %w", err)
+	}
+	return resp.Value == "yes", nil
+}
@@ -0,0 +1,38 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+func TestCanI(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.EscapedPath() {
+		case "/api/v1/account/can-i/applications/sync/team-a/%2A", "/api/v1/account/can-i/applications/sync/team-a/my%20app%3F":
+			fmt.Fprint(w, `{"value": "yes"}`)
+		case "/api/v1/account/can-i/applications/action%2Fapps%2FDeployment%2Frestart/team-a/%2A":
+			fmt.Fprint(w, `{"value": "no"}`)
+		default:
+			t.Errorf("Unexpected path %s", r.URL.EscapedPath())
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	account := NewAccountService(&model.Server{BaseURL: server.URL, Token: "test-token"})
+	if allowed, err := account.CanI(context.Background(), "applications", "sync", "team-a/*"); err != nil || !allowed {
+		t.Errorf("Expected sync to be allowed, got %v (%v)", allowed, err)
+	}
+	if allowed, err := account.CanI(context.Background(), "applications", "sync", "team-a/my app?"); err != nil || !allowed {
+		t.Errorf("Expected the app name to be escaped, got %v (%v)", allowed, err)
+	}
+	action := model.ResourceActionRBAC("apps", "Deployment", "restart")
+	if allowed, err := account.CanI(context.Background(), "applications", action, "team-a/*"); err != nil || allowed {
+		t.Errorf("Expected restart to be denied, got %v (%v)", allowed, err)
+	}
+}
@@ -25,9 +25,11 @@ type OwnerReference struct {
 // ArgoApplication represents an ArgoCD application from the API
 type ArgoApplication struct {
 	Metadata struct {
-		Name            string           `json:"name"`
-		Namespace       string           `json:"namespace,omitempty"`
-		OwnerReferences []OwnerReference `json:"ownerReferences,omitempty"`
+		Name            string            `json:"name"`
+		Namespace       string            `json:"namespace,omitempty"`
+		OwnerReferences []OwnerReference  `json:"ownerReferences,omitempty"`
+		Labels          map[string]string `json:"labels,omitempty"`
+		Annotations     map[string]string `json:"annotations,omitempty"`
 	} `json:"metadata"`
 	Spec struct {
 		Project string `json:"project,omitempty"`
@@ -36,18 +38,21 @@ type ArgoApplication struct {
 			RepoURL        string `json:"repoURL,omitempty"`
 			Path           string `json:"path,omitempty"`
 			TargetRevision string `json:"targetRevision,omitempty"`
+			Chart          string `json:"chart,omitempty"`
 		} `json:"source,omitempty"`
 		// Multiple sources (newer multi-source support)
 		Sources []struct {
 			RepoURL        string `json:"repoURL,omitempty"`
 			Path           string `json:"path,omitempty"`
 			TargetRevision string `json:"targetRevision,omitempty"`
+			Chart          string `json:"chart,omitempty"`
 		} `json:"sources,omitempty"`
 		Destination struct {
 			Name      string `json:"name,omitempty"`
 			Server    string `json:"server,omitempty"`
 			Namespace string `json:"namespace,omitempty"`
 		} `json:"destination"`
+		SyncPolicy *SyncPolicy `json:"syncPolicy,omitempty"`
 	} `json:"spec"`
 	Status struct {
 		Sync struct {
@@ -71,16 +76,75 @@ type ArgoApplication struct {
 			Status  string `json:"status,omitempty"`
 			Message string `json:"message,omitempty"`
 		} `json:"health"`
-		OperationState struct {
-			Phase      string    `json:"phase,omitempty"`
-			StartedAt  time.Time `json:"startedAt,omitempty"`
-			FinishedAt time.Time `json:"finishedAt,omitempty"`
-		} `json:"operationState,omitempty"`
-		History   []DeploymentHistory `json:"history,omitempty"`
-		Resources []ResourceStatus    `json:"resources,omitempty"`
+		OperationState OperationState      `json:"operationState,omitempty"`
+		History        []DeploymentHistory `json:"history,omitempty"`
+		Resources      []ResourceStatus    `json:"resources,omitempty"`
+		Conditions     []AppCondition      `json:"conditions,omitempty"`
 	} `json:"status"`
 }
 
+// SyncPolicy controls when and how an application is synced
+type SyncPolicy struct {
+	Automated   *AutomatedSyncPolicy `json:"automated,omitempty"`
+	SyncOptions []string             `json:"syncOptions,omitempty"`
+}
+
+// AutomatedSyncPolicy is present when automated sync is enabled
+type AutomatedSyncPolicy struct {
+	Prune      bool `json:"prune"`
+	SelfHeal   bool `json:"selfHeal"`
+	AllowEmpty bool `json:"allowEmpty"`
+}
+
+// AppCondition is an entry of status.conditions (ComparisonError, SyncError, OrphanedResourceWarning, ...)
+type AppCondition struct {
+	Type               string     `json:"type"`
+	Message            string     `json:"message,omitempty"`
+	LastTransitionTime *time.Time `json:"lastTransitionTime,omitempty"`
+}
+
+// OperationState is the state of an application's current or most recent operation
+type OperationState struct {
+	Phase      string               `json:"phase,omitempty"` // Running, Terminating, Succeeded, Failed, Error
+	Message    string               `json:"message,omitempty"`
+	StartedAt  time.Time            `json:"startedAt,omitempty"`
+	FinishedAt time.Time            `json:"finishedAt,omitempty"`
+	SyncResult *SyncOperationResult `json:"syncResult,omitempty"`
+	Operation  *Operation           `json:"operation,omitempty"`
+}
+
+// Operation is the requested operation, including who initiated it
+type Operation struct {
+	InitiatedBy struct {
+		Username  string `json:"username,omitempty"`
+		Automated bool   `json:"automated,omitempty"`
+	} `json:"initiatedBy"`
+	Sync *struct {
+		Revision  string   `json:"revision,omitempty"`
+		Revisions []string `json:"revisions,omitempty"`
+	} `json:"sync,omitempty"`
+}
+
+// SyncOperationResult is the result of a sync operation
+type SyncOperationResult struct {
+	Revision  string           `json:"revision,omitempty"`
+	Resources []ResourceResult `json:"resources,omitempty"`
+}
+
+// ResourceResult is the result of syncing a single resource
+type ResourceResult struct {
+	Group     string `json:"group,omitempty"`
+	Version   string `json:"version,omitempty"`
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	Status    string `json:"status,omitempty"` // Synced, SyncFailed, Pruned, PruneSkipped
+	Message   string `json:"message,omitempty"`
+	HookType  string `json:"hookType,omitempty"`  // PreSync, Sync, PostSync, SyncFail, Skip; empty for regular resources
+	HookPhase string `json:"hookPhase,omitempty"` // Running, Succeeded, Failed, Error, Terminating
+	SyncPhase string `json:"syncPhase,omitempty"` // PreSync, Sync, PostSync, SyncFail
+}
+
 // ApplicationWatchEvent represents an event from the watch stream
 type ApplicationWatchEvent struct {
 	Type        string          `json:"type"`
@@ -107,11 +171,16 @@ var AppListFields = []string{
 	"items.metadata.name",
 	"items.metadata.namespace",
 	"items.metadata.ownerReferences",
+	"items.metadata.labels",
+	"items.metadata.annotations",
 	"items.spec",
 	"items.status.sync.status",
+	"items.status.sync.revision",
+	"items.status.sync.revisions",
 	"items.status.health",
 	"items.status.operationState.finishedAt",
 	"items.status.operationState.startedAt",
+	"items.status.operationState.phase",
 }
 
 // AppWatchFields is intentionally empty — the stream endpoint does not support
@@ -299,6 +368,10 @@ func (s *ApplicationService) SyncApplication(ctx context.Context, appName string
 		"appNamespace": opts.AppNamespace,
 	}
 
+	if opts.Revision != "" {
+		reqBody["revision"] = opts.Revision
+	}
+
 	// Add resources array if provided (for selective resource sync)
 	if len(opts.Resources) > 0 {
 		reqBody["resources"] = opts.Resources
@@ -313,6 +386,17 @@ func (s *ApplicationService) SyncApplication(ctx context.Context, appName string
 		}
 	}
 
+	// Sync options are sent as Key=value items, same as spec.syncPolicy.syncOptions
+	if items := opts.syncOptionItems(); len(items) > 0 {
+		reqBody["syncOptions"] = map[string]interface{}{
+			"items": items,
+		}
+	}
+
+	if opts.Retry != nil {
+		reqBody["retryStrategy"] = opts.Retry
+	}
+
 	path := fmt.Sprintf("/api/v1/applications/%s/sync", url.PathEscape(appName))
 	if opts.AppNamespace != "" {
 		path += "?appNamespace=" + url.QueryEscape(opts.AppNamespace)
@@ -450,7 +534,6 @@ func (s *ApplicationService) WatchApplicationsWithOptions(ctx context.Context, e
 	}
 }
 
-// SyncOptions represents options for syncing an application
 // SyncResourceTarget represents a specific resource to sync
 type SyncResourceTarget struct {
 	Group     string `json:"group"`
@@ -459,12 +542,59 @@ type SyncResourceTarget struct {
 	Namespace string `json:"namespace"`
 }
 
+// SyncOptions represents options for syncing an application
 type SyncOptions struct {
 	Prune        bool                 `json:"prune,omitempty"`
 	DryRun       bool                 `json:"dryRun,omitempty"`
 	Force        bool                 `json:"force,omitempty"`
 	AppNamespace string               `json:"appNamespace,omitempty"`
 	Resources    []SyncResourceTarget `json:"resources,omitempty"`
+	// Revision to sync to instead of the app's target revision
+	Revision                 string         `json:"revision,omitempty"`
+	ApplyOutOfSyncOnly       bool           `json:"applyOutOfSyncOnly,omitempty"`
+	ServerSideApply          bool           `json:"serverSideApply,omitempty"`
+	Replace                  bool           `json:"replace,omitempty"`
+	PruneLast                bool           `json:"pruneLast,omitempty"`
+	RespectIgnoreDifferences bool           `json:"respectIgnoreDifferences,omitempty"`
+	CreateNamespace          bool           `json:"createNamespace,omitempty"`
+	Retry                    *RetryStrategy `json:"retry,omitempty"`
+}
+
+// RetryStrategy controls how ArgoCD retries a failed sync
+type RetryStrategy struct {
+	Limit   int64    `json:"limit"` // negative means unlimited
+	Backoff *Backoff `json:"backoff,omitempty"`
+}
+
+// Backoff is the retry backoff for a sync; unset fields use ArgoCD defaults
+type Backoff struct {
+	Duration    string `json:"duration,omitempty"`
+	Factor      *int64 `json:"factor,omitempty"`
+	MaxDuration string `json:"maxDuration,omitempty"`
+}
+
+// syncOptionItems returns the enabled options in ArgoCD's Key=value form
+func (o *SyncOptions) syncOptionItems() []string {
+	var items []string
+	if o.ApplyOutOfSyncOnly {
+		items = append(items, "ApplyOutOfSyncOnly=true")
+	}
+	if o.ServerSideApply {
+		items = append(items, "ServerSideApply=true")
+	}
+	if o.Replace {
+		items = append(items, "Replace=true")
+	}
+	if o.PruneLast {
+		items = append(items, "PruneLast=true")
+	}
+	if o.RespectIgnoreDifferences {
+		items = append(items, "RespectIgnoreDifferences=true")
+	}
+	if o.CreateNamespace {
+		items = append(items, "CreateNamespace=true")
+	}
+	return items
 }
 
 // ConvertToApp converts an ArgoApplication to our model.App
@@ -487,6 +617,8 @@ func (s *ApplicationService) ConvertToApp(argoApp ArgoApplication) model.App {
 	if argoApp.Spec.Destination.Namespace != "" {
 		app.Namespace = &argoApp.Spec.Destination.Namespace
 	}
+	app.DestinationServer = argoApp.Spec.Destination.Server
+	app.DestinationName = argoApp.Spec.Destination.Name
 
 	// Extract cluster info preferring destination.name, else from destination.server host
 	if argoApp.Spec.Destination.Name != "" || argoApp.Spec.Destination.Server != "" {
@@ -496,19 +628,8 @@ func (s *ApplicationService) ConvertToApp(argoApp ArgoApplication) model.App {
 			id = argoApp.Spec.Destination.Name
 			label = id
 		} else {
-			server := argoApp.Spec.Destination.Server
-			if server == "https://kubernetes.default.svc" {
-				id = "in-cluster"
-				label = id
-			} else {
-				if u, err := url.Parse(server); err == nil && u.Host != "" {
-					id = u.Host
-					label = u.Host
-				} else {
-					id = server
-					label = server
-				}
-			}
+			id = model.ClusterLabelForServer(argoApp.Spec.Destination.Server)
+			label = id
 		}
 		app.ClusterID = &id
 		app.ClusterLabel = &label
@@ -521,6 +642,36 @@ func (s *ApplicationService) ConvertToApp(argoApp ArgoApplication) model.App {
 		app.LastSyncAt = &argoApp.Status.OperationState.StartedAt
 	}
 
+	app.OperationPhase = argoApp.Status.OperationState.Phase
+	if policy := argoApp.Spec.SyncPolicy; policy != nil && policy.Automated != nil {
+		app.AutoSync = &model.AutoSync{
+			Prune:      policy.Automated.Prune,
+			SelfHeal:   policy.Automated.SelfHeal,
+			AllowEmpty: policy.Automated.AllowEmpty,
+		}
+	}
+	app.Labels = argoApp.Metadata.Labels
+	app.Annotations = argoApp.Metadata.Annotations
+
+	// Source repositories: either the single source or every entry of a multi-source app
+	if src := argoApp.Spec.Source; src != nil && src.RepoURL != "" {
+		app.RepoURLs = append(app.RepoURLs, src.RepoURL)
+	}
+	for _, src := range argoApp.Spec.Sources {
+		if src.RepoURL != "" {
+			app.RepoURLs = append(app.RepoURLs, src.RepoURL)
+		}
+	}
+	if src := argoApp.Spec.Source; src != nil {
+		app.SourcePath, app.TargetRevision = src.Path, src.TargetRevision
+	} else if len(argoApp.Spec.Sources) > 0 {
+		app.SourcePath, app.TargetRevision = argoApp.Spec.Sources[0].Path, argoApp.Spec.Sources[0].TargetRevision
+	}
+	app.SyncedRevision = argoApp.Status.Sync.Revision
+	if app.SyncedRevision == "" && len(argoApp.Status.Sync.Revisions) > 0 {
+		app.SyncedRevision = argoApp.Status.Sync.Revisions[0]
+	}
+
 	// Extract ApplicationSet from ownerReferences
 	for _, ref := range argoApp.Metadata.OwnerReferences {
 		if ref.Kind == "ApplicationSet" {
@@ -540,6 +691,83 @@ func (s *ApplicationService) ConvertToApp(argoApp ArgoApplication) model.App {
 	return app
 }
 
+// ConvertSyncOperation extracts the app's current or most recent sync operation,
+// or nil when it has none. Sync waves are taken from status.resources because
+// operation results do not carry them.
+func (s *ApplicationService) ConvertSyncOperation(argoApp ArgoApplication) *model.SyncOperation {
+	state := argoApp.Status.OperationState
+	if state.Phase == "" {
+		return nil
+	}
+	op := &model.SyncOperation{
+		Phase:      state.Phase,
+		Message:    state.Message,
+		StartedAt:  state.StartedAt,
+		FinishedAt: state.FinishedAt,
+	}
+	if state.Operation != nil {
+		op.InitiatedBy = state.Operation.InitiatedBy.Username
+		op.Automated = state.Operation.InitiatedBy.Automated
+	}
+	if state.SyncResult == nil {
+		return op
+	}
+	op.Revision = state.SyncResult.Revision
+
+	waves := make(map[string]int64, len(argoApp.Status.Resources))
+	for _, r := range argoApp.Status.Resources {
+		waves[r.Group+"/"+r.Kind+"/"+r.Namespace+"/"+r.Name] = r.SyncWave
+	}
+	op.Resources = make([]model.SyncResourceResult, 0, len(state.SyncResult.Resources))
+	for _, r := range state.SyncResult.Resources {
+		op.Resources = append(op.Resources, model.SyncResourceResult{
+			Group:     r.Group,
+			Kind:      r.Kind,
+			Namespace: r.Namespace,
+			Name:      r.Name,
+			SyncPhase: r.SyncPhase,
+			Wave:      waves[r.Group+"/"+r.Kind+"/"+r.Namespace+"/"+r.Name],
+			HookType:  r.HookType,
+			HookPhase: r.HookPhase,
+			Status:    r.Status,
+			Message:   r.Message,
+		})
+	}
+	return op
+}
+
+// ConvertToAppDetail converts an application fetched with GetApplication to the
+// app detail view model
+func (s *ApplicationService) ConvertToAppDetail(argoApp ArgoApplication) model.AppDetail {
+	detail := model.AppDetail{
+		App:                  s.ConvertToApp(argoApp),
+		DestinationServer:    argoApp.Spec.Destination.Server,
+		DestinationName:      argoApp.Spec.Destination.Name,
+		DestinationNamespace: argoApp.Spec.Destination.Namespace,
+		HealthMessage:        argoApp.Status.Health.Message,
+		Operation:            s.ConvertSyncOperation(argoApp),
+	}
+	if src := argoApp.Spec.Source; src != nil {
+		detail.Sources = append(detail.Sources, model.AppSource{RepoURL: src.RepoURL, Path: src.Path, TargetRevision: src.TargetRevision, Chart: src.Chart})
+	}
+	for _, src := range argoApp.Spec.Sources {
+		detail.Sources = append(detail.Sources, model.AppSource{RepoURL: src.RepoURL, Path: src.Path, TargetRevision: src.TargetRevision, Chart: src.Chart})
+	}
+	detail.MultiSource = argoApp.HasMultipleSources()
+	if argoApp.Status.Sync.Revision != "" {
+		detail.SyncedRevisions = []string{argoApp.Status.Sync.Revision}
+	} else {
+		detail.SyncedRevisions = argoApp.Status.Sync.Revisions
+	}
+	for _, c := range argoApp.Status.Conditions {
+		detail.Conditions = append(detail.Conditions, model.AppCondition{Type: c.Type, Message: c.Message})
+	}
+	if policy := argoApp.Spec.SyncPolicy; policy != nil {
+		detail.SyncOptions = policy.SyncOptions
+	}
+	return detail
+}
+
 // HasMultipleSources returns true if the application uses multiple sources
 func (app *ArgoApplication) HasMultipleSources() bool {
 	return len(app.Spec.Sources) > 0
@@ -550,6 +778,7 @@ func (app *ArgoApplication) GetPrimarySource() *struct {
 	RepoURL        string `json:"repoURL,omitempty"`
 	Path           string `json:"path,omitempty"`
 	TargetRevision string `json:"targetRevision,omitempty"`
+	Chart          string `json:"chart,omitempty"`
 } {
 	if app.Spec.Source != nil {
 		return app.Spec.Source
@@ -627,6 +856,7 @@ type ResourceStatus struct {
 	Status    string          `json:"status"` // Sync status: "Synced", "OutOfSync"
 	Version   string          `json:"version"`
 	Health    *ResourceHealth `json:"health,omitempty"`
+	SyncWave  int64           `json:"syncWave,omitempty"`
 }
 
 // GetResourceTree retrieves the resource tree for an application
@@ -788,6 +1018,41 @@ func (s *ApplicationService) GetApplication(ctx context.Context, name string, ap
 	return &app, nil
 }
 
+// GetManifests fetches the rendered manifests of an application at the given
+// revision. An empty revision returns the manifests of the current target revision.
+func (s *ApplicationService) GetManifests(ctx context.Context, name string, appNamespace *string, revision string) ([]string, error) {
+	if name == "" {
+		return nil, fmt.Errorf("application name is required")
+	}
+
+	params := url.Values{}
+	if revision != "" {
+		params.Set("revision", revision)
+	}
+	if appNamespace != nil && *appNamespace != "" {
+		params.Set("appNamespace", *appNamespace)
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/applications/%s/manifests", url.PathEscape(name))
+	if len(params) > 0 {
+		endpoint += "?" + params.Encode()
+	}
+
+	resp, err := s.client.Get(ctx, endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get manifests for %s at %s: %w", name, revision, err)
+	}
+
+	var result struct {
+		Manifests []string `json:"manifests"`
+	}
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to decode manifests response: %w", err)
+	}
+
+	return result.Manifests, nil
+}
+
 // RefreshOptions specifies options for refreshing an application
 type RefreshOptions struct {
 	Hard         bool    // If true, performs hard refresh (invalidates cache)
@@ -821,6 +1086,67 @@ func (s *ApplicationService) RefreshApplication(ctx context.Context, name string
 	return nil
 }
 
+// TerminateOperation terminates the currently running operation (e.g. a sync) of an application
+func (s *ApplicationService) TerminateOperation(ctx context.Context, name string, appNamespace *string) error {
+	if name == "" {
+		return fmt.Errorf("application name is required")
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/applications/%s/operation", url.PathEscape(name))
+	if appNamespace != nil && *appNamespace != "" {
+		endpoint += "?appNamespace=" + url.QueryEscape(*appNamespace)
+	}
+
+	_, err := s.client.Delete(ctx, endpoint)
+	if err != nil {
+		return fmt.Errorf("failed to terminate operation for application %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// PatchApplication applies a JSON merge patch to an application's manifest, e.g. to change
+// its sync policy
+func (s *ApplicationService) PatchApplication(ctx context.Context, name string, appNamespace *string, patch string) error {
+	if name == "" {
+		return fmt.Errorf("application name is required")
+	}
+	if patch == "" {
+		return fmt.Errorf("patch is required")
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/applications/%s", url.PathEscape(name))
+	body := map[string]interface{}{
+		"name":      name,
+		"patch":     patch,
+		"patchType": "merge",
+	}
+	if appNamespace != nil && *appNamespace != "" {
+		endpoint += "?appNamespace=" + url.QueryEscape(*appNamespace)
+		body["appNamespace"] = *appNamespace
+	}
+
+	if _, err := s.client.Patch(ctx, endpoint, body); err != nil {
+		return fmt.Errorf("failed to patch application %s: %w", name, err)
+	}
+	return nil
+}
+
+// AutoSyncPatch returns the merge patch that sets spec.syncPolicy.automated to the policy,
+// or removes it (disabling automated sync) when policy is nil
+func AutoSyncPatch(policy *model.AutoSync) string {
+	var automated interface{}
+	if policy != nil {
+		automated = AutomatedSyncPolicy{Prune: policy.Prune, SelfHeal: policy.SelfHeal, AllowEmpty: policy.AllowEmpty}
+	}
+	patch, _ := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"syncPolicy": map[string]interface{}{"automated": automated},
+		},
+	})
+	return string(patch)
+}
+
 // GetRevisionMetadata fetches git metadata for a specific revision
 func (s *ApplicationService) GetRevisionMetadata(ctx context.Context, name string, revision string, appNamespace *string) (*model.RevisionMetadata, error) {
 	endpoint := fmt.Sprintf("/api/v1/applications/%s/revisions/%s/metadata", name, revision)
@@ -233,4 +233,50 @@ func TestDeleteApplication_NoCascade(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Expected no error, got %v", err)
 	}
-}
\ No newline at end of file
+}
+func TestTerminateOperation_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "DELETE" {
+			t.Errorf("Expected DELETE request, got %s", r.Method)
+		}
+		if r.URL.Path != "/api/v1/applications/test-app/operation" {
+			t.Errorf("Expected path /api/v1/applications/test-app/operation, got %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("appNamespace"); got != "team-a" {
+			t.Errorf("Expected appNamespace=team-a, got %s", got)
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	svc := NewApplicationService(&model.Server{
+		BaseURL: server.URL,
+		Token:   "test-token",
+	})
+
+	appNamespace := "team-a"
+	if err := svc.TerminateOperation(context.Background(), "test-app", &appNamespace); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func TestTerminateOperation_NoOperation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"error":   "Bad Request",
+			"message": "Unable to terminate operation. No operation is in progress",
+		})
+	}))
+	defer server.Close()
+
+	svc := NewApplicationService(&model.Server{
+		BaseURL: server.URL,
+		Token:   "test-token",
+	})
+
+	if err := svc.TerminateOperation(context.Background(), "test-app", nil); err == nil {
+		t.Fatal("Expected error when no operation is in progress")
+	}
+}
@@ -0,0 +1,41 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+func TestGetManifests_RevisionQuery(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/applications/test-app/manifests" {
+			t.Errorf("Expected path /api/v1/applications/test-app/manifests, got %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("revision"); got != "abc123" {
+			t.Errorf("Expected revision abc123, got %q", got)
+		}
+		if got := r.URL.Query().Get("appNamespace"); got != "team-a" {
+			t.Errorf("Expected appNamespace team-a, got %q", got)
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"manifests":["{\"kind\":\"ConfigMap\"}","{\"kind\":\"Service\"}"],"revision":"abc123"}`))
+	}))
+	defer server.Close()
+
+	svc := NewApplicationService(&model.Server{
+		BaseURL: server.URL,
+		Token:   "test-token",
+	})
+
+	ns := "team-a"
+	manifests, err := svc.GetManifests(context.Background(), "test-app", &ns, "abc123")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(manifests) != 2 || manifests[0] != `{"kind":"ConfigMap"}` {
+		t.Errorf("Unexpected manifests %v", manifests)
+	}
+}
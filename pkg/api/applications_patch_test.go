@@ -0,0 +1,55 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+func TestPatchApplication_RequestBody(t *testing.T) {
+	var body map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("Expected PATCH request, got %s", r.Method)
+		}
+		if r.URL.Path != "/api/v1/applications/test-app" {
+			t.Errorf("Expected path /api/v1/applications/test-app, got %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("appNamespace"); got != "team-a" {
+			t.Errorf("Expected appNamespace team-a, got %q", got)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Failed to decode body: %v", err)
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	svc := NewApplicationService(&model.Server{
+		BaseURL: server.URL,
+		Token:   "test-token",
+	})
+
+	ns := "team-a"
+	if err := svc.PatchApplication(context.Background(), "test-app", &ns, AutoSyncPatch(nil)); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if body["name"] != "test-app" || body["patchType"] != "merge" || body["appNamespace"] != "team-a" {
+		t.Errorf("Unexpected request body %v", body)
+	}
+	if body["patch"] != `{"spec":{"syncPolicy":{"automated":null}}}` {
+		t.Errorf("Expected the patch as a JSON string, got %v", body["patch"])
+	}
+}
+
+func TestAutoSyncPatch(t *testing.T) {
+	want := `{"spec":{"syncPolicy":{"automated":{"prune":false,"selfHeal":true,"allowEmpty":false}}}}`
+	if got := AutoSyncPatch(&model.AutoSync{SelfHeal: true}); got != want {
+		t.Errorf("Expected %s, got %s", want, got)
+	}
+}
@@ -0,0 +1,144 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+func TestSyncApplication_RequestBody(t *testing.T) {
+	var body map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("Expected POST request, got %s", r.Method)
+		}
+		if r.URL.Path != "/api/v1/applications/test-app/sync" {
+			t.Errorf("Expected path /api/v1/applications/test-app/sync, got %s", r.URL.Path)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Failed to decode body: %v", err)
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	svc := NewApplicationService(&model.Server{
+		BaseURL: server.URL,
+		Token:   "test-token",
+	})
+
+	factor := int64(2)
+	err := svc.SyncApplication(context.Background(), "test-app", &SyncOptions{
+		Prune:           true,
+		Revision:        "v1.2.0",
+		ServerSideApply: true,
+		PruneLast:       true,
+		CreateNamespace: true,
+		Retry: &RetryStrategy{
+			Limit:   3,
+			Backoff: &Backoff{Duration: "10s", Factor: &factor, MaxDuration: "3m"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if body["revision"] != "v1.2.0" {
+		t.Errorf("Expected revision v1.2.0, got %v", body["revision"])
+	}
+	if body["prune"] != true {
+		t.Errorf("Expected prune true, got %v", body["prune"])
+	}
+	items := body["syncOptions"].(map[string]interface{})["items"]
+	wantItems := []interface{}{"ServerSideApply=true", "PruneLast=true", "CreateNamespace=true"}
+	if !reflect.DeepEqual(items, wantItems) {
+		t.Errorf("Expected syncOptions items %v, got %v", wantItems, items)
+	}
+	wantRetry := map[string]interface{}{
+		"limit":   float64(3),
+		"backoff": map[string]interface{}{"duration": "10s", "factor": float64(2), "maxDuration": "3m"},
+	}
+	if !reflect.DeepEqual(body["retryStrategy"], wantRetry) {
+		t.Errorf("Expected retryStrategy %v, got %v", wantRetry, body["retryStrategy"])
+	}
+}
+
+func TestSyncApplication_DefaultsOmitOptionalFields(t *testing.T) {
+	var body map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&body)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	svc := NewApplicationService(&model.Server{
+		BaseURL: server.URL,
+		Token:   "test-token",
+	})
+
+	if err := svc.SyncApplication(context.Background(), "test-app", nil); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	for _, key := range []string{"revision", "syncOptions", "retryStrategy", "strategy"} {
+		if _, ok := body[key]; ok {
+			t.Errorf("Expected %s to be omitted, got %v", key, body[key])
+		}
+	}
+}
+
+func TestConvertSyncOperation(t *testing.T) {
+	var app ArgoApplication
+	raw := `{
+		"metadata": {"name": "web"},
+		"status": {
+			"resources": [
+				{"group": "apps", "kind": "Deployment", "namespace": "prod", "name": "web", "syncWave": 2},
+				{"group": "batch", "kind": "Job", "namespace": "prod", "name": "migrate", "syncWave": -1}
+			],
+			"operationState": {
+				"phase": "Running",
+				"message": "waiting for healthy state",
+				"startedAt": "2024-05-01T10:00:00Z",
+				"syncResult": {
+					"revision": "abc123",
+					"resources": [
+						{"group": "batch", "kind": "Job", "namespace": "prod", "name": "migrate", "status": "Synced", "hookType": "PreSync", "hookPhase": "Succeeded", "syncPhase": "PreSync"},
+						{"group": "apps", "kind": "Deployment", "namespace": "prod", "name": "web", "status": "Synced", "syncPhase": "Sync"}
+					]
+				}
+			}
+		}
+	}`
+	if err := json.Unmarshal([]byte(raw), &app); err != nil {
+		t.Fatalf("Failed to decode app: %v", err)
+	}
+
+	op := NewApplicationService(&model.Server{}).ConvertSyncOperation(app)
+	if op == nil {
+		t.Fatal("Expected an operation")
+	}
+	if op.Phase != "Running" || op.Revision != "abc123" || op.StartedAt.IsZero() || op.Completed() {
+		t.Fatalf("Unexpected operation: %+v", op)
+	}
+	if len(op.Resources) != 2 {
+		t.Fatalf("Expected 2 resource results, got %d", len(op.Resources))
+	}
+	if r := op.Resources[0]; r.Wave != -1 || r.HookType != "PreSync" || r.HookPhase != "Succeeded" {
+		t.Errorf("Unexpected hook result: %+v", r)
+	}
+	if r := op.Resources[1]; r.Wave != 2 || r.SyncPhase != "Sync" {
+		t.Errorf("Unexpected deployment result: %+v", r)
+	}
+
+	// Apps that never ran an operation have no operation state
+	if op := NewApplicationService(&model.Server{}).ConvertSyncOperation(ArgoApplication{}); op != nil {
+		t.Fatalf("Expected nil operation, got %+v", op)
+	}
+}
@@ -1,8 +1,8 @@
 package api
 
 import (
+	"encoding/json"
 	"testing"
-	"time"
 )
 
 func TestConvertToApp_WithApplicationSet(t *testing.T) {
@@ -10,12 +10,16 @@ func TestConvertToApp_WithApplicationSet(t *testing.T) {
 
 	argoApp := ArgoApplication{
 		Metadata: struct {
-			Name            string           `json:"name"`
-			Namespace       string           `json:"namespace,omitempty"`
-			OwnerReferences []OwnerReference `json:"ownerReferences,omitempty"`
+			Name            string            `json:"name"`
+			Namespace       string            `json:"namespace,omitempty"`
+			OwnerReferences []OwnerReference  `json:"ownerReferences,omitempty"`
+			Labels          map[string]string `json:"labels,omitempty"`
+			Annotations     map[string]string `json:"annotations,omitempty"`
 		}{
-			Name:      "test-app",
-			Namespace: "argocd",
+			Name:        "test-app",
+			Namespace:   "argocd",
+			Labels:      map[string]string{"team": "core"},
+			Annotations: map[string]string{"owner": "payments"},
 			OwnerReferences: []OwnerReference{
 				{
 					APIVersion: "argoproj.io/v1alpha1",
@@ -47,13 +51,10 @@ func TestConvertToApp_WithApplicationSet(t *testing.T) {
 				Status  string `json:"status,omitempty"`
 				Message string `json:"message,omitempty"`
 			} `json:"health"`
-			OperationState struct {
-				Phase      string    `json:"phase,omitempty"`
-				StartedAt  time.Time `json:"startedAt,omitempty"`
-				FinishedAt time.Time `json:"finishedAt,omitempty"`
-			} `json:"operationState,omitempty"`
-			History   []DeploymentHistory `json:"history,omitempty"`
-			Resources []ResourceStatus    `json:"resources,omitempty"`
+			OperationState OperationState      `json:"operationState,omitempty"`
+			History        []DeploymentHistory `json:"history,omitempty"`
+			Resources      []ResourceStatus    `json:"resources,omitempty"`
+			Conditions     []AppCondition      `json:"conditions,omitempty"`
 		}{
 			Sync: struct {
 				Status     string `json:"status,omitempty"`
@@ -92,6 +93,12 @@ func TestConvertToApp_WithApplicationSet(t *testing.T) {
 	if *app.ApplicationSet != "my-appset" {
 		t.Errorf("Expected ApplicationSet 'my-appset', got %s", *app.ApplicationSet)
 	}
+	if app.Labels["team"] != "core" {
+		t.Errorf("Expected label team=core, got %v", app.Labels)
+	}
+	if app.Annotations["owner"] != "payments" {
+		t.Errorf("Expected annotation owner=payments, got %v", app.Annotations)
+	}
 }
 
 func TestConvertToApp_WithoutApplicationSet(t *testing.T) {
@@ -99,9 +106,11 @@ func TestConvertToApp_WithoutApplicationSet(t *testing.T) {
 
 	argoApp := ArgoApplication{
 		Metadata: struct {
-			Name            string           `json:"name"`
-			Namespace       string           `json:"namespace,omitempty"`
-			OwnerReferences []OwnerReference `json:"ownerReferences,omitempty"`
+			Name            string            `json:"name"`
+			Namespace       string            `json:"namespace,omitempty"`
+			OwnerReferences []OwnerReference  `json:"ownerReferences,omitempty"`
+			Labels          map[string]string `json:"labels,omitempty"`
+			Annotations     map[string]string `json:"annotations,omitempty"`
 		}{
 			Name:            "standalone-app",
 			Namespace:       "argocd",
@@ -129,13 +138,10 @@ func TestConvertToApp_WithoutApplicationSet(t *testing.T) {
 				Status  string `json:"status,omitempty"`
 				Message string `json:"message,omitempty"`
 			} `json:"health"`
-			OperationState struct {
-				Phase      string    `json:"phase,omitempty"`
-				StartedAt  time.Time `json:"startedAt,omitempty"`
-				FinishedAt time.Time `json:"finishedAt,omitempty"`
-			} `json:"operationState,omitempty"`
-			History   []DeploymentHistory `json:"history,omitempty"`
-			Resources []ResourceStatus    `json:"resources,omitempty"`
+			OperationState OperationState      `json:"operationState,omitempty"`
+			History        []DeploymentHistory `json:"history,omitempty"`
+			Resources      []ResourceStatus    `json:"resources,omitempty"`
+			Conditions     []AppCondition      `json:"conditions,omitempty"`
 		}{
 			Sync: struct {
 				Status     string `json:"status,omitempty"`
@@ -174,9 +180,11 @@ func TestConvertToApp_WithOtherOwnerReference(t *testing.T) {
 	// Test that apps with non-ApplicationSet owner references don't get an ApplicationSet field
 	argoApp := ArgoApplication{
 		Metadata: struct {
-			Name            string           `json:"name"`
-			Namespace       string           `json:"namespace,omitempty"`
-			OwnerReferences []OwnerReference `json:"ownerReferences,omitempty"`
+			Name            string            `json:"name"`
+			Namespace       string            `json:"namespace,omitempty"`
+			OwnerReferences []OwnerReference  `json:"ownerReferences,omitempty"`
+			Labels          map[string]string `json:"labels,omitempty"`
+			Annotations     map[string]string `json:"annotations,omitempty"`
 		}{
 			Name:      "app-with-other-owner",
 			Namespace: "argocd",
@@ -211,13 +219,10 @@ func TestConvertToApp_WithOtherOwnerReference(t *testing.T) {
 				Status  string `json:"status,omitempty"`
 				Message string `json:"message,omitempty"`
 			} `json:"health"`
-			OperationState struct {
-				Phase      string    `json:"phase,omitempty"`
-				StartedAt  time.Time `json:"startedAt,omitempty"`
-				FinishedAt time.Time `json:"finishedAt,omitempty"`
-			} `json:"operationState,omitempty"`
-			History   []DeploymentHistory `json:"history,omitempty"`
-			Resources []ResourceStatus    `json:"resources,omitempty"`
+			OperationState OperationState      `json:"operationState,omitempty"`
+			History        []DeploymentHistory `json:"history,omitempty"`
+			Resources      []ResourceStatus    `json:"resources,omitempty"`
+			Conditions     []AppCondition      `json:"conditions,omitempty"`
 		}{
 			Sync: struct {
 				Status     string `json:"status,omitempty"`
@@ -249,3 +254,101 @@ func TestConvertToApp_WithOtherOwnerReference(t *testing.T) {
 		t.Errorf("Expected ApplicationSet to be nil for app with non-ApplicationSet owner, got %v", *app.ApplicationSet)
 	}
 }
+
+func TestConvertToApp_SourceAndRevision(t *testing.T) {
+	svc := &ApplicationService{}
+
+	var argoApp ArgoApplication
+	if err := json.Unmarshal([]byte(`{
+		"metadata": {"name": "multi"},
+		"spec": {"sources": [
+			{"repoURL": "https://github.com/example/apps", "path": "apps/multi", "targetRevision": "main"},
+			{"repoURL": "https://charts.example.com", "targetRevision": "1.2.3"}
+		]},
+		"status": {"sync": {"status": "Synced", "revisions": ["0123456789abcdef0123456789abcdef01234567", "1.2.3"]}}
+	}`), &argoApp); err != nil {
+		t.Fatal(err)
+	}
+
+	app := svc.ConvertToApp(argoApp)
+	if app.SourcePath != "apps/multi" || app.TargetRevision != "main" {
+		t.Errorf("Expected the first source's path and revision, got %q %q", app.SourcePath, app.TargetRevision)
+	}
+	if app.SyncedRevision != "0123456789abcdef0123456789abcdef01234567" {
+		t.Errorf("Expected the first synced revision, got %q", app.SyncedRevision)
+	}
+}
+
+func TestConvertToApp_KeepsDestination(t *testing.T) {
+	svc := &ApplicationService{}
+
+	var argoApp ArgoApplication
+	if err := json.Unmarshal([]byte(`{
+		"metadata": {"name": "orders"},
+		"spec": {"destination": {"server": "https://10.0.0.1:6443", "name": "prod-eu", "namespace": "orders"}}
+	}`), &argoApp); err != nil {
+		t.Fatal(err)
+	}
+
+	app := svc.ConvertToApp(argoApp)
+	if app.DestinationServer != "https://10.0.0.1:6443" || app.DestinationName != "prod-eu" {
+		t.Errorf("Expected the destination as set on the app, got %q %q", app.DestinationServer, app.DestinationName)
+	}
+}
+
+func TestConvertToAppDetail(t *testing.T) {
+	svc := &ApplicationService{}
+
+	var argoApp ArgoApplication
+	if err := json.Unmarshal([]byte(`{
+		"metadata": {"name": "multi", "namespace": "argocd"},
+		"spec": {
+			"project": "payments",
+			"destination": {"server": "https://kubernetes.default.svc", "namespace": "pay"},
+			"sources": [
+				{"repoURL": "https://github.com/example/apps", "path": "apps/multi", "targetRevision": "main"},
+				{"repoURL": "https://charts.example.com", "chart": "redis", "targetRevision": "1.2.3"}
+			],
+			"syncPolicy": {"automated": {"prune": true, "selfHeal": true}, "syncOptions": ["CreateNamespace=true"]}
+		},
+		"status": {
+			"sync": {"status": "OutOfSync", "revisions": ["abc", "1.2.3"]},
+			"health": {"status": "Degraded", "message": "Deployment has 0 ready replicas"},
+			"conditions": [
+				{"type": "ComparisonError", "message": "rpc error"},
+				{"type": "OrphanedResourceWarning", "message": "1 orphaned resource"}
+			],
+			"operationState": {
+				"phase": "Failed",
+				"message": "one or more objects failed to apply",
+				"operation": {"initiatedBy": {"username": "alice"}, "sync": {"revisions": ["abc", "1.2.3"]}},
+				"startedAt": "2024-01-01T10:00:00Z"
+			}
+		}
+	}`), &argoApp); err != nil {
+		t.Fatal(err)
+	}
+
+	detail := svc.ConvertToAppDetail(argoApp)
+	if detail.App.Name != "multi" || detail.DestinationNamespace != "pay" || detail.DestinationServer != "https://kubernetes.default.svc" {
+		t.Errorf("Unexpected app or destination: %+v", detail)
+	}
+	if !detail.MultiSource || len(detail.Sources) != 2 || detail.Sources[1].Chart != "redis" {
+		t.Errorf("Expected both sources including the chart, got %+v", detail.Sources)
+	}
+	if len(detail.SyncedRevisions) != 2 || detail.SyncedRevisions[1] != "1.2.3" {
+		t.Errorf("Expected one synced revision per source, got %v", detail.SyncedRevisions)
+	}
+	if len(detail.Conditions) != 2 || !detail.Conditions[0].IsError() || detail.Conditions[1].IsError() {
+		t.Errorf("Unexpected conditions: %+v", detail.Conditions)
+	}
+	if detail.App.AutoSync == nil || !detail.App.AutoSync.Prune || !detail.App.AutoSync.SelfHeal || detail.App.AutoSync.AllowEmpty {
+		t.Errorf("Unexpected automated sync policy: %+v", detail.App.AutoSync)
+	}
+	if detail.HealthMessage != "Deployment has 0 ready replicas" {
+		t.Errorf("Unexpected health message %q", detail.HealthMessage)
+	}
+	if detail.Operation == nil || detail.Operation.Phase != "Failed" || detail.Operation.InitiatedBy != "alice" || detail.Operation.Automated {
+		t.Errorf("Unexpected operation: %+v", detail.Operation)
+	}
+}
@@ -0,0 +1,266 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"strings"
+
+	cblog "github.com/charmbracelet/log"
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+// ArgoApplicationSet represents an ArgoCD ApplicationSet from the API
+type ArgoApplicationSet struct {
+	Metadata struct {
+		Name            string `json:"name"`
+		Namespace       string `json:"namespace,omitempty"`
+		ResourceVersion string `json:"resourceVersion,omitempty"`
+	} `json:"metadata"`
+	Spec struct {
+		// Each generator is an object keyed by its type (list, git, clusters, matrix, ...)
+		Generators []map[string]json.RawMessage `json:"generators,omitempty"`
+		SyncPolicy *struct {
+			PreserveResourcesOnDeletion bool   `json:"preserveResourcesOnDeletion,omitempty"`
+			ApplicationsSync            string `json:"applicationsSync,omitempty"`
+		} `json:"syncPolicy,omitempty"`
+		Strategy *struct {
+			Type string `json:"type,omitempty"`
+		} `json:"strategy,omitempty"`
+	} `json:"spec"`
+	Status struct {
+		Conditions []ApplicationSetCondition      `json:"conditions,omitempty"`
+		Resources  []ApplicationSetResourceStatus `json:"resources,omitempty"`
+	} `json:"status"`
+}
+
+// ApplicationSetCondition is a status condition reported by the ApplicationSet controller
+type ApplicationSetCondition struct {
+	Type    string `json:"type"`   // ErrorOccurred, ParametersGenerated, ResourcesUpToDate, RolloutProgressing
+	Status  string `json:"status"` // True, False, Unknown
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// ApplicationSetResourceStatus is an application generated by an ApplicationSet
+type ApplicationSetResourceStatus struct {
+	Name      string          `json:"name"`
+	Namespace string          `json:"namespace,omitempty"`
+	Status    string          `json:"status,omitempty"` // Sync status
+	Health    *ResourceHealth `json:"health,omitempty"`
+}
+
+// ApplicationSetWatchEvent represents a watch event for ApplicationSets
+type ApplicationSetWatchEvent struct {
+	Type           string             `json:"type"` // ADDED, MODIFIED, DELETED
+	ApplicationSet ArgoApplicationSet `json:"applicationSet"`
+}
+
+// ApplicationSetService provides ArgoCD ApplicationSet operations
+type ApplicationSetService struct {
+	client *Client
+}
+
+// NewApplicationSetService creates a new ApplicationSet service
+func NewApplicationSetService(server *model.Server) *ApplicationSetService {
+	return &ApplicationSetService{
+		client: NewClient(server),
+	}
+}
+
+// ListApplicationSets retrieves all ApplicationSets visible to the user
+func (s *ApplicationSetService) ListApplicationSets(ctx context.Context) ([]ArgoApplicationSet, error) {
+	data, err := s.client.Get(ctx, "/api/v1/applicationsets")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list applicationsets: %w", err)
+	}
+
+	var list struct {
+		Items []ArgoApplicationSet `json:"items"`
+	}
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, fmt.Errorf("failed to parse applicationsets response: %w", err)
+	}
+	return list.Items, nil
+}
+
+// GetApplicationSet retrieves a single ApplicationSet
+func (s *ApplicationSetService) GetApplicationSet(ctx context.Context, name, namespace string) (*ArgoApplicationSet, error) {
+	if name == "" {
+		return nil, fmt.Errorf("applicationset name is required")
+	}
+	data, err := s.client.Get(ctx, applicationSetPath(name, namespace))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get applicationset %s: %w", name, err)
+	}
+
+	var set ArgoApplicationSet
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("failed to decode applicationset response: %w", err)
+	}
+	return &set, nil
+}
+
+// DeleteApplicationSet deletes an ApplicationSet. Generated applications are removed by the
+// controller unless the set's sync policy preserves them.
+func (s *ApplicationSetService) DeleteApplicationSet(ctx context.Context, name, namespace string) error {
+	if name == "" {
+		return fmt.Errorf("applicationset name is required")
+	}
+	if _, err := s.client.Delete(ctx, applicationSetPath(name, namespace)); err != nil {
+		return fmt.Errorf("failed to delete applicationset %s: %w", name, err)
+	}
+	return nil
+}
+
+// applicationSetPath builds the endpoint for a single ApplicationSet
+func applicationSetPath(name, namespace string) string {
+	endpoint := fmt.Sprintf("/api/v1/applicationsets/%s", url.PathEscape(name))
+	if namespace != "" {
+		endpoint += "?appsetNamespace=" + url.QueryEscape(namespace)
+	}
+	return endpoint
+}
+
+// WatchApplicationSets streams ApplicationSet changes until the context is cancelled or the stream ends.
+// Servers that predate the ApplicationSet watch endpoint return an error when the stream is opened.
+func (s *ApplicationSetService) WatchApplicationSets(ctx context.Context, eventChan chan<- ApplicationSetWatchEvent) error {
+	streamResp, err := s.client.Stream(ctx, "/api/v1/stream/applicationsets")
+	if err != nil {
+		return fmt.Errorf("failed to start applicationset watch stream: %w", err)
+	}
+
+	sseReader := NewAccumulatingSSEReader(streamResp.Body, DefaultSSEConfig())
+	defer sseReader.Close()
+
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		eventData, err := sseReader.ReadEvent()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			if errors.Is(err, ErrEventTooLarge) {
+				return fmt.Errorf("SSE event exceeds maximum size: %w", err)
+			}
+			return fmt.Errorf("error reading SSE event: %w", err)
+		}
+
+		for _, line := range strings.Split(string(eventData), "\n") {
+			line = strings.TrimSpace(line)
+			if !strings.HasPrefix(line, "data: ") {
+				// Skip empty lines, keep-alives and comments
+				continue
+			}
+			var eventResult struct {
+				Result ApplicationSetWatchEvent `json:"result"`
+			}
+			if err := json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &eventResult); err != nil {
+				cblog.With("component", "api").Warn("WatchApplicationSets: failed to unmarshal event", "error", err)
+				continue
+			}
+
+			select {
+			case eventChan <- eventResult.Result:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+}
+
+// ConvertToApplicationSet converts an ArgoApplicationSet to the domain model
+func ConvertToApplicationSet(set ArgoApplicationSet) model.ApplicationSet {
+	out := model.ApplicationSet{
+		Name:       set.Metadata.Name,
+		Namespace:  set.Metadata.Namespace,
+		SyncPolicy: "sync",
+	}
+	for _, g := range set.Spec.Generators {
+		out.Generators = append(out.Generators, summarizeGenerator(g))
+	}
+	if p := set.Spec.SyncPolicy; p != nil {
+		if p.ApplicationsSync != "" {
+			out.SyncPolicy = p.ApplicationsSync
+		}
+		out.PreserveResourcesOnDeletion = p.PreserveResourcesOnDeletion
+	}
+	if set.Spec.Strategy != nil {
+		out.Strategy = set.Spec.Strategy.Type
+	}
+	for _, c := range set.Status.Conditions {
+		out.Conditions = append(out.Conditions, model.ApplicationSetCondition{
+			Type:    c.Type,
+			Status:  c.Status,
+			Reason:  c.Reason,
+			Message: c.Message,
+		})
+	}
+	for _, r := range set.Status.Resources {
+		res := model.ApplicationSetResource{Name: r.Name, Namespace: r.Namespace, Sync: r.Status}
+		if r.Health != nil && r.Health.Status != nil {
+			res.Health = *r.Health.Status
+		}
+		out.Resources = append(out.Resources, res)
+	}
+	return out
+}
+
+// summarizeGenerator describes a generator in a few characters, e.g. "list(3)", "git(dirs)"
+// or "matrix(git(files)+clusters)"
+func summarizeGenerator(g map[string]json.RawMessage) string {
+	keys := make([]string, 0, len(g))
+	for k := range g {
+		if k != "selector" {
+			keys = append(keys, k)
+		}
+	}
+	if len(keys) == 0 {
+		return "unknown"
+	}
+	sort.Strings(keys)
+	kind := keys[0]
+	raw := g[kind]
+
+	switch kind {
+	case "list":
+		var list struct {
+			Elements []json.RawMessage `json:"elements"`
+		}
+		if json.Unmarshal(raw, &list) == nil {
+			return fmt.Sprintf("list(%d)", len(list.Elements))
+		}
+	case "git":
+		var git struct {
+			Directories []json.RawMessage `json:"directories"`
+			Files       []json.RawMessage `json:"files"`
+		}
+		if json.Unmarshal(raw, &git) == nil {
+			switch {
+			case len(git.Files) > 0:
+				return "git(files)"
+			case len(git.Directories) > 0:
+				return "git(dirs)"
+			}
+		}
+	case "matrix", "merge":
+		var nested struct {
+			Generators []map[string]json.RawMessage `json:"generators"`
+		}
+		if json.Unmarshal(raw, &nested) == nil && len(nested.Generators) > 0 {
+			parts := make([]string, 0, len(nested.Generators))
+			for _, n := range nested.Generators {
+				parts = append(parts, summarizeGenerator(n))
+			}
+			return kind + "(" + strings.Join(parts, "+") + ")"
+		}
+	}
+	return kind
+}
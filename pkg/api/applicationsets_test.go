@@ -0,0 +1,178 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+const testAppSetJSON = `{
+	"metadata": {"name": "guestbook", "namespace": "argocd"},
+	"spec": {
+		"generators": [
+			{"list": {"elements": [{"cluster": "a"}, {"cluster": "b"}]}},
+			{"matrix": {"generators": [
+				{"git": {"repoURL": "https://example.com/repo.git", "directories": [{"path": "apps/*"}]}},
+				{"clusters": {}, "selector": {"matchLabels": {"env": "prod"}}}
+			]}}
+		],
+		"syncPolicy": {"applicationsSync": "create-update", "preserveResourcesOnDeletion": true},
+		"strategy": {"type": "RollingSync"}
+	},
+	"status": {
+		"conditions": [
+			{"type": "ErrorOccurred", "status": "True", "reason": "ApplicationGenerationFromParamsError", "message": "failed to execute go template"},
+			{"type": "ResourcesUpToDate", "status": "False", "reason": "ApplicationGenerationFromParamsError"}
+		],
+		"resources": [
+			{"name": "guestbook-a", "namespace": "argocd", "status": "Synced", "health": {"status": "Healthy"}},
+			{"name": "guestbook-b", "namespace": "argocd", "status": "OutOfSync"}
+		]
+	}
+}`
+
+func TestListApplicationSets_ConvertsSpecAndStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/applicationsets" {
+			t.Errorf("Expected path /api/v1/applicationsets, got %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"items": [%s]}`, testAppSetJSON)
+	}))
+	defer server.Close()
+
+	svc := NewApplicationSetService(&model.Server{BaseURL: server.URL, Token: "test-token"})
+	sets, err := svc.ListApplicationSets(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(sets) != 1 {
+		t.Fatalf("Expected 1 ApplicationSet, got %d", len(sets))
+	}
+
+	got := ConvertToApplicationSet(sets[0])
+	if got.Name != "guestbook" || got.Namespace != "argocd" {
+		t.Errorf("Unexpected name/namespace: %s/%s", got.Namespace, got.Name)
+	}
+	wantGenerators := []string{"list(2)", "matrix(git(dirs)+clusters)"}
+	if !reflect.DeepEqual(got.Generators, wantGenerators) {
+		t.Errorf("Expected generators %v, got %v", wantGenerators, got.Generators)
+	}
+	if got.SyncPolicy != "create-update" || !got.PreserveResourcesOnDeletion || got.Strategy != "RollingSync" {
+		t.Errorf("Unexpected sync policy: %+v", got)
+	}
+	if !got.HasError() || got.Condition("ErrorOccurred").Message != "failed to execute go template" {
+		t.Errorf("Expected ErrorOccurred condition, got %+v", got.Conditions)
+	}
+	wantResources := []model.ApplicationSetResource{
+		{Name: "guestbook-a", Namespace: "argocd", Sync: "Synced", Health: "Healthy"},
+		{Name: "guestbook-b", Namespace: "argocd", Sync: "OutOfSync"},
+	}
+	if !reflect.DeepEqual(got.Resources, wantResources) {
+		t.Errorf("Expected resources %+v, got %+v", wantResources, got.Resources)
+	}
+}
+
+func TestConvertToApplicationSet_Defaults(t *testing.T) {
+	var set ArgoApplicationSet
+	set.Metadata.Name = "plain"
+	got := ConvertToApplicationSet(set)
+	if got.SyncPolicy != "sync" || got.HasError() || got.Condition("ResourcesUpToDate") != nil {
+		t.Errorf("Unexpected defaults: %+v", got)
+	}
+}
+
+func TestGetAndDeleteApplicationSet_UseAppSetNamespace(t *testing.T) {
+	var methods []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		if r.URL.Path != "/api/v1/applicationsets/guestbook" {
+			t.Errorf("Expected path /api/v1/applicationsets/guestbook, got %s", r.URL.Path)
+		}
+		if ns := r.URL.Query().Get("appsetNamespace"); ns != "team-a" {
+			t.Errorf("Expected appsetNamespace=team-a, got %q", ns)
+		}
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodGet {
+			fmt.Fprint(w, testAppSetJSON)
+			return
+		}
+		fmt.Fprint(w, "{}")
+	}))
+	defer server.Close()
+
+	svc := NewApplicationSetService(&model.Server{BaseURL: server.URL, Token: "test-token"})
+	set, err := svc.GetApplicationSet(context.Background(), "guestbook", "team-a")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if set.Metadata.Name != "guestbook" {
+		t.Errorf("Expected guestbook, got %s", set.Metadata.Name)
+	}
+	if err := svc.DeleteApplicationSet(context.Background(), "guestbook", "team-a"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !reflect.DeepEqual(methods, []string{http.MethodGet, http.MethodDelete}) {
+		t.Errorf("Expected GET then DELETE, got %v", methods)
+	}
+}
+
+func TestDeleteApplicationSet_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"error": "permission denied"}`)
+	}))
+	defer server.Close()
+
+	svc := NewApplicationSetService(&model.Server{BaseURL: server.URL, Token: "test-token"})
+	if err := svc.DeleteApplicationSet(context.Background(), "guestbook", ""); err == nil {
+		t.Fatal("Expected an error for a forbidden delete")
+	}
+}
+
+func TestWatchApplicationSets_DeliversEvents(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/stream/applicationsets" {
+			t.Errorf("Expected path /api/v1/stream/applicationsets, got %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, "data: {\"result\":{\"type\":\"ADDED\",\"applicationSet\":{\"metadata\":{\"name\":\"one\"}}}}\n\n")
+		fmt.Fprint(w, ": keep-alive\n\n")
+		fmt.Fprint(w, "data: {\"result\":{\"type\":\"DELETED\",\"applicationSet\":{\"metadata\":{\"name\":\"two\"}}}}\n\n")
+	}))
+	defer server.Close()
+
+	svc := NewApplicationSetService(&model.Server{BaseURL: server.URL, Token: "test-token"})
+	events := make(chan ApplicationSetWatchEvent, 10)
+	if err := svc.WatchApplicationSets(context.Background(), events); err != nil {
+		t.Fatalf("Expected stream to end without error, got %v", err)
+	}
+	close(events)
+
+	var got []string
+	for ev := range events {
+		got = append(got, ev.Type+" "+ev.ApplicationSet.Metadata.Name)
+	}
+	want := []string{"ADDED one", "DELETED two"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
+
+func TestWatchApplicationSets_UnsupportedServer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	svc := NewApplicationSetService(&model.Server{BaseURL: server.URL, Token: "test-token"})
+	if err := svc.WatchApplicationSets(context.Background(), make(chan ApplicationSetWatchEvent, 1)); err == nil {
+		t.Fatal("Expected an error when the watch endpoint is missing")
+	}
+}
@@ -0,0 +1,204 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	cblog "github.com/charmbracelet/log"
+	apperrors "github.com/darksworm/argonaut/pkg/errors"
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+// AuthProvider supplies the bearer token for API requests
+type AuthProvider interface {
+	// Token returns the token to send, fetching a new one if needed
+	Token(ctx context.Context) (string, error)
+	// Invalidate is called when the server rejected token with a 401. It reports whether
+	// asking for a token again may help.
+	Invalidate(token string) bool
+}
+
+// staticTokenProvider sends the token from the Argo CD CLI config
+type staticTokenProvider struct {
+	token string
+}
+
+func (p staticTokenProvider) Token(context.Context) (string, error) { return p.token, nil }
+func (p staticTokenProvider) Invalidate(string) bool                { return false }
+
+// execTokenExpirySkew renews exec tokens this long before they expire so in-flight requests don't race the expiry
+const execTokenExpirySkew = 30 * time.Second
+
+// execCommandTimeout bounds a credential command, leaving time for plugins that open a browser
+// login. Requests wait for the command, and streams have no deadline of their own.
+const execCommandTimeout = 2 * time.Minute
+
+// ExecAuthProvider gets tokens by running a command, like kubectl exec credential plugins.
+// The command prints JSON with a token and an optional RFC 3339 expiry, either flat
+// ({"token": "...", "expirationTimestamp": "..."}) or as a kubectl ExecCredential
+// ({"status": {"token": "...", "expirationTimestamp": "..."}}). The token is cached until
+// it expires or the server rejects it.
+type ExecAuthProvider struct {
+	exec    model.ExecAuth
+	baseURL string
+	now     func() time.Time
+	timeout time.Duration
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time // Zero when the command gave no expiry
+}
+
+// NewExecAuthProvider creates an exec auth provider for a server
+func NewExecAuthProvider(exec model.ExecAuth, baseURL string) *ExecAuthProvider {
+	return &ExecAuthProvider{exec: exec, baseURL: baseURL, now: time.Now, timeout: execCommandTimeout}
+}
+
+// execProviders shares exec providers, and so their cached tokens, between the short-lived
+// clients of a server. ForgetExecAuth drops a server's provider when switching away from it.
+var (
+	execProvidersMu sync.Mutex
+	execProviders   = map[string]*ExecAuthProvider{} // server URL → provider
+)
+
+// authProviderFor returns the auth provider for a server
+func authProviderFor(server *model.Server) AuthProvider {
+	if server.ExecAuth == nil {
+		return staticTokenProvider{token: server.Token}
+	}
+	execProvidersMu.Lock()
+	defer execProvidersMu.Unlock()
+	p, ok := execProviders[server.BaseURL]
+	if !ok || !reflect.DeepEqual(p.exec, *server.ExecAuth) {
+		p = NewExecAuthProvider(*server.ExecAuth, server.BaseURL)
+		execProviders[server.BaseURL] = p
+	}
+	return p
+}
+
+// ForgetExecAuth drops the provider and cached exec token of a server
+func ForgetExecAuth(server *model.Server) {
+	if server == nil {
+		return
+	}
+	execProvidersMu.Lock()
+	defer execProvidersMu.Unlock()
+	delete(execProviders, server.BaseURL)
+}
+
+// Token returns the cached token or runs the command for a new one
+func (p *ExecAuthProvider) Token(ctx context.Context) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.token != "" && (p.expiry.IsZero() || p.now().Add(execTokenExpirySkew).Before(p.expiry)) {
+		return p.token, nil
+	}
+
+	// The lock is held while the command runs, so bound it even when ctx has no deadline
+	runCtx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+	token, expiry, err := p.run(runCtx)
+	if err != nil {
+		if runCtx.Err() == context.DeadlineExceeded && ctx.Err() == nil {
+			err = fmt.Errorf("timed out after %s", p.timeout)
+		}
+		return "", apperrors.Wrap(err, apperrors.ErrorAuth, "EXEC_AUTH_FAILED",
+			fmt.Sprintf("Credential command %q failed: %v", p.exec.Command, err)).
+			WithContext("command", p.exec.Command).
+			WithUserAction("Check the [auth.exec] command configured for this context")
+	}
+	p.token, p.expiry = token, expiry
+	cblog.With("component", "auth").Debug("Obtained token from credential command", "command", p.exec.Command, "expiry", expiry)
+	return token, nil
+}
+
+// Expiry returns the expiry of the cached token, or false if there is none or it has no expiry
+func (p *ExecAuthProvider) Expiry() (time.Time, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.token == "" || p.expiry.IsZero() {
+		return time.Time{}, false
+	}
+	return p.expiry, true
+}
+
+// ExecTokenExpiry returns the expiry of the token cached for a server with a credential command
+func ExecTokenExpiry(server *model.Server) (time.Time, bool) {
+	if server == nil || server.ExecAuth == nil {
+		return time.Time{}, false
+	}
+	return authProviderFor(server).(*ExecAuthProvider).Expiry()
+}
+
+// Invalidate drops the cached token if it is the one the server rejected
+func (p *ExecAuthProvider) Invalidate(token string) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.token == token {
+		p.token, p.expiry = "", time.Time{}
+	}
+	return true
+}
+
+// run executes the credential command and parses its output
+func (p *ExecAuthProvider) run(ctx context.Context) (string, time.Time, error) {
+	cmd := exec.CommandContext(ctx, p.exec.Command, p.exec.Args...)
+	cmd.WaitDelay = time.Second // Don't wait on children that keep the output open after a kill
+	cmd.Env = append(os.Environ(), "ARGONAUT_SERVER="+p.baseURL)
+	keys := make([]string, 0, len(p.exec.Env))
+	for k := range p.exec.Env {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		cmd.Env = append(cmd.Env, k+"="+p.exec.Env[k])
+	}
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", time.Time{}, fmt.Errorf("%w: %s", err, msg)
+		}
+		return "", time.Time{}, err
+	}
+	return parseExecCredential(stdout.Bytes())
+}
+
+// execCredential is the output of a credential command
+type execCredential struct {
+	Token      string     `json:"token"`
+	Expiration *time.Time `json:"expirationTimestamp,omitempty"`
+	Status     *struct {
+		Token      string     `json:"token"`
+		Expiration *time.Time `json:"expirationTimestamp,omitempty"`
+	} `json:"status,omitempty"`
+}
+
+// parseExecCredential reads the token and expiry printed by a credential command
+func parseExecCredential(data []byte) (string, time.Time, error) {
+	var cred execCredential
+	if err := json.Unmarshal(data, &cred); err != nil {
+		return "", time.Time{}, fmt.Errorf("invalid credential output: %w", err)
+	}
+	token, expiration := cred.Token, cred.Expiration
+	if cred.Status != nil && cred.Status.Token != "" {
+		token, expiration = cred.Status.Token, cred.Status.Expiration
+	}
+	if token == "" {
+		return "", time.Time{}, fmt.Errorf("credential output has no token")
+	}
+	var expiry time.Time
+	if expiration != nil {
+		expiry = *expiration
+	}
+	return token, expiry, nil
+}
@@ -0,0 +1,172 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+// writeCredentialScript creates a credential command that prints token-N on its Nth run
+func writeCredentialScript(t *testing.T, output string) model.ExecAuth {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("credential test script needs sh")
+	}
+	dir := t.TempDir()
+	script := filepath.Join(dir, "creds.sh")
+	body := fmt.Sprintf(`#!/bin/sh
+n=$(($(cat "%[1]s/count" 2>/dev/null || echo 0) + 1))
+echo $n > "%[1]s/count"
+printf '%%s' "%[2]s" | sed "s/TOKEN/token-$n/"
+`, dir, strings.ReplaceAll(output, `"`, `\"`))
+	if err := os.WriteFile(script, []byte(body), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	return model.ExecAuth{Command: script}
+}
+
+func TestExecAuthProvider_CachesUntilExpiry(t *testing.T) {
+	expiry := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	exec := writeCredentialScript(t, `{"status": {"token": "TOKEN", "expirationTimestamp": "2026-01-01T12:00:00Z"}}`)
+	p := NewExecAuthProvider(exec, "https://argocd.example.com")
+	now := expiry.Add(-time.Hour)
+	p.now = func() time.Time { return now }
+
+	for i := 0; i < 2; i++ {
+		if token, err := p.Token(context.Background()); err != nil || token != "token-1" {
+			t.Fatalf("Expected cached token-1, got %q (%v)", token, err)
+		}
+	}
+
+	if exp, ok := p.Expiry(); !ok || !exp.Equal(expiry) {
+		t.Fatalf("Expected the cached expiry %s, got %s", expiry, exp)
+	}
+
+	now = expiry.Add(-execTokenExpirySkew / 2)
+	if token, _ := p.Token(context.Background()); token != "token-2" {
+		t.Fatalf("Expected the command to run again near expiry, got %q", token)
+	}
+}
+
+func TestExecAuthProvider_CommandFailure(t *testing.T) {
+	p := NewExecAuthProvider(model.ExecAuth{Command: "sh", Args: []string{"-c", "echo no credentials >&2; exit 3"}}, "")
+	_, err := p.Token(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "no credentials") {
+		t.Fatalf("Expected the command's stderr in the error, got %v", err)
+	}
+}
+
+func TestExecAuthProvider_CommandTimeout(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("credential test command needs sh")
+	}
+	p := NewExecAuthProvider(model.ExecAuth{Command: "sh", Args: []string{"-c", "sleep 10"}}, "")
+	p.timeout = 100 * time.Millisecond
+
+	start := time.Now()
+	_, err := p.Token(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "timed out") {
+		t.Fatalf("Expected a hung command to time out, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("Expected the command to be stopped at the timeout, took %s", elapsed)
+	}
+}
+
+func TestAuthProviderFor_OnePerServer(t *testing.T) {
+	server := &model.Server{BaseURL: "https://argocd.example.com", ExecAuth: &model.ExecAuth{Command: "get-token"}}
+	defer ForgetExecAuth(server)
+
+	p := authProviderFor(server)
+	if authProviderFor(&model.Server{BaseURL: server.BaseURL, ExecAuth: &model.ExecAuth{Command: "get-token"}}) != p {
+		t.Fatal("Expected clients of the same server to share the provider")
+	}
+	server.ExecAuth = &model.ExecAuth{Command: "get-token", Args: []string{"--prod"}}
+	changed := authProviderFor(server)
+	if changed == p {
+		t.Fatal("Expected a changed credential command to replace the provider")
+	}
+
+	ForgetExecAuth(server)
+	if authProviderFor(server) == changed {
+		t.Fatal("Expected a forgotten server to get a new provider")
+	}
+}
+
+func TestParseExecCredential(t *testing.T) {
+	tests := []struct {
+		name       string
+		output     string
+		wantToken  string
+		wantExpiry bool
+		wantErr    bool
+	}{
+		{"flat", `{"token": "abc", "expirationTimestamp": "2026-01-01T00:00:00Z"}`, "abc", true, false},
+		{"exec credential", `{"kind": "ExecCredential", "status": {"token": "abc"}}`, "abc", false, false},
+		{"no token", `{"status": {}}`, "", false, true},
+		{"not json", `abc`, "", false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, expiry, err := parseExecCredential([]byte(tt.output))
+			if (err != nil) != tt.wantErr || token != tt.wantToken || expiry.IsZero() == tt.wantExpiry {
+				t.Errorf("parseExecCredential() = %q, %v, %v", token, expiry, err)
+			}
+		})
+	}
+}
+
+func TestClient_RenewsExecTokenOn401(t *testing.T) {
+	var seen []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth := r.Header.Get("Authorization")
+		seen = append(seen, auth)
+		if auth != "Bearer token-2" {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"error": "token is expired"}`)
+			return
+		}
+		fmt.Fprint(w, `{"items": []}`)
+	}))
+	defer server.Close()
+
+	exec := writeCredentialScript(t, `{"token": "TOKEN"}`)
+	client := NewClient(&model.Server{BaseURL: server.URL, ExecAuth: &exec})
+	if _, err := client.Get(context.Background(), "/api/v1/clusters"); err != nil {
+		t.Fatalf("Expected the request to succeed with a renewed token, got %v", err)
+	}
+	if len(seen) != 2 || seen[0] != "Bearer token-1" {
+		t.Fatalf("Expected one rejected request and one retry, got %v", seen)
+	}
+
+	// Later clients for the same server reuse the renewed token
+	if _, err := NewClient(&model.Server{BaseURL: server.URL, ExecAuth: &exec}).Get(context.Background(), "/api/v1/clusters"); err != nil {
+		t.Fatalf("Expected the cached token to be reused, got %v", err)
+	}
+	if len(seen) != 3 {
+		t.Fatalf("Expected no further credential runs, got %v", seen)
+	}
+}
+
+func TestClient_StaticTokenNotRetried(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	_, err := NewClient(&model.Server{BaseURL: server.URL, Token: "stale"}).Get(context.Background(), "/api/v1/clusters")
+	if err == nil || requests != 1 {
+		t.Fatalf("Expected one failed request, got %d requests (%v)", requests, err)
+	}
+}
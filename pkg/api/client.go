@@ -5,6 +5,7 @@ import (
 	"context"
 	"crypto/tls"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net"
@@ -23,7 +24,7 @@ import (
 // Client represents an HTTP client for ArgoCD API
 type Client struct {
 	baseURL          string
-	token            string
+	auth             AuthProvider
 	httpClient       *http.Client
 	streamHTTPClient *http.Client // Separate client for SSE streams (no ResponseHeaderTimeout)
 	insecure         bool
@@ -131,7 +132,7 @@ func NewClient(server *model.Server) *Client {
 
 	return &Client{
 		baseURL:          server.BaseURL,
-		token:            server.Token,
+		auth:             authProviderFor(server),
 		httpClient:       httpClient,
 		streamHTTPClient: streamHTTPClient,
 		insecure:         server.Insecure,
@@ -199,6 +200,19 @@ func (c *Client) Put(ctx context.Context, path string, body interface{}) ([]byte
 	return result, err
 }
 
+// Patch performs a PATCH request with retry logic.
+// See Get for timeout responsibility.
+func (c *Client) Patch(ctx context.Context, path string, body interface{}) ([]byte, error) {
+	var result []byte
+	err := retry.RetryNetworkOperation(ctx, fmt.Sprintf("PATCH %s", path), func(attempt int) error {
+		var opErr error
+		result, opErr = c.request(ctx, "PATCH", path, body)
+		return opErr
+	})
+
+	return result, err
+}
+
 // Delete performs a DELETE request with retry logic.
 // See Get for timeout responsibility.
 func (c *Client) Delete(ctx context.Context, path string) ([]byte, error) {
@@ -215,6 +229,23 @@ func (c *Client) Delete(ctx context.Context, path string) ([]byte, error) {
 // Stream performs a streaming GET request for Server-Sent Events
 // Returns both the stream body and response headers for potential future use
 func (c *Client) Stream(ctx context.Context, path string) (*StreamResponse, error) {
+	token, err := c.auth.Token(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.stream(ctx, path, token)
+	if isUnauthorized(err) && c.auth.Invalidate(token) {
+		// The token was revoked or expired early; get a fresh one once before giving up
+		if token, err = c.auth.Token(ctx); err != nil {
+			return nil, err
+		}
+		resp, err = c.stream(ctx, path, token)
+	}
+	return resp, err
+}
+
+// stream performs a single stream request with the given token
+func (c *Client) stream(ctx context.Context, path, token string) (*StreamResponse, error) {
 	// No timeout for streams - managed by caller context
 	url := c.buildURL(path)
 
@@ -227,7 +258,7 @@ func (c *Client) Stream(ctx context.Context, path string) (*StreamResponse, erro
 	}
 
 	// Set headers
-	req.Header.Set("Authorization", "Bearer "+c.token)
+	req.Header.Set("Authorization", "Bearer "+token)
 	req.Header.Set("Accept", "text/event-stream")
 	req.Header.Set("Cache-Control", "no-cache")
 
@@ -275,8 +306,35 @@ func (c *Client) Stream(ctx context.Context, path string) (*StreamResponse, erro
 	}, nil
 }
 
-// request performs the actual HTTP request
+// request performs the HTTP request, retrying once with a fresh token on 401 when the auth
+// provider can renew it
 func (c *Client) request(ctx context.Context, method, path string, body interface{}) ([]byte, error) {
+	token, err := c.auth.Token(ctx)
+	if err != nil {
+		return nil, err
+	}
+	data, err := c.doRequest(ctx, method, path, body, token)
+	if isUnauthorized(err) && c.auth.Invalidate(token) {
+		if token, err = c.auth.Token(ctx); err != nil {
+			return nil, err
+		}
+		data, err = c.doRequest(ctx, method, path, body, token)
+	}
+	return data, err
+}
+
+// isUnauthorized reports whether err is a 401 from the API
+func isUnauthorized(err error) bool {
+	var argErr *apperrors.ArgonautError
+	if !errors.As(err, &argErr) || argErr.Context == nil {
+		return false
+	}
+	status, _ := argErr.Context["statusCode"].(int)
+	return status == http.StatusUnauthorized
+}
+
+// doRequest performs the actual HTTP request
+func (c *Client) doRequest(ctx context.Context, method, path string, body interface{}, token string) ([]byte, error) {
 	// Retrieve the original timeout duration for accurate error messages.
 	// Uses the value stored by WithAPITimeout/WithMinAPITimeout at context
 	// creation time, avoiding time.Until(deadline) which drifts on retries.
@@ -312,7 +370,7 @@ func (c *Client) request(ctx context.Context, method, path string, body interfac
 	}
 
 	// Set headers
-	req.Header.Set("Authorization", "Bearer "+c.token)
+	req.Header.Set("Authorization", "Bearer "+token)
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/json")
 
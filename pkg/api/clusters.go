@@ -0,0 +1,91 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+// ArgoConnectionState is the last known state of ArgoCD's connection to a cluster
+type ArgoConnectionState struct {
+	Status      string     `json:"status,omitempty"` // Successful, Failed, Unknown
+	Message     string     `json:"message,omitempty"`
+	AttemptedAt *time.Time `json:"attemptedAt,omitempty"`
+}
+
+// ArgoCluster represents a cluster registered in ArgoCD
+type ArgoCluster struct {
+	Server string `json:"server"`
+	Name   string `json:"name"`
+	// Deprecated top-level copies of info.serverVersion and info.connectionState, still sent by older servers
+	ServerVersion   string              `json:"serverVersion,omitempty"`
+	ConnectionState ArgoConnectionState `json:"connectionState"`
+	Info            struct {
+		ServerVersion   string              `json:"serverVersion,omitempty"`
+		ConnectionState ArgoConnectionState `json:"connectionState"`
+		CacheInfo       struct {
+			ResourcesCount    int        `json:"resourcesCount,omitempty"`
+			APIsCount         int        `json:"apisCount,omitempty"`
+			LastCacheSyncTime *time.Time `json:"lastCacheSyncTime,omitempty"`
+		} `json:"cacheInfo"`
+		ApplicationsCount int `json:"applicationsCount,omitempty"`
+	} `json:"info"`
+}
+
+// ClusterService provides ArgoCD cluster operations
+type ClusterService struct {
+	client *Client
+}
+
+// NewClusterService creates a new cluster service
+func NewClusterService(server *model.Server) *ClusterService {
+	return &ClusterService{
+		client: NewClient(server),
+	}
+}
+
+// ListClusters retrieves the clusters visible to the user with their connection and cache info
+func (s *ClusterService) ListClusters(ctx context.Context) ([]ArgoCluster, error) {
+	data, err := s.client.Get(ctx, "/api/v1/clusters")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list clusters: %w", err)
+	}
+
+	var list struct {
+		Items []ArgoCluster `json:"items"`
+	}
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, fmt.Errorf("failed to parse clusters response: %w", err)
+	}
+	return list.Items, nil
+}
+
+// ConvertToCluster converts an ArgoCluster to the domain model
+func ConvertToCluster(c ArgoCluster) model.Cluster {
+	state := c.Info.ConnectionState
+	if state.Status == "" {
+		state = c.ConnectionState
+	}
+	version := c.Info.ServerVersion
+	if version == "" {
+		version = c.ServerVersion
+	}
+	out := model.Cluster{
+		Server:            c.Server,
+		Name:              c.Name,
+		Version:           version,
+		ConnectionStatus:  state.Status,
+		ConnectionMessage: state.Message,
+		AttemptedAt:       state.AttemptedAt,
+		ResourcesCount:    c.Info.CacheInfo.ResourcesCount,
+		APIsCount:         c.Info.CacheInfo.APIsCount,
+		CacheSyncedAt:     c.Info.CacheInfo.LastCacheSyncTime,
+	}
+	if out.ConnectionStatus == "" {
+		out.ConnectionStatus = "Unknown"
+	}
+	return out
+}
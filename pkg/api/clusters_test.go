@@ -0,0 +1,55 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+func TestListClusters_ConvertsInfo(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/clusters" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"items": [
+			{"server": "https://kubernetes.default.svc", "name": "in-cluster", "info": {
+				"serverVersion": "1.29", "connectionState": {"status": "Successful"},
+				"cacheInfo": {"resourcesCount": 420, "apisCount": 61, "lastCacheSyncTime": "2026-01-02T03:04:05Z"}}},
+			{"server": "https://10.0.0.1:6443", "name": "prod-eu", "serverVersion": "1.28",
+				"connectionState": {"status": "Failed", "message": "dial tcp 10.0.0.1:6443: i/o timeout"}},
+			{"server": "https://10.0.0.2:6443", "name": "staging"}
+		]}`)
+	}))
+	defer server.Close()
+
+	clusters, err := NewClusterService(&model.Server{BaseURL: server.URL, Token: "test-token"}).ListClusters(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(clusters) != 3 {
+		t.Fatalf("Expected 3 clusters, got %d", len(clusters))
+	}
+
+	inCluster := ConvertToCluster(clusters[0])
+	if inCluster.Version != "1.29" || inCluster.ConnectionStatus != "Successful" || inCluster.ResourcesCount != 420 || inCluster.APIsCount != 61 {
+		t.Errorf("Unexpected in-cluster conversion %+v", inCluster)
+	}
+	if inCluster.CacheSyncedAt == nil || inCluster.CacheSyncedAt.Year() != 2026 {
+		t.Errorf("Expected the cache sync time, got %v", inCluster.CacheSyncedAt)
+	}
+
+	// Older servers only send the deprecated top-level fields
+	prod := ConvertToCluster(clusters[1])
+	if prod.Version != "1.28" || !prod.Failed() || prod.ConnectionMessage != "dial tcp 10.0.0.1:6443: i/o timeout" {
+		t.Errorf("Unexpected prod-eu conversion %+v", prod)
+	}
+
+	if staging := ConvertToCluster(clusters[2]); staging.ConnectionStatus != "Unknown" {
+		t.Errorf("Expected Unknown status without a connection state, got %q", staging.ConnectionStatus)
+	}
+}
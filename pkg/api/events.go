@@ -0,0 +1,107 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// ListResourceEventsParams contains parameters for listing Kubernetes events of an application
+type ListResourceEventsParams struct {
+	AppName           string
+	AppNamespace      *string
+	ResourceName      string // Optional: only events involving this resource
+	ResourceNamespace string // Optional: namespace of ResourceName
+	ResourceUID       string // Optional: only events involving the resource with this UID
+}
+
+// ResourceEvent is a Kubernetes event as returned by ArgoCD
+type ResourceEvent struct {
+	Metadata struct {
+		Name              string     `json:"name"`
+		Namespace         string     `json:"namespace"`
+		CreationTimestamp *time.Time `json:"creationTimestamp,omitempty"`
+	} `json:"metadata"`
+	InvolvedObject struct {
+		Kind      string `json:"kind"`
+		Name      string `json:"name"`
+		Namespace string `json:"namespace"`
+		UID       string `json:"uid"`
+	} `json:"involvedObject"`
+	Type           string     `json:"type"`
+	Reason         string     `json:"reason"`
+	Message        string     `json:"message"`
+	Count          int        `json:"count"`
+	FirstTimestamp *time.Time `json:"firstTimestamp,omitempty"`
+	LastTimestamp  *time.Time `json:"lastTimestamp,omitempty"`
+	EventTime      *time.Time `json:"eventTime,omitempty"`
+	Series         *struct {
+		Count            int        `json:"count"`
+		LastObservedTime *time.Time `json:"lastObservedTime,omitempty"`
+	} `json:"series,omitempty"`
+}
+
+// LastSeen returns the most recent time the event was observed.
+// Newer clients only set eventTime/series, older ones only lastTimestamp.
+func (e ResourceEvent) LastSeen() time.Time {
+	if e.Series != nil && e.Series.LastObservedTime != nil {
+		return *e.Series.LastObservedTime
+	}
+	for _, t := range []*time.Time{e.LastTimestamp, e.EventTime, e.FirstTimestamp, e.Metadata.CreationTimestamp} {
+		if t != nil && !t.IsZero() {
+			return *t
+		}
+	}
+	return time.Time{}
+}
+
+// Occurrences returns how many times the event was observed
+func (e ResourceEvent) Occurrences() int {
+	if e.Series != nil && e.Series.Count > 0 {
+		return e.Series.Count
+	}
+	return max(1, e.Count)
+}
+
+// ListResourceEvents retrieves Kubernetes events for an application, optionally scoped to one resource
+func (s *ApplicationService) ListResourceEvents(ctx context.Context, params ListResourceEventsParams) ([]ResourceEvent, error) {
+	if params.AppName == "" {
+		return nil, fmt.Errorf("application name is required")
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/applications/%s/events", url.PathEscape(params.AppName))
+
+	queryParams := url.Values{}
+	if params.ResourceName != "" {
+		queryParams.Set("resourceName", params.ResourceName)
+	}
+	if params.ResourceNamespace != "" {
+		queryParams.Set("resourceNamespace", params.ResourceNamespace)
+	}
+	if params.ResourceUID != "" {
+		queryParams.Set("resourceUID", params.ResourceUID)
+	}
+	if params.AppNamespace != nil && *params.AppNamespace != "" {
+		queryParams.Set("appNamespace", *params.AppNamespace)
+	}
+	if len(queryParams) > 0 {
+		endpoint += "?" + queryParams.Encode()
+	}
+
+	resp, err := s.client.Get(ctx, endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list events for application %s: %w", params.AppName, err)
+	}
+
+	// ArgoCD returns a Kubernetes EventList: { "items": [...] }
+	var result struct {
+		Items []ResourceEvent `json:"items"`
+	}
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse events response: %w", err)
+	}
+
+	return result.Items, nil
+}
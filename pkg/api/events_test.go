@@ -0,0 +1,83 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+func TestListResourceEvents_ResourceFilters(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/applications/test-app/events" {
+			t.Errorf("Expected events path, got %s", r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("resourceName") != "web-1" || q.Get("resourceNamespace") != "default" || q.Get("resourceUID") != "uid-1" {
+			t.Errorf("Unexpected resource filters: %s", r.URL.RawQuery)
+		}
+		if q.Get("appNamespace") != "argocd" {
+			t.Errorf("Expected appNamespace=argocd, got %s", q.Get("appNamespace"))
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"items":[{
+			"metadata":{"name":"web-1.1","namespace":"default"},
+			"involvedObject":{"kind":"Pod","name":"web-1","namespace":"default","uid":"uid-1"},
+			"type":"Warning","reason":"FailedScheduling","message":"0/3 nodes are available","count":4,
+			"lastTimestamp":"2024-05-01T10:00:00Z","eventTime":null
+		}]}`))
+	}))
+	defer server.Close()
+
+	svc := NewApplicationService(&model.Server{
+		BaseURL: server.URL,
+		Token:   "test-token",
+	})
+
+	appNamespace := "argocd"
+	events, err := svc.ListResourceEvents(context.Background(), ListResourceEventsParams{
+		AppName:           "test-app",
+		AppNamespace:      &appNamespace,
+		ResourceName:      "web-1",
+		ResourceNamespace: "default",
+		ResourceUID:       "uid-1",
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(events) != 1 {
+		t.Fatalf("Expected 1 event, got %d", len(events))
+	}
+	ev := events[0]
+	if ev.Type != "Warning" || ev.Reason != "FailedScheduling" || ev.InvolvedObject.Kind != "Pod" {
+		t.Errorf("Unexpected event: %+v", ev)
+	}
+	if ev.Occurrences() != 4 {
+		t.Errorf("Expected 4 occurrences, got %d", ev.Occurrences())
+	}
+	if want := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC); !ev.LastSeen().Equal(want) {
+		t.Errorf("Expected last seen %v, got %v", want, ev.LastSeen())
+	}
+}
+
+func TestResourceEvent_LastSeenPrefersSeries(t *testing.T) {
+	first := time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+	observed := time.Date(2024, 5, 1, 11, 0, 0, 0, time.UTC)
+	ev := ResourceEvent{EventTime: &first}
+	if !ev.LastSeen().Equal(first) {
+		t.Errorf("Expected eventTime fallback, got %v", ev.LastSeen())
+	}
+	if ev.Occurrences() != 1 {
+		t.Errorf("Expected at least one occurrence, got %d", ev.Occurrences())
+	}
+	ev.Series = &struct {
+		Count            int        `json:"count"`
+		LastObservedTime *time.Time `json:"lastObservedTime,omitempty"`
+	}{Count: 7, LastObservedTime: &observed}
+	if !ev.LastSeen().Equal(observed) || ev.Occurrences() != 7 {
+		t.Errorf("Expected series values, got %v x%d", ev.LastSeen(), ev.Occurrences())
+	}
+}
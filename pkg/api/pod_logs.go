@@ -0,0 +1,212 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	cblog "github.com/charmbracelet/log"
+)
+
+// PodLogsRequest describes a container log stream for a pod managed by an application
+type PodLogsRequest struct {
+	AppName      string  // Name of the ArgoCD application
+	AppNamespace *string // Optional namespace of the ArgoCD application (for multi-tenant)
+	PodName      string  // Name of the pod to stream logs from
+	Namespace    string  // Namespace of the pod
+	Container    string  // Container name (empty = pod's default container)
+	SinceSeconds int64   // Only return lines newer than this many seconds (0 = no limit)
+	TailLines    int64   // Number of lines from the end of the log to start with (0 = all)
+	Follow       bool    // Keep the stream open and deliver new lines as they are written
+}
+
+// PodLogEntry is a single log line streamed from ArgoCD
+type PodLogEntry struct {
+	Content   string     `json:"content"`
+	TimeStamp *time.Time `json:"timeStamp,omitempty"`
+	PodName   string     `json:"podName,omitempty"`
+	Last      bool       `json:"last,omitempty"`
+}
+
+// PodLogsStreamResult wraps streaming responses for pod logs
+type PodLogsStreamResult struct {
+	Result *PodLogEntry `json:"result,omitempty"`
+	Error  *struct {
+		Message string `json:"message"`
+	} `json:"error,omitempty"`
+}
+
+// podLogsPath builds the streaming endpoint path for a pod logs request
+func podLogsPath(req PodLogsRequest) string {
+	path := fmt.Sprintf("/api/v1/applications/%s/pods/%s/logs",
+		url.PathEscape(req.AppName), url.PathEscape(req.PodName))
+
+	queryParams := url.Values{}
+	if req.Namespace != "" {
+		queryParams.Set("namespace", req.Namespace)
+	}
+	if req.Container != "" {
+		queryParams.Set("container", req.Container)
+	}
+	if req.SinceSeconds > 0 {
+		queryParams.Set("sinceSeconds", strconv.FormatInt(req.SinceSeconds, 10))
+	}
+	if req.TailLines > 0 {
+		queryParams.Set("tailLines", strconv.FormatInt(req.TailLines, 10))
+	}
+	if req.Follow {
+		queryParams.Set("follow", "true")
+	}
+	if req.AppNamespace != nil && *req.AppNamespace != "" {
+		queryParams.Set("appNamespace", *req.AppNamespace)
+	}
+	if len(queryParams) > 0 {
+		path += "?" + queryParams.Encode()
+	}
+	return path
+}
+
+// StreamPodLogs streams container log lines for a pod until the stream ends or ctx is cancelled
+func (s *ApplicationService) StreamPodLogs(ctx context.Context, req PodLogsRequest, out chan<- PodLogEntry) error {
+	if req.AppName == "" {
+		return fmt.Errorf("application name is required")
+	}
+	if req.PodName == "" {
+		return fmt.Errorf("pod name is required")
+	}
+
+	path := podLogsPath(req)
+	cblog.With("component", "api").Debug("Starting pod logs stream", "app", req.AppName, "pod", req.PodName, "path", path)
+	streamResp, err := s.client.Stream(ctx, path)
+	if err != nil {
+		cblog.With("component", "api").Error("Failed to start pod logs stream", "err", err, "pod", req.PodName)
+		return fmt.Errorf("failed to start pod logs stream: %w", err)
+	}
+
+	sseReader := NewAccumulatingSSEReader(streamResp.Body, DefaultSSEConfig())
+	defer sseReader.Close()
+
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		eventData, err := sseReader.ReadEvent()
+		if len(eventData) > 0 {
+			done, perr := deliverPodLogEvent(ctx, eventData, out)
+			if perr != nil {
+				return perr
+			}
+			if done {
+				return nil
+			}
+		}
+		if err != nil {
+			if err == io.EOF {
+				cblog.With("component", "api").Debug("StreamPodLogs: stream ended normally", "pod", req.PodName)
+				return nil
+			}
+			if errors.Is(err, ErrEventTooLarge) {
+				return fmt.Errorf("pod logs SSE event exceeds maximum size: %w", err)
+			}
+			return fmt.Errorf("error reading SSE event: %w", err)
+		}
+	}
+}
+
+// deliverPodLogEvent parses a single SSE event and forwards its log entries.
+// Returns done=true once ArgoCD marks the final entry of a non-follow stream.
+func deliverPodLogEvent(ctx context.Context, eventData []byte, out chan<- PodLogEntry) (bool, error) {
+	for _, line := range strings.Split(string(eventData), "\n") {
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(line, "data: ") {
+			// Skip empty lines, keep-alives and non-data fields
+			continue
+		}
+		jsonData := strings.TrimPrefix(line, "data: ")
+
+		var res PodLogsStreamResult
+		if err := json.Unmarshal([]byte(jsonData), &res); err != nil {
+			cblog.With("component", "api").Warn("Failed to parse pod logs event", "err", err)
+			continue
+		}
+		if res.Error != nil {
+			return true, fmt.Errorf("pod logs stream error: %s", res.Error.Message)
+		}
+		if res.Result == nil {
+			continue
+		}
+		if res.Result.Last {
+			return true, nil
+		}
+		select {
+		case out <- *res.Result:
+		case <-ctx.Done():
+			return true, ctx.Err()
+		}
+	}
+	return false, nil
+}
+
+// ListPodContainers returns the container names declared by a pod, followed by its init containers
+func (s *ApplicationService) ListPodContainers(ctx context.Context, appName string, appNamespace *string, namespace, podName string) ([]string, error) {
+	if appName == "" {
+		return nil, fmt.Errorf("application name is required")
+	}
+	if podName == "" {
+		return nil, fmt.Errorf("pod name is required")
+	}
+
+	queryParams := url.Values{}
+	queryParams.Set("resourceName", podName)
+	queryParams.Set("namespace", namespace)
+	queryParams.Set("kind", "Pod")
+	queryParams.Set("version", "v1")
+	queryParams.Set("group", "")
+	if appNamespace != nil && *appNamespace != "" {
+		queryParams.Set("appNamespace", *appNamespace)
+	}
+	endpoint := fmt.Sprintf("/api/v1/applications/%s/resource?%s", url.PathEscape(appName), queryParams.Encode())
+
+	resp, err := s.client.Get(ctx, endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pod %s: %w", podName, err)
+	}
+
+	// ArgoCD returns { "manifest": "<json-encoded object>" }
+	var result struct {
+		Manifest string `json:"manifest"`
+	}
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse resource response: %w", err)
+	}
+
+	var pod struct {
+		Spec struct {
+			InitContainers []struct {
+				Name string `json:"name"`
+			} `json:"initContainers"`
+			Containers []struct {
+				Name string `json:"name"`
+			} `json:"containers"`
+		} `json:"spec"`
+	}
+	if err := json.Unmarshal([]byte(result.Manifest), &pod); err != nil {
+		return nil, fmt.Errorf("failed to parse pod manifest: %w", err)
+	}
+
+	var containers []string
+	for _, c := range pod.Spec.Containers {
+		containers = append(containers, c.Name)
+	}
+	for _, c := range pod.Spec.InitContainers {
+		containers = append(containers, c.Name)
+	}
+	return containers, nil
+}
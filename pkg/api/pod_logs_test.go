@@ -0,0 +1,139 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+func TestStreamPodLogs_DeliversEntriesUntilLast(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/applications/test-app/pods/web-1/logs" {
+			t.Errorf("Expected pod logs path, got %s", r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("namespace") != "default" {
+			t.Errorf("Expected namespace=default, got %s", q.Get("namespace"))
+		}
+		if q.Get("container") != "app" {
+			t.Errorf("Expected container=app, got %s", q.Get("container"))
+		}
+		if q.Get("tailLines") != "100" {
+			t.Errorf("Expected tailLines=100, got %s", q.Get("tailLines"))
+		}
+		if q.Get("sinceSeconds") != "" {
+			t.Errorf("Expected no sinceSeconds, got %s", q.Get("sinceSeconds"))
+		}
+		if q.Get("follow") != "true" {
+			t.Errorf("Expected follow=true, got %s", q.Get("follow"))
+		}
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, "data: {\"result\":{\"content\":\"first line\",\"podName\":\"web-1\"}}\n\n")
+		fmt.Fprint(w, "data: {\"result\":{\"content\":\"second line\",\"podName\":\"web-1\"}}\n\n")
+		fmt.Fprint(w, "data: {\"result\":{\"content\":\"\",\"last\":true}}\n\n")
+		fmt.Fprint(w, "data: {\"result\":{\"content\":\"after last\"}}\n\n")
+	}))
+	defer server.Close()
+
+	svc := NewApplicationService(&model.Server{
+		BaseURL: server.URL,
+		Token:   "test-token",
+	})
+
+	out := make(chan PodLogEntry, 10)
+	err := svc.StreamPodLogs(context.Background(), PodLogsRequest{
+		AppName:   "test-app",
+		PodName:   "web-1",
+		Namespace: "default",
+		Container: "app",
+		TailLines: 100,
+		Follow:    true,
+	}, out)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	close(out)
+
+	var got []string
+	for e := range out {
+		got = append(got, e.Content)
+	}
+	if len(got) != 2 || got[0] != "first line" || got[1] != "second line" {
+		t.Fatalf("Expected [first line second line], got %v", got)
+	}
+}
+
+func TestStreamPodLogs_StreamError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, "data: {\"error\":{\"message\":\"container not found\"}}\n\n")
+	}))
+	defer server.Close()
+
+	svc := NewApplicationService(&model.Server{
+		BaseURL: server.URL,
+		Token:   "test-token",
+	})
+
+	out := make(chan PodLogEntry, 10)
+	err := svc.StreamPodLogs(context.Background(), PodLogsRequest{AppName: "test-app", PodName: "web-1"}, out)
+	if err == nil {
+		t.Fatal("Expected error from stream error event")
+	}
+}
+
+func TestPodLogsPath_AppNamespaceAndSince(t *testing.T) {
+	ns := "argocd-apps"
+	path := podLogsPath(PodLogsRequest{
+		AppName:      "test-app",
+		AppNamespace: &ns,
+		PodName:      "web-1",
+		SinceSeconds: 300,
+	})
+	want := "/api/v1/applications/test-app/pods/web-1/logs?appNamespace=argocd-apps&sinceSeconds=300"
+	if path != want {
+		t.Errorf("Expected %s, got %s", want, path)
+	}
+}
+
+func TestListPodContainers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/applications/test-app/resource" {
+			t.Errorf("Expected resource path, got %s", r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("kind") != "Pod" || q.Get("resourceName") != "web-1" || q.Get("namespace") != "default" {
+			t.Errorf("Unexpected query %s", r.URL.RawQuery)
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"manifest":"{\"spec\":{\"initContainers\":[{\"name\":\"init\"}],\"containers\":[{\"name\":\"app\"},{\"name\":\"sidecar\"}]}}"}`))
+	}))
+	defer server.Close()
+
+	svc := NewApplicationService(&model.Server{
+		BaseURL: server.URL,
+		Token:   "test-token",
+	})
+
+	containers, err := svc.ListPodContainers(context.Background(), "test-app", nil, "default", "web-1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	want := []string{"app", "sidecar", "init"}
+	if len(containers) != len(want) {
+		t.Fatalf("Expected %v, got %v", want, containers)
+	}
+	for i := range want {
+		if containers[i] != want[i] {
+			t.Errorf("Expected %v, got %v", want, containers)
+			break
+		}
+	}
+}
@@ -0,0 +1,182 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+// ArgoProject represents an ArgoCD AppProject from the API
+type ArgoProject struct {
+	Metadata struct {
+		Name            string `json:"name"`
+		Namespace       string `json:"namespace,omitempty"`
+		ResourceVersion string `json:"resourceVersion,omitempty"`
+	} `json:"metadata"`
+	Spec struct {
+		Description                string               `json:"description,omitempty"`
+		SourceRepos                []string             `json:"sourceRepos,omitempty"`
+		Destinations               []ProjectDestination `json:"destinations,omitempty"`
+		ClusterResourceWhitelist   []GroupKind          `json:"clusterResourceWhitelist,omitempty"`
+		ClusterResourceBlacklist   []GroupKind          `json:"clusterResourceBlacklist,omitempty"`
+		NamespaceResourceWhitelist []GroupKind          `json:"namespaceResourceWhitelist,omitempty"`
+		NamespaceResourceBlacklist []GroupKind          `json:"namespaceResourceBlacklist,omitempty"`
+		Roles                      []ProjectRole        `json:"roles,omitempty"`
+		SyncWindows                []ArgoSyncWindow     `json:"syncWindows,omitempty"`
+	} `json:"spec"`
+}
+
+// ProjectDestination is a cluster and namespace apps of a project may deploy to
+type ProjectDestination struct {
+	Server    string `json:"server,omitempty"`
+	Name      string `json:"name,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// GroupKind identifies a Kubernetes resource type in project allow/deny lists
+type GroupKind struct {
+	Group string `json:"group"`
+	Kind  string `json:"kind"`
+}
+
+// ProjectRole is a project-scoped RBAC role
+type ProjectRole struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Policies    []string `json:"policies,omitempty"`
+	Groups      []string `json:"groups,omitempty"`
+}
+
+// ArgoSyncWindow is a time window in which syncs of matching apps are allowed or denied
+type ArgoSyncWindow struct {
+	Kind         string   `json:"kind"`     // allow or deny
+	Schedule     string   `json:"schedule"` // Cron expression for the window start
+	Duration     string   `json:"duration"`
+	Applications []string `json:"applications,omitempty"`
+	Namespaces   []string `json:"namespaces,omitempty"`
+	Clusters     []string `json:"clusters,omitempty"`
+	ManualSync   bool     `json:"manualSync,omitempty"`
+	TimeZone     string   `json:"timeZone,omitempty"`
+	AndOperator  bool     `json:"andOperator,omitempty"`
+}
+
+// ProjectService provides ArgoCD AppProject operations
+type ProjectService struct {
+	client *Client
+}
+
+// NewProjectService creates a new project service
+func NewProjectService(server *model.Server) *ProjectService {
+	return &ProjectService{
+		client: NewClient(server),
+	}
+}
+
+// ListProjects retrieves all projects visible to the user
+func (s *ProjectService) ListProjects(ctx context.Context) ([]ArgoProject, error) {
+	data, err := s.client.Get(ctx, "/api/v1/projects")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list projects: %w", err)
+	}
+
+	var list struct {
+		Items []ArgoProject `json:"items"`
+	}
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, fmt.Errorf("failed to parse projects response: %w", err)
+	}
+	return list.Items, nil
+}
+
+// GetProject retrieves a single project
+func (s *ProjectService) GetProject(ctx context.Context, name string) (*ArgoProject, error) {
+	if name == "" {
+		return nil, fmt.Errorf("project name is required")
+	}
+	data, err := s.client.Get(ctx, fmt.Sprintf("/api/v1/projects/%s", url.PathEscape(name)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project %s: %w", name, err)
+	}
+
+	var proj ArgoProject
+	if err := json.Unmarshal(data, &proj); err != nil {
+		return nil, fmt.Errorf("failed to decode project response: %w", err)
+	}
+	return &proj, nil
+}
+
+// GetSyncWindows retrieves the sync windows of a project that are active right now
+func (s *ProjectService) GetSyncWindows(ctx context.Context, name string) ([]ArgoSyncWindow, error) {
+	if name == "" {
+		return nil, fmt.Errorf("project name is required")
+	}
+	data, err := s.client.Get(ctx, fmt.Sprintf("/api/v1/projects/%s/syncwindows", url.PathEscape(name)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sync windows of project %s: %w", name, err)
+	}
+
+	var state struct {
+		Windows []ArgoSyncWindow `json:"windows"`
+	}
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("failed to parse sync windows response: %w", err)
+	}
+	return state.Windows, nil
+}
+
+// ConvertToProject converts an ArgoProject and its active sync windows to the domain model
+func ConvertToProject(proj ArgoProject, active []ArgoSyncWindow) model.Project {
+	out := model.Project{
+		Name:                   proj.Metadata.Name,
+		Description:            proj.Spec.Description,
+		SourceRepos:            proj.Spec.SourceRepos,
+		ClusterResourceAllow:   groupKinds(proj.Spec.ClusterResourceWhitelist),
+		ClusterResourceDeny:    groupKinds(proj.Spec.ClusterResourceBlacklist),
+		NamespaceResourceAllow: groupKinds(proj.Spec.NamespaceResourceWhitelist),
+		NamespaceResourceDeny:  groupKinds(proj.Spec.NamespaceResourceBlacklist),
+		SyncWindows:            convertSyncWindows(proj.Spec.SyncWindows),
+		ActiveWindows:          convertSyncWindows(active),
+	}
+	for _, d := range proj.Spec.Destinations {
+		out.Destinations = append(out.Destinations, model.ProjectDestination{Server: d.Server, Name: d.Name, Namespace: d.Namespace})
+	}
+	for _, r := range proj.Spec.Roles {
+		out.Roles = append(out.Roles, model.ProjectRole{Name: r.Name, Description: r.Description, Policies: r.Policies, Groups: r.Groups})
+	}
+	return out
+}
+
+// groupKinds formats allow/deny list entries as "group/kind"; the core group is shown as "core"
+func groupKinds(list []GroupKind) []string {
+	var out []string
+	for _, gk := range list {
+		group := gk.Group
+		if group == "" {
+			group = "core"
+		}
+		out = append(out, group+"/"+gk.Kind)
+	}
+	return out
+}
+
+// convertSyncWindows converts API sync windows to the domain model
+func convertSyncWindows(windows []ArgoSyncWindow) []model.SyncWindow {
+	var out []model.SyncWindow
+	for _, w := range windows {
+		out = append(out, model.SyncWindow{
+			Kind:         w.Kind,
+			Schedule:     w.Schedule,
+			Duration:     w.Duration,
+			TimeZone:     w.TimeZone,
+			Applications: w.Applications,
+			Namespaces:   w.Namespaces,
+			Clusters:     w.Clusters,
+			ManualSync:   w.ManualSync,
+			AndOperator:  w.AndOperator,
+		})
+	}
+	return out
+}
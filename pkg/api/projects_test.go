@@ -0,0 +1,96 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+const testProjectJSON = `{
+	"metadata": {"name": "payments", "namespace": "argocd"},
+	"spec": {
+		"description": "Payments team",
+		"sourceRepos": ["https://github.com/example/payments.git"],
+		"destinations": [
+			{"server": "https://kubernetes.default.svc", "namespace": "payments-*"},
+			{"name": "prod-eu", "namespace": "payments"}
+		],
+		"clusterResourceWhitelist": [{"group": "", "kind": "Namespace"}],
+		"namespaceResourceBlacklist": [{"group": "networking.k8s.io", "kind": "NetworkPolicy"}],
+		"roles": [{"name": "deployer", "description": "CI", "policies": ["p, proj:payments:deployer, applications, sync, payments/*, allow"], "groups": ["ci"]}],
+		"syncWindows": [
+			{"kind": "deny", "schedule": "0 22 * * *", "duration": "8h", "applications": ["*"], "manualSync": true},
+			{"kind": "allow", "schedule": "0 9 * * 1-5", "duration": "9h", "namespaces": ["payments"], "timeZone": "Europe/Riga"}
+		]
+	}
+}`
+
+func TestGetProjectAndSyncWindows_ConvertsSpec(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		switch r.URL.Path {
+		case "/api/v1/projects/payments":
+			fmt.Fprint(w, testProjectJSON)
+		case "/api/v1/projects/payments/syncwindows":
+			fmt.Fprint(w, `{"windows": [{"kind": "deny", "schedule": "0 22 * * *", "duration": "8h", "applications": ["*"], "manualSync": true}]}`)
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	svc := NewProjectService(&model.Server{BaseURL: server.URL, Token: "test-token"})
+	proj, err := svc.GetProject(context.Background(), "payments")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	active, err := svc.GetSyncWindows(context.Background(), "payments")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	got := ConvertToProject(*proj, active)
+	if got.Name != "payments" || got.Description != "Payments team" {
+		t.Errorf("Unexpected name/description: %s %q", got.Name, got.Description)
+	}
+	wantDest := []model.ProjectDestination{
+		{Server: "https://kubernetes.default.svc", Namespace: "payments-*"},
+		{Name: "prod-eu", Namespace: "payments"},
+	}
+	if !reflect.DeepEqual(got.Destinations, wantDest) {
+		t.Errorf("Expected destinations %+v, got %+v", wantDest, got.Destinations)
+	}
+	if !reflect.DeepEqual(got.ClusterResourceAllow, []string{"core/Namespace"}) {
+		t.Errorf("Expected the core group to be named, got %v", got.ClusterResourceAllow)
+	}
+	if !reflect.DeepEqual(got.NamespaceResourceDeny, []string{"networking.k8s.io/NetworkPolicy"}) {
+		t.Errorf("Unexpected namespace deny list %v", got.NamespaceResourceDeny)
+	}
+	if len(got.Roles) != 1 || got.Roles[0].Name != "deployer" || len(got.Roles[0].Policies) != 1 {
+		t.Errorf("Unexpected roles %+v", got.Roles)
+	}
+	if len(got.SyncWindows) != 2 || got.SyncWindows[1].TimeZone != "Europe/Riga" {
+		t.Errorf("Unexpected sync windows %+v", got.SyncWindows)
+	}
+	if len(got.ActiveWindows) != 1 || !got.ActiveWindows[0].ManualSync || !got.IsActive(got.SyncWindows[0]) {
+		t.Errorf("Expected the deny window to be active, got %+v", got.ActiveWindows)
+	}
+}
+
+func TestListProjects_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"error": "permission denied"}`)
+	}))
+	defer server.Close()
+
+	svc := NewProjectService(&model.Server{BaseURL: server.URL, Token: "test-token"})
+	if _, err := svc.ListProjects(context.Background()); err == nil {
+		t.Fatal("Expected an error for a forbidden list")
+	}
+}
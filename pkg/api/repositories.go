@@ -0,0 +1,100 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+// ArgoRepository represents a repository registered in ArgoCD
+type ArgoRepository struct {
+	Repo            string `json:"repo"`
+	Type            string `json:"type,omitempty"` // git, helm; OCI repos are helm repos with enableOCI
+	Name            string `json:"name,omitempty"`
+	Project         string `json:"project,omitempty"`
+	EnableOCI       bool   `json:"enableOCI,omitempty"`
+	ConnectionState struct {
+		Status      string     `json:"status,omitempty"` // Successful, Failed, Unknown
+		Message     string     `json:"message,omitempty"`
+		AttemptedAt *time.Time `json:"attemptedAt,omitempty"`
+	} `json:"connectionState"`
+}
+
+// RepositoryService provides ArgoCD repository operations
+type RepositoryService struct {
+	client *Client
+}
+
+// NewRepositoryService creates a new repository service
+func NewRepositoryService(server *model.Server) *RepositoryService {
+	return &RepositoryService{
+		client: NewClient(server),
+	}
+}
+
+// ListRepositories retrieves the repositories visible to the user with their last known connection state
+func (s *RepositoryService) ListRepositories(ctx context.Context) ([]ArgoRepository, error) {
+	data, err := s.client.Get(ctx, "/api/v1/repositories")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list repositories: %w", err)
+	}
+
+	var list struct {
+		Items []ArgoRepository `json:"items"`
+	}
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, fmt.Errorf("failed to parse repositories response: %w", err)
+	}
+	return list.Items, nil
+}
+
+// RefreshRepository re-checks the connection to a repository and returns its new state.
+// project is only needed for project-scoped repositories.
+func (s *RepositoryService) RefreshRepository(ctx context.Context, repoURL, project string) (*ArgoRepository, error) {
+	if repoURL == "" {
+		return nil, fmt.Errorf("repository URL is required")
+	}
+	params := url.Values{}
+	params.Set("forceRefresh", "true")
+	if project != "" {
+		params.Set("appProject", project)
+	}
+	endpoint := fmt.Sprintf("/api/v1/repositories/%s?%s", url.PathEscape(repoURL), params.Encode())
+	data, err := s.client.Get(ctx, endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to refresh repository %s: %w", repoURL, err)
+	}
+
+	var repo ArgoRepository
+	if err := json.Unmarshal(data, &repo); err != nil {
+		return nil, fmt.Errorf("failed to decode repository response: %w", err)
+	}
+	return &repo, nil
+}
+
+// ConvertToRepository converts an ArgoRepository to the domain model
+func ConvertToRepository(repo ArgoRepository) model.Repository {
+	out := model.Repository{
+		URL:               repo.Repo,
+		Type:              repo.Type,
+		Name:              repo.Name,
+		Project:           repo.Project,
+		ConnectionStatus:  repo.ConnectionState.Status,
+		ConnectionMessage: repo.ConnectionState.Message,
+		AttemptedAt:       repo.ConnectionState.AttemptedAt,
+	}
+	if out.Type == "" {
+		out.Type = "git"
+	}
+	if repo.EnableOCI {
+		out.Type = "oci"
+	}
+	if out.ConnectionStatus == "" {
+		out.ConnectionStatus = "Unknown"
+	}
+	return out
+}
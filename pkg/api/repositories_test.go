@@ -0,0 +1,72 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+func TestListRepositories_ConvertsConnectionState(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/repositories" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"items": [
+			{"repo": "https://github.com/example/apps.git", "project": "payments", "connectionState": {"status": "Successful"}},
+			{"repo": "https://charts.example.com", "type": "helm", "connectionState": {"status": "Failed", "message": "401 Unauthorized"}},
+			{"repo": "registry.example.com/charts", "type": "helm", "enableOCI": true}
+		]}`)
+	}))
+	defer server.Close()
+
+	repos, err := NewRepositoryService(&model.Server{BaseURL: server.URL, Token: "test-token"}).ListRepositories(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(repos) != 3 {
+		t.Fatalf("Expected 3 repositories, got %d", len(repos))
+	}
+
+	want := []model.Repository{
+		{URL: "https://github.com/example/apps.git", Type: "git", Project: "payments", ConnectionStatus: "Successful"},
+		{URL: "https://charts.example.com", Type: "helm", ConnectionStatus: "Failed", ConnectionMessage: "401 Unauthorized"},
+		{URL: "registry.example.com/charts", Type: "oci", ConnectionStatus: "Unknown"},
+	}
+	for i, r := range repos {
+		if got := ConvertToRepository(r); got != want[i] {
+			t.Errorf("ConvertToRepository(%s) = %+v, want %+v", r.Repo, got, want[i])
+		}
+	}
+}
+
+func TestRefreshRepository_ForcesRefresh(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.EscapedPath(); got != "/api/v1/repositories/https:%2F%2Fgithub.com%2Fexample%2Fapps.git" {
+			t.Errorf("Unexpected path %s", got)
+		}
+		if r.URL.Query().Get("forceRefresh") != "true" || r.URL.Query().Get("appProject") != "payments" {
+			t.Errorf("Unexpected query %s", r.URL.RawQuery)
+		}
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"repo": "https://github.com/example/apps.git", "project": "payments", "connectionState": {"status": "Failed", "message": "authentication required"}}`)
+	}))
+	defer server.Close()
+
+	svc := NewRepositoryService(&model.Server{BaseURL: server.URL, Token: "test-token"})
+	repo, err := svc.RefreshRepository(context.Background(), "https://github.com/example/apps.git", "payments")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if repo.ConnectionState.Status != "Failed" || repo.ConnectionState.Message != "authentication required" {
+		t.Errorf("Unexpected connection state %+v", repo.ConnectionState)
+	}
+
+	if _, err := svc.RefreshRepository(context.Background(), "", ""); err == nil {
+		t.Error("Expected an error for an empty repository URL")
+	}
+}
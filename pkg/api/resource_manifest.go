@@ -0,0 +1,107 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// GetResourceParams identifies a single resource managed by an application
+type GetResourceParams struct {
+	AppName      string
+	AppNamespace *string
+	ResourceName string
+	Namespace    string
+	Kind         string
+	Group        string
+	Version      string
+}
+
+// query builds the query parameters ArgoCD expects for the /resource endpoints
+func (p GetResourceParams) query() url.Values {
+	queryParams := url.Values{}
+	queryParams.Set("resourceName", p.ResourceName)
+	queryParams.Set("kind", p.Kind)
+	queryParams.Set("version", p.Version)
+	if p.Namespace != "" {
+		queryParams.Set("namespace", p.Namespace)
+	}
+	if p.Group != "" {
+		queryParams.Set("group", p.Group)
+	}
+	if p.AppNamespace != nil && *p.AppNamespace != "" {
+		queryParams.Set("appNamespace", *p.AppNamespace)
+	}
+	return queryParams
+}
+
+func (p GetResourceParams) validate() error {
+	if p.AppName == "" {
+		return fmt.Errorf("application name is required")
+	}
+	if p.ResourceName == "" {
+		return fmt.Errorf("resource name is required")
+	}
+	if p.Kind == "" {
+		return fmt.Errorf("resource kind is required")
+	}
+	return nil
+}
+
+// GetResource retrieves the live manifest of a resource as a JSON string
+func (s *ApplicationService) GetResource(ctx context.Context, params GetResourceParams) (string, error) {
+	if err := params.validate(); err != nil {
+		return "", err
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/applications/%s/resource?%s", url.PathEscape(params.AppName), params.query().Encode())
+
+	resp, err := s.client.Get(ctx, endpoint)
+	if err != nil {
+		return "", fmt.Errorf("failed to get %s/%s: %w", params.Kind, params.ResourceName, err)
+	}
+
+	// ArgoCD returns { "manifest": "<json encoded object>" }
+	var result struct {
+		Manifest string `json:"manifest"`
+	}
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return "", fmt.Errorf("failed to parse resource response: %w", err)
+	}
+
+	return result.Manifest, nil
+}
+
+// MergePatchType is the patch type for JSON merge patches (RFC 7386)
+const MergePatchType = "application/merge-patch+json"
+
+// PatchResource applies a patch to a live resource and returns the patched manifest as a JSON string.
+// ArgoCD exposes PatchResource as POST on the /resource endpoint with the patch as the request body.
+func (s *ApplicationService) PatchResource(ctx context.Context, params GetResourceParams, patch, patchType string) (string, error) {
+	if err := params.validate(); err != nil {
+		return "", err
+	}
+	if patch == "" {
+		return "", fmt.Errorf("patch is required")
+	}
+
+	query := params.query()
+	query.Set("patchType", patchType)
+	endpoint := fmt.Sprintf("/api/v1/applications/%s/resource?%s", url.PathEscape(params.AppName), query.Encode())
+
+	// The request body is the patch itself, encoded as a JSON string
+	resp, err := s.client.Post(ctx, endpoint, patch)
+	if err != nil {
+		return "", fmt.Errorf("failed to patch %s/%s: %w", params.Kind, params.ResourceName, err)
+	}
+
+	var result struct {
+		Manifest string `json:"manifest"`
+	}
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return "", fmt.Errorf("failed to parse patch response: %w", err)
+	}
+
+	return result.Manifest, nil
+}
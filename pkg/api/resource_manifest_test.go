@@ -0,0 +1,122 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+func TestGetResource_ReturnsManifest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if r.URL.Path != "/api/v1/applications/test-app/resource" {
+			t.Errorf("Expected resource path, got %s", r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("resourceName") != "web" || q.Get("kind") != "Deployment" || q.Get("group") != "apps" ||
+			q.Get("version") != "v1" || q.Get("namespace") != "default" {
+			t.Errorf("Unexpected query: %s", r.URL.RawQuery)
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"manifest":"{\"apiVersion\":\"apps/v1\",\"kind\":\"Deployment\",\"status\":{\"replicas\":2}}"}`))
+	}))
+	defer server.Close()
+
+	svc := NewApplicationService(&model.Server{
+		BaseURL: server.URL,
+		Token:   "test-token",
+	})
+
+	manifest, err := svc.GetResource(context.Background(), GetResourceParams{
+		AppName:      "test-app",
+		ResourceName: "web",
+		Namespace:    "default",
+		Kind:         "Deployment",
+		Group:        "apps",
+		Version:      "v1",
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !strings.Contains(manifest, `"replicas":2`) {
+		t.Errorf("Expected manifest with status, got %s", manifest)
+	}
+}
+
+func TestGetResource_RequiresKindAndName(t *testing.T) {
+	svc := NewApplicationService(&model.Server{BaseURL: "http://localhost"})
+	if _, err := svc.GetResource(context.Background(), GetResourceParams{AppName: "test-app", Kind: "Pod"}); err == nil {
+		t.Error("Expected error for missing resource name")
+	}
+	if _, err := svc.GetResource(context.Background(), GetResourceParams{AppName: "test-app", ResourceName: "web"}); err == nil {
+		t.Error("Expected error for missing kind")
+	}
+}
+
+func TestPatchResource_SendsMergePatch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST request, got %s", r.Method)
+		}
+		if r.URL.Path != "/api/v1/applications/test-app/resource" {
+			t.Errorf("Expected resource path, got %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("patchType"); got != MergePatchType {
+			t.Errorf("Expected patchType %s, got %s", MergePatchType, got)
+		}
+		var patch string
+		if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+			t.Fatalf("Expected patch encoded as JSON string: %v", err)
+		}
+		if patch != `{"data":{"key":"new"}}` {
+			t.Errorf("Unexpected patch: %s", patch)
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"manifest":"{\"kind\":\"ConfigMap\",\"data\":{\"key\":\"new\"}}"}`))
+	}))
+	defer server.Close()
+
+	svc := NewApplicationService(&model.Server{
+		BaseURL: server.URL,
+		Token:   "test-token",
+	})
+
+	manifest, err := svc.PatchResource(context.Background(), GetResourceParams{
+		AppName:      "test-app",
+		ResourceName: "settings",
+		Namespace:    "default",
+		Kind:         "ConfigMap",
+		Version:      "v1",
+	}, `{"data":{"key":"new"}}`, MergePatchType)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !strings.Contains(manifest, `"key":"new"`) {
+		t.Errorf("Expected patched manifest, got %s", manifest)
+	}
+}
+
+func TestPatchResource_ConflictError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+		w.Write([]byte(`{"error":"the object has been modified","code":10,"message":"the object has been modified; please apply your changes to the latest version"}`))
+	}))
+	defer server.Close()
+
+	svc := NewApplicationService(&model.Server{BaseURL: server.URL, Token: "test-token"})
+	_, err := svc.PatchResource(context.Background(), GetResourceParams{
+		AppName:      "test-app",
+		ResourceName: "web",
+		Kind:         "Deployment",
+	}, `{"spec":{"replicas":3}}`, MergePatchType)
+	if err == nil || !strings.Contains(err.Error(), "please apply your changes") {
+		t.Errorf("Expected conflict message from server, got %v", err)
+	}
+}
@@ -0,0 +1,47 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+// SessionService provides ArgoCD session (login) operations
+type SessionService struct {
+	client *Client
+}
+
+// NewSessionService creates a new session service. The server's token is not needed.
+func NewSessionService(server *model.Server) *SessionService {
+	return &SessionService{
+		client: NewClient(server),
+	}
+}
+
+// CreateSession logs in with a local account and returns the session token
+func (s *SessionService) CreateSession(ctx context.Context, username, password string) (string, error) {
+	if username == "" || password == "" {
+		return "", fmt.Errorf("username and password are required")
+	}
+	body := map[string]string{
+		"username": username,
+		"password": password,
+	}
+	data, err := s.client.Post(ctx, "/api/v1/session", body)
+	if err != nil {
+		return "", fmt.Errorf("failed to log in: %w", err)
+	}
+
+	var resp struct {
+		Token string `json:"token"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return "", fmt.Errorf("failed to decode session response: %w", err)
+	}
+	if resp.Token == "" {
+		return "", fmt.Errorf("server returned no session token")
+	}
+	return resp.Token, nil
+}
@@ -1,6 +1,7 @@
 package autocomplete
 
 import (
+	"slices"
 	"sort"
 	"strings"
 
@@ -15,6 +16,9 @@ type CommandAlias struct {
 	Description string   // Help text for the command
 	TakesArg    bool     // Whether command accepts an argument
 	ArgType     string   // Type of argument (e.g., "app", "cluster")
+	// Operation is the app operation the command performs; its app suggestions only include
+	// apps the user's ArgoCD role allows it on
+	Operation model.AppOperation
 }
 
 // AliasMap maps all command variants to their canonical command
@@ -64,12 +68,27 @@ func NewAutocompleteEngine() *AutocompleteEngine {
 			TakesArg:    true,
 			ArgType:     "appset",
 		},
+		{
+			Command:     "repo",
+			Aliases:     []string{"repo", "repos", "repository", "repositories"},
+			Description: "Navigate to repositories view",
+			TakesArg:    true,
+			ArgType:     "repo",
+		},
+		{
+			Command:     "label",
+			Aliases:     []string{"label", "labels"},
+			Description: "Navigate to labels view",
+			TakesArg:    true,
+			ArgType:     "label",
+		},
 		{
 			Command:     "sync",
 			Aliases:     []string{"sync", "s"},
 			Description: "Sync selected applications",
 			TakesArg:    true,
 			ArgType:     "app",
+			Operation:   model.OpSync,
 		},
 		{
 			Command:     "diff",
@@ -84,6 +103,7 @@ func NewAutocompleteEngine() *AutocompleteEngine {
 			Description: "Rollback application to previous revision",
 			TakesArg:    true,
 			ArgType:     "app",
+			Operation:   model.OpRollback,
 		},
 		{
 			Command:     "delete",
@@ -91,6 +111,14 @@ func NewAutocompleteEngine() *AutocompleteEngine {
 			Description: "Delete application",
 			TakesArg:    true,
 			ArgType:     "app",
+			Operation:   model.OpDelete,
+		},
+		{
+			Command:     "terminate",
+			Aliases:     []string{"terminate", "term", "stop"},
+			Description: "Terminate the running operation (e.g. a stuck sync)",
+			TakesArg:    true,
+			ArgType:     "app",
 		},
 		{
 			Command:     "resources",
@@ -155,6 +183,21 @@ func NewAutocompleteEngine() *AutocompleteEngine {
 			TakesArg:    true,
 			ArgType:     "sort",
 		},
+		{
+			Command:     "columns",
+			Aliases:     []string{"columns", "cols"},
+			Description: "Toggle apps list columns (e.g., :columns synced,lastsync)",
+			TakesArg:    true,
+			ArgType:     "column",
+		},
+		{
+			Command:     "autosync",
+			Aliases:     []string{"autosync", "auto-sync"},
+			Description: "Show or change automated sync (e.g., :autosync off, :autosync selfheal on)",
+			TakesArg:    true,
+			ArgType:     "autosync",
+			Operation:   model.OpUpdate,
+		},
 		{
 			Command:     "changelog",
 			Aliases:     []string{"changelog", "whatsnew", "news"},
@@ -175,6 +218,7 @@ func NewAutocompleteEngine() *AutocompleteEngine {
 			Description: "Refresh application (compare with git)",
 			TakesArg:    true,
 			ArgType:     "app",
+			Operation:   model.OpRefresh,
 		},
 		{
 			Command:     "refresh!",
@@ -182,6 +226,7 @@ func NewAutocompleteEngine() *AutocompleteEngine {
 			Description: "Hard refresh application (invalidate cache)",
 			TakesArg:    true,
 			ArgType:     "app",
+			Operation:   model.OpRefresh,
 		},
 	}
 
@@ -252,32 +297,32 @@ func (e *AutocompleteEngine) GetCommandAutocomplete(input string, state *model.A
 		}
 	}
 
+	// Flag completion for :sync (e.g., ":sync my-app --ser")
+	if cmdInfo := e.GetCommandInfo(parts[0]); cmdInfo != nil && cmdInfo.Command == "sync" {
+		if last := parts[len(parts)-1]; !hasTrailingSpace && strings.HasPrefix(last, "-") {
+			return e.getSyncFlagSuggestions(parts)
+		}
+	}
+
+	// Multi-key completion for :sort (e.g., ":sort health desc, last")
+	if cmdInfo := e.GetCommandInfo(parts[0]); cmdInfo != nil && cmdInfo.Command == "sort" && len(parts) >= 2 {
+		return e.getSortSpecSuggestions(input, hasTrailingSpace)
+	}
+
+	// Option values for :autosync (e.g., ":autosync prune o")
+	if cmdInfo := e.GetCommandInfo(parts[0]); cmdInfo != nil && cmdInfo.Command == "autosync" && (len(parts) == 3 || hasTrailingSpace) {
+		return e.getAutoSyncValueSuggestions(parts, hasTrailingSpace)
+	}
+
 	if len(parts) == 2 {
 		if hasTrailingSpace {
-			// First arg is complete, suggest second argument if applicable (e.g., ":sort name ")
-			return e.getSecondArgumentSuggestions(parts[0], parts[1], "", true, state)
-		}
-		// Check if first arg exactly matches a valid option for commands with second args
-		// This shows direction suggestions right after typing a complete field name
-		cmdInfo := e.GetCommandInfo(parts[0])
-		if cmdInfo != nil && cmdInfo.Command == "sort" {
-			firstArgSuggestions := e.getSortSuggestions("")
-			for _, s := range firstArgSuggestions {
-				if strings.EqualFold(s, parts[1]) {
-					// First arg is complete, suggest second argument (direction is required)
-					return e.getSecondArgumentSuggestions(parts[0], parts[1], "", false, state)
-				}
-			}
+			// Argument is complete; only :sort takes more than one
+			return nil
 		}
 		// Argument completion (e.g., ":cluster pr")
 		return e.getArgumentSuggestions(parts[0], parts[1], state)
 	}
 
-	if len(parts) == 3 {
-		// Second argument completion (e.g., ":sort name as")
-		return e.getSecondArgumentSuggestions(parts[0], parts[1], parts[2], hasTrailingSpace, state)
-	}
-
 	return nil
 }
 
@@ -328,12 +373,23 @@ func (e *AutocompleteEngine) getArgumentSuggestions(command, argPrefix string, s
 		suggestions = e.getProjectSuggestions(argPrefix, state)
 	case "app":
 		suggestions = e.getAppSuggestions(argPrefix, state)
+		if cmdInfo.Operation != "" {
+			suggestions = e.filterPermittedApps(suggestions, cmdInfo.Operation, state)
+		}
 	case "appset":
 		suggestions = e.getAppSetSuggestions(argPrefix, state)
+	case "repo":
+		suggestions = e.getRepoSuggestions(argPrefix, state)
+	case "label":
+		suggestions = e.getLabelSuggestions(argPrefix, state)
 	case "theme":
 		suggestions = e.getThemeSuggestions(argPrefix)
 	case "sort":
 		suggestions = e.getSortSuggestions(argPrefix)
+	case "column":
+		suggestions = e.getColumnSuggestions(argPrefix, state)
+	case "autosync":
+		suggestions = e.getAutoSyncSuggestions(argPrefix)
 	case "argocd-context":
 		suggestions = e.getArgocdContextSuggestions(argPrefix, state)
 	}
@@ -362,6 +418,14 @@ func (e *AutocompleteEngine) getClusterSuggestions(prefix string, state *model.A
 			seen[cluster] = true
 		}
 	}
+	// Registered clusters without apps
+	for label := range state.Clusters {
+		cluster := strings.ToLower(label)
+		if strings.HasPrefix(cluster, prefix) && !seen[cluster] {
+			suggestions = append(suggestions, label)
+			seen[cluster] = true
+		}
+	}
 
 	sort.Strings(suggestions)
 	return suggestions
@@ -446,6 +510,48 @@ func (e *AutocompleteEngine) getAppSetSuggestions(prefix string, state *model.Ap
 			seen[appset] = true
 		}
 	}
+	// ApplicationSets from the API, including those that have not generated any apps
+	if state.ApplicationSets != nil {
+		for name := range state.ApplicationSets.Items {
+			appset := strings.ToLower(name)
+			if strings.HasPrefix(appset, prefix) && !seen[appset] {
+				suggestions = append(suggestions, name)
+				seen[appset] = true
+			}
+		}
+	}
+
+	sort.Strings(suggestions)
+	return suggestions
+}
+
+// getRepoSuggestions returns repository URL suggestions; the prefix may omit the URL scheme
+func (e *AutocompleteEngine) getRepoSuggestions(prefix string, state *model.AppState) []string {
+	var suggestions []string
+	seen := make(map[string]bool)
+	add := func(repoURL string) {
+		key := model.NormalizeRepoURL(repoURL)
+		if key == "" || seen[key] {
+			return
+		}
+		lower := strings.ToLower(repoURL)
+		if _, rest, ok := strings.Cut(lower, "://"); strings.HasPrefix(lower, prefix) || (ok && strings.HasPrefix(rest, prefix)) {
+			suggestions = append(suggestions, repoURL)
+			seen[key] = true
+		}
+	}
+
+	// Registered repositories first so their spelling wins over the apps' repoURLs
+	if state.Repositories != nil {
+		for _, repo := range state.Repositories.Items {
+			add(repo.URL)
+		}
+	}
+	for _, app := range state.Apps {
+		for _, repoURL := range app.RepoURLs {
+			add(repoURL)
+		}
+	}
 
 	sort.Strings(suggestions)
 	return suggestions
@@ -484,6 +590,33 @@ func (e *AutocompleteEngine) getAppSuggestions(prefix string, state *model.AppSt
 	return suggestions
 }
 
+// filterPermittedApps drops apps the user's ArgoCD role does not allow op on
+func (e *AutocompleteEngine) filterPermittedApps(names []string, op model.AppOperation, state *model.AppState) []string {
+	if len(state.Permissions) == 0 {
+		return names
+	}
+	allowed := make(map[string]bool, len(state.Apps))
+	for _, app := range state.Apps {
+		allowed[app.Name] = state.Permissions.OperationAllowedForApp(app, op)
+	}
+	filtered := names[:0]
+	for _, name := range names {
+		if allowed[name] {
+			filtered = append(filtered, name)
+		}
+	}
+	return filtered
+}
+
+// CommandPermitted reports whether the user's ArgoCD role allows a command on at least one app
+func (e *AutocompleteEngine) CommandPermitted(command string, state *model.AppState) bool {
+	cmdInfo := e.GetCommandInfo(command)
+	if cmdInfo == nil || cmdInfo.Operation == "" {
+		return true
+	}
+	return state.Permissions.OperationAvailable(state.Apps, cmdInfo.Operation)
+}
+
 // getThemeSuggestions returns available theme suggestions
 func (e *AutocompleteEngine) getThemeSuggestions(prefix string) []string {
 	var suggestions []string
@@ -516,19 +649,14 @@ func (e *AutocompleteEngine) getArgocdContextSuggestions(prefix string, state *m
 	return suggestions
 }
 
-// getSortSuggestions returns available sort option suggestions
+// getSortSuggestions returns the sort fields starting with prefix
 func (e *AutocompleteEngine) getSortSuggestions(prefix string) []string {
-	// Sort suggestions are just field names - direction is a second argument
-	options := []string{
-		"name", "sync", "health",
-	}
-
 	var suggestions []string
 	prefix = strings.ToLower(prefix)
 
-	for _, opt := range options {
-		if strings.HasPrefix(strings.ToLower(opt), prefix) {
-			suggestions = append(suggestions, opt)
+	for _, f := range model.ValidSortFields() {
+		if strings.HasPrefix(strings.ToLower(string(f)), prefix) {
+			suggestions = append(suggestions, string(f))
 		}
 	}
 
@@ -536,48 +664,137 @@ func (e *AutocompleteEngine) getSortSuggestions(prefix string) []string {
 	return suggestions
 }
 
-// getSecondArgumentSuggestions returns suggestions for a second argument (e.g., sort direction)
-// The hasTrailingSpace parameter indicates if the original input had a trailing space after the current token
-func (e *AutocompleteEngine) getSecondArgumentSuggestions(command, firstArg, prefix string, hasTrailingSpace bool, state *model.AppState) []string {
-	cmdInfo := e.GetCommandInfo(command)
-	if cmdInfo == nil {
-		return nil
+// getAutoSyncSuggestions returns the first :autosync argument: on, off or an option name
+func (e *AutocompleteEngine) getAutoSyncSuggestions(prefix string) []string {
+	var suggestions []string
+	for _, arg := range append([]string{"on", "off"}, model.AutoSyncFlags...) {
+		if strings.HasPrefix(arg, prefix) {
+			suggestions = append(suggestions, arg)
+		}
 	}
+	sort.Strings(suggestions)
+	return suggestions
+}
 
-	// Currently only sort command has a second argument
-	if cmdInfo.Command != "sort" {
+// getAutoSyncValueSuggestions completes the on/off value after an :autosync option name
+func (e *AutocompleteEngine) getAutoSyncValueSuggestions(parts []string, hasTrailingSpace bool) []string {
+	if len(parts) > 3 || (len(parts) == 3 && hasTrailingSpace) {
+		return nil
+	}
+	option := strings.ToLower(parts[1])
+	if !slices.Contains(model.AutoSyncFlags, option) {
 		return nil
 	}
+	prefix := ""
+	if len(parts) == 3 {
+		prefix = strings.ToLower(parts[2])
+	}
+	var suggestions []string
+	for _, value := range []string{"off", "on"} {
+		if strings.HasPrefix(value, prefix) {
+			suggestions = append(suggestions, ":"+parts[0]+" "+parts[1]+" "+value)
+		}
+	}
+	return suggestions
+}
+
+// getSortSpecSuggestions completes the last "field direction" pair of a :sort command such as
+// ":sort health desc, lastSync asc": a field, then its direction once the field is complete
+func (e *AutocompleteEngine) getSortSpecSuggestions(input string, hasTrailingSpace bool) []string {
+	if hasTrailingSpace {
+		input += " "
+	}
+	// input is ":sort ..." - everything before the last pair stays as typed so that
+	// suggestions extend the input
+	cmd, args, _ := strings.Cut(input, " ")
+	head := cmd + " "
+	if i := strings.LastIndex(args, ","); i >= 0 {
+		head += args[:i+1]
+		args = args[i+1:]
+	}
+	trimmed := strings.TrimLeft(args, " ")
+	head += args[:len(args)-len(trimmed)]
+	if trimmed == "" && !strings.HasSuffix(head, " ") {
+		head += " "
+	}
+	words := strings.Fields(trimmed)
+	trailing := strings.HasSuffix(trimmed, " ")
 
-	// Suggest direction options
-	options := []string{"asc", "desc"}
 	var suggestions []string
-	prefix = strings.ToLower(prefix)
+	switch {
+	case len(words) == 0:
+		for _, f := range e.getSortSuggestions("") {
+			suggestions = append(suggestions, head+f)
+		}
+	case len(words) == 1 && !trailing && !model.IsValidSortField(words[0]):
+		for _, f := range e.getSortSuggestions(words[0]) {
+			suggestions = append(suggestions, head+f)
+		}
+	case len(words) == 1:
+		// Field is complete, suggest the direction (it is required)
+		for _, d := range model.ValidSortDirections() {
+			suggestions = append(suggestions, head+words[0]+" "+string(d))
+		}
+	case len(words) == 2 && !trailing:
+		for _, d := range model.ValidSortDirections() {
+			if strings.HasPrefix(string(d), strings.ToLower(words[1])) {
+				suggestions = append(suggestions, head+words[0]+" "+string(d))
+			}
+		}
+	}
+	return suggestions
+}
+
+// getColumnSuggestions completes the last of comma-separated :columns names, including a
+// label:<key> column for every label key
+func (e *AutocompleteEngine) getColumnSuggestions(prefix string, state *model.AppState) []string {
+	done, current := "", prefix
+	if i := strings.LastIndex(prefix, ","); i >= 0 {
+		done, current = prefix[:i+1], prefix[i+1:]
+	}
+	options := []string{"none"}
+	for _, c := range model.AppColumns {
+		options = append(options, c.Name)
+	}
+	if state.Index != nil {
+		for _, key := range state.Index.LabelKeys {
+			options = append(options, "label:"+key)
+		}
+	}
 
+	var suggestions []string
 	for _, opt := range options {
-		if strings.HasPrefix(opt, prefix) {
-			suggestions = append(suggestions, opt)
+		if strings.HasPrefix(strings.ToLower(opt), current) && (done == "" || opt != "none") {
+			suggestions = append(suggestions, done+opt)
 		}
 	}
+	sort.Strings(suggestions)
+	return suggestions
+}
 
-	// Build suggestions that match the input format exactly
-	// When hasTrailingSpace is true and prefix is empty, input is "sort name "
-	// so suggestion should be "sort name asc" (matching the space)
-	prefixedSuggestions := make([]string, len(suggestions))
-	for i, suggestion := range suggestions {
-		if hasTrailingSpace && prefix == "" {
-			// Input: "sort name " -> Suggestion: "sort name asc"
-			prefixedSuggestions[i] = ":" + command + " " + firstArg + " " + suggestion
-		} else if prefix != "" {
-			// Input: "sort name a" or "sort name as" -> Suggestion: "sort name asc"
-			prefixedSuggestions[i] = ":" + command + " " + firstArg + " " + suggestion
+// getSyncFlagSuggestions completes the last token of a :sync command to a known flag,
+// skipping flags that were already given
+func (e *AutocompleteEngine) getSyncFlagSuggestions(parts []string) []string {
+	last := parts[len(parts)-1]
+	used := make(map[string]bool)
+	for _, p := range parts[1 : len(parts)-1] {
+		name, _, _ := strings.Cut(p, "=")
+		used[name] = true
+	}
+
+	head := ":" + strings.Join(parts[:len(parts)-1], " ") + " "
+	var suggestions []string
+	for _, flag := range model.SyncFlags {
+		if used[flag.Name] || !strings.HasPrefix(flag.Name, strings.ToLower(last)) {
+			continue
+		}
+		if flag.TakesValue {
+			suggestions = append(suggestions, head+flag.Name+"=")
 		} else {
-			// Input: "sort name" (no trailing space) -> Suggestion: "sort name asc" (add space before direction)
-			prefixedSuggestions[i] = ":" + command + " " + firstArg + " " + suggestion
+			suggestions = append(suggestions, head+flag.Name)
 		}
 	}
-
-	return prefixedSuggestions
+	return suggestions
 }
 
 // GetAllCommands returns all available commands for help/reference
@@ -273,14 +273,14 @@ func TestSortCommandAutocomplete(t *testing.T) {
 
 	// Test sort field suggestions with trailing space
 	suggestions := engine.GetCommandAutocomplete(":sort ", state)
-	expectedFields := []string{":sort health", ":sort name", ":sort sync"}
+	expectedFields := []string{":sort appset", ":sort cluster", ":sort health", ":sort lastSync", ":sort name", ":sort namespace", ":sort phase", ":sort project", ":sort repo", ":sort sync"}
 	if !reflect.DeepEqual(suggestions, expectedFields) {
 		t.Errorf("Expected %v, got %v", expectedFields, suggestions)
 	}
 
 	// Test partial field completion
-	suggestions = engine.GetCommandAutocomplete(":sort n", state)
-	expected := []string{":sort name"}
+	suggestions = engine.GetCommandAutocomplete(":sort h", state)
+	expected := []string{":sort health"}
 	if !reflect.DeepEqual(suggestions, expected) {
 		t.Errorf("Expected %v, got %v", expected, suggestions)
 	}
@@ -298,6 +298,44 @@ func TestSortCommandAutocomplete(t *testing.T) {
 	if !reflect.DeepEqual(suggestions, expected) {
 		t.Errorf("Expected %v, got %v", expected, suggestions)
 	}
+
+	// Further keys after a comma complete the same way, keeping the earlier keys as typed
+	suggestions = engine.GetCommandAutocomplete(":sort health desc, last", state)
+	expected = []string{":sort health desc, lastSync"}
+	if !reflect.DeepEqual(suggestions, expected) {
+		t.Errorf("Expected %v, got %v", expected, suggestions)
+	}
+	suggestions = engine.GetCommandAutocomplete(":sort health desc,lastSync a", state)
+	expected = []string{":sort health desc,lastSync asc"}
+	if !reflect.DeepEqual(suggestions, expected) {
+		t.Errorf("Expected %v, got %v", expected, suggestions)
+	}
+}
+
+func TestSyncCommandFlagAutocomplete(t *testing.T) {
+	engine := NewAutocompleteEngine()
+	state := createTestState()
+
+	// Flags complete after an app name
+	suggestions := engine.GetCommandAutocomplete(":sync frontend --ser", state)
+	expected := []string{":sync frontend --server-side"}
+	if !reflect.DeepEqual(suggestions, expected) {
+		t.Errorf("Expected %v, got %v", expected, suggestions)
+	}
+
+	// Value flags complete with a trailing "=" and used flags are skipped
+	suggestions = engine.GetCommandAutocomplete(":sync --server-side --re", state)
+	expected = []string{":sync --server-side --revision=", ":sync --server-side --replace", ":sync --server-side --respect-ignore-differences", ":sync --server-side --retry-limit=", ":sync --server-side --retry-backoff-duration=", ":sync --server-side --retry-backoff-factor=", ":sync --server-side --retry-backoff-max-duration="}
+	if !reflect.DeepEqual(suggestions, expected) {
+		t.Errorf("Expected %v, got %v", expected, suggestions)
+	}
+
+	// App names still complete as the first argument
+	suggestions = engine.GetCommandAutocomplete(":sync fr", state)
+	expected = []string{":sync frontend"}
+	if !reflect.DeepEqual(suggestions, expected) {
+		t.Errorf("Expected %v, got %v", expected, suggestions)
+	}
 }
 
 // TestSortCommandRequiresDirection tests that ":sort name" (without direction)
@@ -448,6 +486,25 @@ func TestAppSetCommandAutocomplete(t *testing.T) {
 	}
 }
 
+func TestAppSetCommandAutocomplete_IncludesSetsWithoutApps(t *testing.T) {
+	engine := NewAutocompleteEngine()
+	appset := "nerdy-demo"
+	state := &model.AppState{
+		Apps: []model.App{{Name: "app-1", ApplicationSet: &appset}},
+		ApplicationSets: &model.ApplicationSetsState{Items: map[string]model.ApplicationSet{
+			"nerdy-demo": {Name: "nerdy-demo"},
+			"empty-set":  {Name: "empty-set"},
+		}},
+		Selections: *model.NewSelectionState(),
+	}
+
+	suggestions := engine.GetArgumentSuggestions("appset", "", state)
+	want := []string{":appset empty-set", ":appset nerdy-demo"}
+	if len(suggestions) != len(want) || suggestions[0] != want[0] || suggestions[1] != want[1] {
+		t.Errorf("Expected %v, got %v", want, suggestions)
+	}
+}
+
 func TestAppSetCommandAutocomplete_NoAppSets(t *testing.T) {
 	engine := NewAutocompleteEngine()
 
@@ -465,3 +522,112 @@ func TestAppSetCommandAutocomplete_NoAppSets(t *testing.T) {
 		t.Errorf("Expected 0 suggestions when no apps have ApplicationSet, got %d: %v", len(suggestions), suggestions)
 	}
 }
+
+func TestRepoCommandAutocomplete(t *testing.T) {
+	engine := NewAutocompleteEngine()
+	state := &model.AppState{
+		Apps: []model.App{
+			{Name: "app-1", RepoURLs: []string{"https://github.com/example/apps"}},
+			{Name: "app-2", RepoURLs: []string{"https://charts.example.com"}},
+		},
+		Repositories: &model.RepositoriesState{Items: map[string]model.Repository{
+			"https://github.com/example/apps": {URL: "https://github.com/example/apps.git"},
+		}},
+		Selections: *model.NewSelectionState(),
+	}
+
+	if engine.ResolveAlias("repos") != "repo" {
+		t.Error("'repos' should resolve to 'repo'")
+	}
+
+	// Registered spelling wins and the scheme can be left out of the prefix
+	suggestions := engine.GetArgumentSuggestions("repo", "github", state)
+	if len(suggestions) != 1 || suggestions[0] != ":repo https://github.com/example/apps.git" {
+		t.Errorf("Expected the registered repo URL, got %v", suggestions)
+	}
+	if all := engine.GetArgumentSuggestions("repo", "", state); len(all) != 2 {
+		t.Errorf("Expected 2 suggestions, got %v", all)
+	}
+}
+
+func TestPermissionAwareAutocomplete(t *testing.T) {
+	engine := NewAutocompleteEngine()
+	state := createTestState()
+	state.Permissions = model.Permissions{
+		"ecommerce": {"sync": false, "delete": false},
+		"platform":  {"delete": false},
+	}
+
+	suggestions := engine.GetArgumentSuggestions("sync", "", state)
+	expected := []string{":sync analytics", ":sync cache"}
+	if !reflect.DeepEqual(suggestions, expected) {
+		t.Errorf("Expected only apps the role may sync, got %v", suggestions)
+	}
+	if all := engine.GetArgumentSuggestions("diff", "", state); len(all) != len(state.Apps) {
+		t.Errorf("Expected read-only commands to suggest every app, got %v", all)
+	}
+
+	if !engine.CommandPermitted("s", state) {
+		t.Error("Expected sync to be permitted for some apps")
+	}
+	if engine.CommandPermitted("rm", state) {
+		t.Error("Expected delete to be denied in every project")
+	}
+	if !engine.CommandPermitted("logs", state) {
+		t.Error("Expected commands without an operation to be permitted")
+	}
+}
+
+func TestLabelAutocomplete(t *testing.T) {
+	engine := NewAutocompleteEngine()
+	state := &model.AppState{
+		Apps: []model.App{
+			{Name: "app-1", Labels: map[string]string{"team": "core", "env": "prod"}},
+			{Name: "app-2", Labels: map[string]string{"team": "web"}},
+		},
+		Selections: *model.NewSelectionState(),
+	}
+
+	if engine.ResolveAlias("labels") != "label" {
+		t.Error("'labels' should resolve to 'label'")
+	}
+	if got := engine.GetArgumentSuggestions("label", "te", state); len(got) != 1 || got[0] != ":label team" {
+		t.Errorf("Expected the team key, got %v", got)
+	}
+	got := engine.GetArgumentSuggestions("label", "team=", state)
+	if len(got) != 2 || got[0] != ":label team=core" || got[1] != ":label team=web" {
+		t.Errorf("Expected the team values, got %v", got)
+	}
+}
+
+func TestColumnAutocomplete(t *testing.T) {
+	engine := NewAutocompleteEngine()
+	apps := []model.App{{Name: "app-1", Labels: map[string]string{"team": "core"}}}
+	state := &model.AppState{Apps: apps, Index: model.BuildAppIndex(apps), Selections: *model.NewSelectionState()}
+
+	if got := engine.GetArgumentSuggestions("columns", "sy", state); len(got) != 1 || got[0] != ":columns synced" {
+		t.Errorf("Expected the synced column, got %v", got)
+	}
+	// The last of comma-separated names is completed, including label columns
+	if got := engine.GetArgumentSuggestions("cols", "project,la", state); len(got) != 2 || got[0] != ":cols project,label:team" || got[1] != ":cols project,lastsync" {
+		t.Errorf("Expected label and lastsync columns, got %v", got)
+	}
+}
+
+func TestAutoSyncAutocomplete(t *testing.T) {
+	engine := NewAutocompleteEngine()
+	state := &model.AppState{Selections: *model.NewSelectionState()}
+
+	if got := engine.GetCommandAutocomplete(":autosync s", state); len(got) != 1 || got[0] != ":autosync selfheal" {
+		t.Errorf("Expected the selfheal option, got %v", got)
+	}
+	if got := engine.GetCommandAutocomplete(":autosync prune ", state); len(got) != 2 || got[0] != ":autosync prune off" || got[1] != ":autosync prune on" {
+		t.Errorf("Expected on/off values, got %v", got)
+	}
+	if got := engine.GetCommandAutocomplete(":auto-sync selfheal of", state); len(got) != 1 || got[0] != ":auto-sync selfheal off" {
+		t.Errorf("Expected the off value, got %v", got)
+	}
+	if got := engine.GetCommandAutocomplete(":autosync off ", state); len(got) != 0 {
+		t.Errorf("Expected no suggestions after on/off, got %v", got)
+	}
+}
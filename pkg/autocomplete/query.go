@@ -0,0 +1,150 @@
+package autocomplete
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+// queryStatusValues are the known values of the status fields of the filter query language
+var queryStatusValues = map[string][]string{
+	"health": {"Healthy", "Progressing", "Degraded", "Suspended", "Missing", "Unknown"},
+	"sync":   {"Synced", "OutOfSync", "Unknown"},
+	"phase":  {"Running", "Terminating", "Succeeded", "Failed", "Error"},
+}
+
+// GetQueryAutocomplete completes the last term of an app filter query (see model.AppQuery):
+// field names, then the values known for that field. Each suggestion is the whole input with
+// the last term completed.
+func (e *AutocompleteEngine) GetQueryAutocomplete(input string, state *model.AppState) []string {
+	if input == "" || strings.HasSuffix(input, " ") {
+		return nil
+	}
+	start := strings.LastIndexAny(input, " (|") + 1
+	term := strings.TrimLeft(input[start:], "-!")
+	head := input[:len(input)-len(term)]
+	if term == "" {
+		return nil
+	}
+
+	name, value, hasValue := strings.Cut(term, ":")
+	if !hasValue {
+		var suggestions []string
+		prefix := strings.ToLower(term)
+		for _, f := range model.QueryFields {
+			if !strings.HasPrefix(f.Name, prefix) {
+				continue
+			}
+			if f.Compare {
+				suggestions = append(suggestions, head+f.Name+">")
+			} else {
+				suggestions = append(suggestions, head+f.Name+":")
+			}
+		}
+		return suggestions
+	}
+
+	field, ok := model.LookupQueryField(name)
+	if !ok || field.Compare {
+		return nil
+	}
+	// Complete the last of comma-separated alternatives
+	done, current := "", value
+	if i := strings.LastIndex(value, ","); i >= 0 {
+		done, current = value[:i+1], value[i+1:]
+	}
+	if strings.HasPrefix(current, "~") {
+		// Substring matches are free text
+		return nil
+	}
+
+	var candidates []string
+	if field.Name == "label" {
+		candidates = e.getLabelQuerySuggestions(current, state)
+	} else {
+		candidates = e.getQueryFieldValues(field.Name, strings.ToLower(current), state)
+	}
+	var suggestions []string
+	for _, c := range candidates {
+		if strings.EqualFold(c, current) {
+			continue
+		}
+		if strings.ContainsAny(c, " \t") {
+			// Quote the value so the query keeps it as one term
+			if k, v, ok := strings.Cut(c, "="); ok && field.Name == "label" {
+				c = k + `="` + v + `"`
+			} else {
+				c = `"` + c + `"`
+			}
+		}
+		suggestions = append(suggestions, head+name+":"+done+c)
+	}
+	return suggestions
+}
+
+// getQueryFieldValues returns the known values of a query field starting with prefix
+func (e *AutocompleteEngine) getQueryFieldValues(field, prefix string, state *model.AppState) []string {
+	var values []string
+	switch field {
+	case "name":
+		return e.getAppSuggestions(prefix, state)
+	case "repo":
+		return e.getRepoSuggestions(prefix, state)
+	case "health", "sync", "phase":
+		values = queryStatusValues[field]
+	case "project", "cluster", "namespace", "appset":
+		if idx := state.Index; idx != nil {
+			values = map[string][]string{
+				"project":   idx.Projects,
+				"cluster":   idx.Clusters,
+				"namespace": idx.Namespaces,
+				"appset":    idx.ApplicationSets,
+			}[field]
+		}
+	}
+
+	var suggestions []string
+	for _, v := range values {
+		if strings.HasPrefix(strings.ToLower(v), prefix) {
+			suggestions = append(suggestions, v)
+		}
+	}
+	return suggestions
+}
+
+// getLabelQuerySuggestions completes label keys ("team=") and, after the "=", that key's values
+func (e *AutocompleteEngine) getLabelQuerySuggestions(current string, state *model.AppState) []string {
+	key, value, hasValue := strings.Cut(current, "=")
+	value = strings.TrimPrefix(value, `"`)
+	seen := make(map[string]bool)
+	var suggestions []string
+	for _, app := range state.Apps {
+		for k, v := range app.Labels {
+			var candidate string
+			switch {
+			case !hasValue && strings.HasPrefix(strings.ToLower(k), strings.ToLower(key)):
+				candidate = k + "="
+			case hasValue && strings.EqualFold(k, key) && strings.HasPrefix(strings.ToLower(v), strings.ToLower(value)):
+				candidate = k + "=" + v
+			default:
+				continue
+			}
+			if !seen[candidate] {
+				seen[candidate] = true
+				suggestions = append(suggestions, candidate)
+			}
+		}
+	}
+	sort.Strings(suggestions)
+	return suggestions
+}
+
+// getLabelSuggestions completes :label arguments: bare label keys, then key=value selectors
+func (e *AutocompleteEngine) getLabelSuggestions(prefix string, state *model.AppState) []string {
+	suggestions := e.getLabelQuerySuggestions(prefix, state)
+	for i, s := range suggestions {
+		suggestions[i] = strings.TrimSuffix(s, "=")
+	}
+	return suggestions
+}
@@ -0,0 +1,48 @@
+package autocomplete
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+func TestQueryAutocomplete(t *testing.T) {
+	engine := NewAutocompleteEngine()
+	state := createTestState()
+	state.Apps[0].Labels = map[string]string{"team": "core", "env": "prod"}
+	state.Apps[1].Labels = map[string]string{"team": "payments", "owner": "platform team"}
+	state.Index = model.BuildAppIndex(state.Apps)
+
+	tests := []struct {
+		input string
+		want  []string
+	}{
+		{"", nil},
+		{"health:Degraded ", nil},
+		{"he", []string{"health:"}},
+		{"a", []string{"appset:", "age>"}},
+		{"health:deg", []string{"health:Degraded"}},
+		{"-sync:Out", []string{"-sync:OutOfSync"}},
+		{"health:Degraded,Mi", []string{"health:Degraded,Missing"}},
+		{"sync:Synced proj:eco", []string{"sync:Synced proj:ecommerce"}},
+		{"(health:Healthy OR cluster:st", []string{"(health:Healthy OR cluster:staging"}},
+		{"label:te", []string{"label:team="}},
+		{"label:team=", []string{"label:team=core", "label:team=payments"}},
+		{"label:ow", []string{"label:owner="}},
+		{"label:owner=p", []string{`label:owner="platform team"`}},
+		{`label:owner="pl`, []string{`label:owner="platform team"`}},
+		{"name:back", []string{"name:backend"}},
+		{"repo:~chart", nil},
+		{"colour:r", nil},
+		{"age>7", nil},
+		{"health:Degraded", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := engine.GetQueryAutocomplete(tt.input, state); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetQueryAutocomplete(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/darksworm/argonaut/pkg/model"
 	"github.com/pelletier/go-toml/v2"
 )
 
@@ -18,11 +19,13 @@ const DefaultThemeName = "tokyo-night"
 type ArgonautConfig struct {
 	Appearance      AppearanceConfig   `toml:"appearance"`
 	Sort            SortConfig         `toml:"sort,omitempty"`
+	Apps            AppsConfig         `toml:"apps,omitempty"`
 	K9s             K9sConfig          `toml:"k9s,omitempty"`
 	Diff            DiffConfig         `toml:"diff,omitempty"`
 	PortForward     PortForwardConfig  `toml:"port_forward,omitempty"`
 	Clipboard       ClipboardConfig    `toml:"clipboard,omitempty"`
 	HTTPTimeouts    HTTPTimeoutConfig  `toml:"http_timeouts,omitempty"`
+	Auth            AuthConfig         `toml:"auth,omitempty"`
 	DefaultView     string             `toml:"default_view,omitempty"`
 	LastSeenVersion string             `toml:"last_seen_version,omitempty"`
 }
@@ -37,6 +40,23 @@ type AppearanceConfig struct {
 type SortConfig struct {
 	Field     string `toml:"field"`
 	Direction string `toml:"direction"`
+	Then      string `toml:"then,omitempty"` // Tie-breakers, e.g. "lastSync desc, name asc"
+}
+
+// NewSortConfig returns the config form of an apps sort
+func NewSortConfig(sortConfig model.SortConfig) SortConfig {
+	c := SortConfig{Field: string(sortConfig.Field), Direction: string(sortConfig.Direction)}
+	if len(sortConfig.Then) > 0 {
+		c.Then = model.SortConfig{Field: sortConfig.Then[0].Field, Direction: sortConfig.Then[0].Direction, Then: sortConfig.Then[1:]}.String()
+	}
+	return c
+}
+
+// AppsConfig holds apps list settings
+type AppsConfig struct {
+	// Columns are the optional columns shown between NAME and SYNC, e.g.
+	// ["project", "synced", "lastsync", "label:team"]
+	Columns []string `toml:"columns,omitempty"`
 }
 
 // K9sConfig holds k9s integration settings
@@ -49,6 +69,7 @@ type K9sConfig struct {
 type DiffConfig struct {
 	Viewer    string `toml:"viewer,omitempty"`    // External diff viewer command (e.g., "code --diff {left} {right}")
 	Formatter string `toml:"formatter,omitempty"` // Diff formatter command (e.g., "delta")
+	Engine    string `toml:"engine,omitempty"`    // "git" (default) or "builtin" for the pure-Go renderer
 }
 
 // PortForwardConfig holds settings for kubectl port-forward mode
@@ -76,6 +97,23 @@ type HTTPTimeoutConfig struct {
 	RequestTimeout string `toml:"request_timeout,omitempty"`
 }
 
+// AuthConfig holds authentication settings
+type AuthConfig struct {
+	// TokenExpiryWarning is how long before the auth token expires the status line starts warning
+	// (e.g., "30m", "2h"). Default is "30m".
+	TokenExpiryWarning string `toml:"token_expiry_warning,omitempty"`
+	// Exec maps ArgoCD context names to credential commands, e.g. [auth.exec.production]
+	Exec map[string]ExecAuthConfig `toml:"exec,omitempty"`
+}
+
+// ExecAuthConfig configures a command that prints a token as JSON, like kubectl exec
+// credential plugins: {"token": "...", "expirationTimestamp": "2025-01-01T00:00:00Z"}
+type ExecAuthConfig struct {
+	Command string            `toml:"command"`
+	Args    []string          `toml:"args,omitempty"`
+	Env     map[string]string `toml:"env,omitempty"`
+}
+
 
 // GetArgonautConfigPath returns the path to the Argonaut configuration file
 func GetArgonautConfigPath() string {
@@ -221,6 +259,11 @@ func (c *ArgonautConfig) GetDiffFormatter() string {
 	return c.Diff.Formatter
 }
 
+// UseBuiltinDiff reports whether diffs should use the built-in engine instead of git
+func (c *ArgonautConfig) UseBuiltinDiff() bool {
+	return strings.EqualFold(c.Diff.Engine, "builtin")
+}
+
 // GetPortForwardNamespace returns the namespace for kubectl port-forward, defaulting to "argocd"
 func (c *ArgonautConfig) GetPortForwardNamespace() string {
 	if c.PortForward.Namespace != "" {
@@ -239,6 +282,43 @@ func (c *ArgonautConfig) GetClipboardPasteCommand() string {
 	return c.Clipboard.PasteCommand
 }
 
+// GetExecAuth returns the credential command configured for an ArgoCD context, or nil if none
+func (c *ArgonautConfig) GetExecAuth(contextName string) *model.ExecAuth {
+	exec, ok := c.Auth.Exec[contextName]
+	if !ok || exec.Command == "" {
+		return nil
+	}
+	return &model.ExecAuth{Command: exec.Command, Args: exec.Args, Env: exec.Env}
+}
+
+// GetTokenExpiryWarning returns how long before token expiry to warn, defaulting to 30 minutes
+// for unset or invalid values
+func (c *ArgonautConfig) GetTokenExpiryWarning() time.Duration {
+	if d, err := time.ParseDuration(c.Auth.TokenExpiryWarning); err == nil && d > 0 {
+		return d
+	}
+	return 30 * time.Minute
+}
+
+// GetSortConfig returns the configured apps sort, or the default sort when it is invalid
+func (c *ArgonautConfig) GetSortConfig() model.SortConfig {
+	spec := c.Sort.Field + " " + c.Sort.Direction
+	if c.Sort.Then != "" {
+		spec += ", " + c.Sort.Then
+	}
+	if sortConfig, err := model.ParseSortSpec(spec); err == nil {
+		return sortConfig
+	}
+	return model.DefaultSortConfig()
+}
+
+// GetAppColumns returns the configured apps list columns by their canonical names, skipping
+// unknown ones
+func (c *ArgonautConfig) GetAppColumns() []string {
+	columns, _ := model.ParseAppColumns(c.Apps.Columns)
+	return columns
+}
+
 // GetRequestTimeoutString returns the raw string value of the request timeout configuration.
 // If no timeout is configured, returns the default value of "10s".
 // This method returns the raw string without validation.
@@ -3,8 +3,13 @@ package config
 import (
 	"os"
 	"path/filepath"
+	"reflect"
 	"runtime"
 	"testing"
+	"time"
+
+	"github.com/darksworm/argonaut/pkg/model"
+	"github.com/pelletier/go-toml/v2"
 )
 
 func TestGetArgonautConfigPath(t *testing.T) {
@@ -344,6 +349,15 @@ func TestDiffConfigGetters(t *testing.T) {
 	}
 }
 
+func TestUseBuiltinDiff(t *testing.T) {
+	if (&ArgonautConfig{}).UseBuiltinDiff() {
+		t.Error("Expected git to be the default diff engine")
+	}
+	if !(&ArgonautConfig{Diff: DiffConfig{Engine: "Builtin"}}).UseBuiltinDiff() {
+		t.Error("Expected engine = \"builtin\" to select the built-in engine")
+	}
+}
+
 func TestSaveAndLoadK9sAndDiffConfig(t *testing.T) {
 	// Create a temporary directory
 	tempDir := t.TempDir()
@@ -647,4 +661,77 @@ func TestSaveAndLoadHTTPTimeoutConfig(t *testing.T) {
 		t.Errorf("Parsed timeout mismatch: expected %q, got %q",
 			expectedDuration, loadedConfig.GetRequestTimeout().String())
 	}
-}
\ No newline at end of file
+}
+func TestExecAuthConfigFromTOML(t *testing.T) {
+	var cfg ArgonautConfig
+	data := []byte(`
+[auth.exec.production]
+command = "vault-argocd-token"
+args = ["--role", "viewer"]
+env = { VAULT_ADDR = "https://vault.example.com" }
+`)
+	if err := toml.Unmarshal(data, &cfg); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+
+	exec := cfg.GetExecAuth("production")
+	if exec == nil {
+		t.Fatal("Expected a credential command for production")
+	}
+	if exec.Command != "vault-argocd-token" || len(exec.Args) != 2 || exec.Env["VAULT_ADDR"] != "https://vault.example.com" {
+		t.Errorf("Unexpected exec auth %+v", exec)
+	}
+	if cfg.GetExecAuth("staging") != nil {
+		t.Error("Expected no credential command for staging")
+	}
+}
+
+func TestTokenExpiryWarningConfig(t *testing.T) {
+	var cfg ArgonautConfig
+	if got := cfg.GetTokenExpiryWarning(); got != 30*time.Minute {
+		t.Errorf("Expected the 30m default, got %s", got)
+	}
+	if err := toml.Unmarshal([]byte("[auth]\ntoken_expiry_warning = \"2h\"\n"), &cfg); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if got := cfg.GetTokenExpiryWarning(); got != 2*time.Hour {
+		t.Errorf("Expected 2h, got %s", got)
+	}
+	cfg.Auth.TokenExpiryWarning = "soon"
+	if got := cfg.GetTokenExpiryWarning(); got != 30*time.Minute {
+		t.Errorf("Expected the default for an invalid value, got %s", got)
+	}
+}
+
+func TestAppColumnsConfig(t *testing.T) {
+	var cfg ArgonautConfig
+	if got := cfg.GetAppColumns(); len(got) != 0 {
+		t.Errorf("Expected no extra columns by default, got %v", got)
+	}
+	if err := toml.Unmarshal([]byte("[apps]\ncolumns = [\"proj\", \"sha\", \"unknown\", \"label:team\"]\n"), &cfg); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	got := cfg.GetAppColumns()
+	if want := []string{"project", "synced", "label:team"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
+
+func TestSortConfigRoundTrip(t *testing.T) {
+	sortConfig, err := model.ParseSortSpec("health desc, lastSync asc, name asc")
+	if err != nil {
+		t.Fatalf("ParseSortSpec: %v", err)
+	}
+	cfg := ArgonautConfig{Sort: NewSortConfig(sortConfig)}
+	if cfg.Sort.Field != "health" || cfg.Sort.Direction != "desc" || cfg.Sort.Then != "lastSync asc, name asc" {
+		t.Errorf("Unexpected config form: %+v", cfg.Sort)
+	}
+	if got := cfg.GetSortConfig(); !reflect.DeepEqual(got, sortConfig) {
+		t.Errorf("Expected %+v, got %+v", sortConfig, got)
+	}
+
+	cfg.Sort = SortConfig{Field: "color", Direction: "asc"}
+	if got := cfg.GetSortConfig(); !reflect.DeepEqual(got, model.DefaultSortConfig()) {
+		t.Errorf("Expected the default sort for an invalid field, got %+v", got)
+	}
+}
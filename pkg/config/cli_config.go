@@ -1,6 +1,7 @@
 package config
 
 import (
+	"bytes"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -202,6 +203,24 @@ func (c *ArgoCLIConfig) ToServerConfig() (*model.Server, error) {
 	}, nil
 }
 
+// ContextTokenStatuses returns the status of the auth token of each context's user. Contexts
+// with a credential command in the Argonaut config (which may be nil) are marked as such.
+func (c *ArgoCLIConfig) ContextTokenStatuses(argonautConfig *ArgonautConfig) map[string]model.TokenStatus {
+	tokens := make(map[string]string, len(c.Users))
+	for _, user := range c.Users {
+		tokens[user.Name] = user.AuthToken
+	}
+	statuses := make(map[string]model.TokenStatus, len(c.Contexts))
+	for _, ctx := range c.Contexts {
+		if argonautConfig != nil && argonautConfig.GetExecAuth(ctx.Name) != nil {
+			statuses[ctx.Name] = model.TokenStatus{Exec: true}
+			continue
+		}
+		statuses[ctx.Name] = model.TokenStatusOf(tokens[ctx.User])
+	}
+	return statuses
+}
+
 // GetContextNames returns a sorted list of all context names
 func (c *ArgoCLIConfig) GetContextNames() []string {
 	names := make([]string, 0, len(c.Contexts))
@@ -267,6 +286,117 @@ func (c *ArgoCLIConfig) ToServerConfigForContext(contextName string) (*model.Ser
 	}, nil
 }
 
+// LoginServerForContext returns the server of a named context without requiring an auth token,
+// so a token can be obtained by logging in
+func (c *ArgoCLIConfig) LoginServerForContext(contextName string) (*model.Server, error) {
+	var serverURL string
+	for _, ctx := range c.Contexts {
+		if ctx.Name == contextName {
+			serverURL = ctx.Server
+			break
+		}
+	}
+	if serverURL == "" {
+		return nil, fmt.Errorf("no server specified for context %q", contextName)
+	}
+	for _, server := range c.Servers {
+		if server.Server == serverURL {
+			return &model.Server{
+				BaseURL:         ensureHTTPS(server.Server, server.PlainText),
+				Insecure:        server.Insecure,
+				GrpcWebRootPath: server.GrpcWebRootPath,
+			}, nil
+		}
+	}
+	return nil, fmt.Errorf("server configuration not found for %s", serverURL)
+}
+
+// SaveAuthToken stores token as the auth token of the named context's user in the config file.
+// The file is edited in place so fields this package does not model (e.g. refresh tokens of other
+// users) are kept.
+func SaveAuthToken(configPath, contextName, token string) error {
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		return fmt.Errorf("failed to read ArgoCD config from %s: %w", configPath, err)
+	}
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return fmt.Errorf("failed to parse ArgoCD config: %w", err)
+	}
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return fmt.Errorf("failed to parse ArgoCD config: not a mapping")
+	}
+	root := doc.Content[0]
+
+	userName := ""
+	if contexts := mappingValue(root, "contexts"); contexts != nil {
+		for _, ctx := range contexts.Content {
+			if name := mappingValue(ctx, "name"); name != nil && name.Value == contextName {
+				if user := mappingValue(ctx, "user"); user != nil {
+					userName = user.Value
+				}
+			}
+		}
+	}
+	if userName == "" {
+		return fmt.Errorf("no user specified for context %q", contextName)
+	}
+
+	users := mappingValue(root, "users")
+	if users == nil {
+		users = &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+		root.Content = append(root.Content, scalarNode("users"), users)
+	}
+	var user *yaml.Node
+	for _, u := range users.Content {
+		if name := mappingValue(u, "name"); name != nil && name.Value == userName {
+			user = u
+			break
+		}
+	}
+	if user == nil {
+		user = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map", Content: []*yaml.Node{scalarNode("name"), scalarNode(userName)}}
+		users.Content = append(users.Content, user)
+	}
+	if existing := mappingValue(user, "auth-token"); existing != nil {
+		existing.Value = token
+	} else {
+		user.Content = append(user.Content, scalarNode("auth-token"), scalarNode(token))
+	}
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&doc); err != nil {
+		return fmt.Errorf("failed to encode ArgoCD config: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return fmt.Errorf("failed to encode ArgoCD config: %w", err)
+	}
+	if err := os.WriteFile(configPath, buf.Bytes(), 0600); err != nil {
+		return fmt.Errorf("failed to write ArgoCD config to %s: %w", configPath, err)
+	}
+	return nil
+}
+
+// mappingValue returns the value node for key in a YAML mapping node
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// scalarNode creates a YAML string node
+func scalarNode(value string) *yaml.Node {
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value}
+}
+
 // IsContextPortForward returns true if the named context uses port-forward mode
 func (c *ArgoCLIConfig) IsContextPortForward(contextName string) (bool, error) {
 	for _, ctx := range c.Contexts {
@@ -1,9 +1,13 @@
 package config
 
 import (
+	"encoding/base64"
+	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestGetConfigPath(t *testing.T) {
@@ -422,6 +426,41 @@ func TestGetContextNames(t *testing.T) {
 	}
 }
 
+func TestContextTokenStatuses(t *testing.T) {
+	exp := time.Now().Add(time.Hour).Truncate(time.Second)
+	payload := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"exp":%d}`, exp.Unix())))
+	config := &ArgoCLIConfig{
+		Contexts: []ArgoContext{
+			{Name: "production", Server: "https://prod.example.com", User: "prod-user"},
+			{Name: "staging", Server: "https://staging.example.com", User: "staging-user"},
+			{Name: "dev", Server: "https://dev.example.com", User: "dev-user"},
+			{Name: "oncall", Server: "https://oncall.example.com", User: "oncall-user"},
+		},
+		Users: []ArgoUser{
+			{Name: "prod-user", AuthToken: "header." + payload + ".signature"},
+			{Name: "staging-user", AuthToken: "opaque-token"},
+		},
+	}
+	argonautConfig := &ArgonautConfig{Auth: AuthConfig{Exec: map[string]ExecAuthConfig{"oncall": {Command: "get-token"}}}}
+
+	statuses := config.ContextTokenStatuses(argonautConfig)
+	if got := statuses["production"]; !got.HasToken || !got.ExpiresAt.Equal(exp) {
+		t.Errorf("production: expected a token expiring at %s, got %+v", exp, got)
+	}
+	if got := statuses["staging"]; !got.HasToken || !got.ExpiresAt.IsZero() {
+		t.Errorf("staging: expected a token without expiry, got %+v", got)
+	}
+	if got := statuses["dev"]; got.HasToken || got.Exec {
+		t.Errorf("dev: expected no token, got %+v", got)
+	}
+	if got := statuses["oncall"]; !got.Exec || got.Expired(time.Now()) {
+		t.Errorf("oncall: expected a credential command, got %+v", got)
+	}
+	if got := config.ContextTokenStatuses(nil)["oncall"]; got.Exec {
+		t.Errorf("oncall: expected no credential command without an Argonaut config, got %+v", got)
+	}
+}
+
 func TestToServerConfigForContext(t *testing.T) {
 	multiContextConfig := &ArgoCLIConfig{
 		CurrentContext: "production",
@@ -585,4 +624,76 @@ func TestIsContextCore(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+func TestLoginServerForContext(t *testing.T) {
+	cfg := &ArgoCLIConfig{
+		Contexts: []ArgoContext{{Name: "prod", Server: "argocd.example.com", User: "prod-user"}},
+		Servers:  []ArgoServer{{Server: "argocd.example.com", Insecure: true}},
+		Users:    []ArgoUser{{Name: "prod-user"}}, // no token yet
+	}
+
+	server, err := cfg.LoginServerForContext("prod")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if server.BaseURL != "https://argocd.example.com" || !server.Insecure || server.Token != "" {
+		t.Errorf("Unexpected login server %+v", server)
+	}
+	if _, err := cfg.LoginServerForContext("missing"); err == nil {
+		t.Error("Expected an error for an unknown context")
+	}
+}
+
+func TestSaveAuthToken(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config")
+	initial := `contexts:
+  - name: prod
+    server: argocd.example.com
+    user: prod-user
+  - name: staging
+    server: staging.example.com
+    user: staging-user
+current-context: prod
+servers:
+  - server: argocd.example.com
+users:
+  - name: prod-user
+    auth-token: old-token
+    refresh-token: keep-me
+`
+	if err := os.WriteFile(configPath, []byte(initial), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := SaveAuthToken(configPath, "prod", "new-token"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	// A context whose user has no entry yet gets one
+	if err := SaveAuthToken(configPath, "staging", "staging-token"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	cfg, err := ReadCLIConfigFromPath(configPath)
+	if err != nil {
+		t.Fatalf("Expected the saved config to parse, got %v", err)
+	}
+	if token, _ := cfg.GetCurrentToken(); token != "new-token" {
+		t.Errorf("Expected the new token for prod, got %q", token)
+	}
+	found := false
+	for _, u := range cfg.Users {
+		found = found || (u.Name == "staging-user" && u.AuthToken == "staging-token")
+	}
+	if !found {
+		t.Errorf("Expected a staging-user entry with its token, got %+v", cfg.Users)
+	}
+
+	data, _ := os.ReadFile(configPath)
+	if !strings.Contains(string(data), "refresh-token: keep-me") {
+		t.Errorf("Expected unmodelled fields to be kept, got:\n%s", data)
+	}
+
+	if err := SaveAuthToken(configPath, "missing", "token"); err == nil {
+		t.Error("Expected an error for an unknown context")
+	}
+}
@@ -0,0 +1,279 @@
+// Package diff is a dependency-free line diff for YAML manifests. It computes
+// per-resource sections split into hunks and renders them as unified or
+// side-by-side text, so diffs can be shown without git or delta installed.
+package diff
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Op is the kind of change a diff line represents
+type Op int
+
+const (
+	Equal Op = iota
+	Delete
+	Insert
+)
+
+// ContextLines is the number of unchanged lines kept around each change
+const ContextLines = 3
+
+// maxEdits caps the edit distance searched before falling back to replacing
+// the whole document, which bounds memory on unrelated inputs.
+const maxEdits = 4000
+
+// Line is one line of an edit script. Left and Right are 1-based line numbers
+// in the old and new document; zero when the line does not exist on that side.
+type Line struct {
+	Op    Op
+	Text  string
+	Left  int
+	Right int
+}
+
+// Hunk is a run of changes with surrounding context
+type Hunk struct {
+	LeftStart  int
+	LeftCount  int
+	RightStart int
+	RightCount int
+	Lines      []Line
+}
+
+// Header formats the hunk range like a unified diff, e.g. "@@ -3,7 +3,7 @@"
+func (h Hunk) Header() string {
+	return fmt.Sprintf("@@ -%d,%d +%d,%d @@", h.LeftStart, h.LeftCount, h.RightStart, h.RightCount)
+}
+
+// Resource is one document pair to compare, such as the live and desired
+// state of a Kubernetes resource
+type Resource struct {
+	Title string
+	Left  string
+	Right string
+}
+
+// Section is the diff of one resource
+type Section struct {
+	Title   string
+	Hunks   []Hunk
+	Added   int
+	Removed int
+}
+
+// Changed reports whether the resource differs between both sides
+func (s Section) Changed() bool {
+	return s.Added > 0 || s.Removed > 0
+}
+
+// Compute diffs every resource and returns the sections that changed, in input order
+func Compute(resources []Resource) []Section {
+	sections := make([]Section, 0, len(resources))
+	for _, r := range resources {
+		if s := ComputeSection(r.Title, r.Left, r.Right); s.Changed() {
+			sections = append(sections, s)
+		}
+	}
+	return sections
+}
+
+// ComputeSection diffs two documents line by line
+func ComputeSection(title, left, right string) Section {
+	lines := Lines(splitLines(left), splitLines(right))
+	section := Section{Title: title, Hunks: hunks(lines, ContextLines)}
+	for _, l := range lines {
+		switch l.Op {
+		case Insert:
+			section.Added++
+		case Delete:
+			section.Removed++
+		}
+	}
+	return section
+}
+
+// Lines returns the shortest edit script turning a into b
+func Lines(a, b []string) []Line {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	out := make([]Line, 0, len(a)+len(b))
+	i, j := 0, 0
+	emit := func(op Op) {
+		switch op {
+		case Equal:
+			out = append(out, Line{Op: Equal, Text: a[i], Left: i + 1, Right: j + 1})
+			i++
+			j++
+		case Delete:
+			out = append(out, Line{Op: Delete, Text: a[i], Left: i + 1})
+			i++
+		case Insert:
+			out = append(out, Line{Op: Insert, Text: b[j], Right: j + 1})
+			j++
+		}
+	}
+
+	for range prefix {
+		emit(Equal)
+	}
+	for _, op := range myers(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]) {
+		emit(op)
+	}
+	for range suffix {
+		emit(Equal)
+	}
+	return out
+}
+
+// myers implements the greedy O(ND) algorithm from "An O(ND) Difference
+// Algorithm and Its Variations" and returns the operations in order.
+func myers(a, b []string) []Op {
+	n, m := len(a), len(b)
+	limit := min(n+m, maxEdits)
+	offset := limit + 1
+	v := make([]int, 2*limit+3)
+	// trace[d] holds the furthest x reached on diagonals -d..d after round d
+	var trace [][]int
+
+	for d := 0; d <= limit; d++ {
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				trace = append(trace, append([]int(nil), v[offset-d:offset+d+1]...))
+				return backtrack(trace, n, m)
+			}
+		}
+		trace = append(trace, append([]int(nil), v[offset-d:offset+d+1]...))
+	}
+
+	// Too many edits: replace the whole range
+	ops := make([]Op, 0, n+m)
+	for range n {
+		ops = append(ops, Delete)
+	}
+	for range m {
+		ops = append(ops, Insert)
+	}
+	return ops
+}
+
+// backtrack walks the trace from (n, m) back to the origin
+func backtrack(trace [][]int, n, m int) []Op {
+	ops := make([]Op, 0, n+m)
+	x, y := n, m
+	for d := len(trace) - 1; d > 0; d-- {
+		prev := trace[d-1]
+		at := func(k int) int { return prev[k+d-1] }
+		k := x - y
+		var prevK int
+		if k == -d || (k != d && at(k-1) < at(k+1)) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := at(prevK)
+		prevY := prevX - prevK
+		for x > prevX && y > prevY {
+			ops = append(ops, Equal)
+			x--
+			y--
+		}
+		if x == prevX {
+			ops = append(ops, Insert)
+			y--
+		} else {
+			ops = append(ops, Delete)
+			x--
+		}
+	}
+	for x > 0 && y > 0 {
+		ops = append(ops, Equal)
+		x--
+		y--
+	}
+
+	for i, j := 0, len(ops)-1; i < j; i, j = i+1, j-1 {
+		ops[i], ops[j] = ops[j], ops[i]
+	}
+	return ops
+}
+
+// hunks groups an edit script into hunks, merging changes whose context overlaps
+func hunks(lines []Line, context int) []Hunk {
+	var out []Hunk
+	for i := 0; i < len(lines); {
+		if lines[i].Op == Equal {
+			i++
+			continue
+		}
+		start := max(0, i-context)
+		end := i
+		// Extend while the next change is within two context windows
+		for end < len(lines) {
+			if lines[end].Op != Equal {
+				end++
+				continue
+			}
+			next := end
+			for next < len(lines) && lines[next].Op == Equal {
+				next++
+			}
+			if next < len(lines) && next-end <= 2*context {
+				end = next
+				continue
+			}
+			break
+		}
+		stop := min(len(lines), end+context)
+		out = append(out, newHunk(lines[start:stop]))
+		i = stop
+	}
+	return out
+}
+
+func newHunk(lines []Line) Hunk {
+	h := Hunk{Lines: lines}
+	for _, l := range lines {
+		if l.Left > 0 {
+			if h.LeftStart == 0 {
+				h.LeftStart = l.Left
+			}
+			h.LeftCount++
+		}
+		if l.Right > 0 {
+			if h.RightStart == 0 {
+				h.RightStart = l.Right
+			}
+			h.RightCount++
+		}
+	}
+	return h
+}
+
+// splitLines splits a document into lines, ignoring the trailing newline
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
@@ -0,0 +1,142 @@
+package diff
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/darksworm/argonaut/pkg/theme"
+)
+
+// Same resources as the e2e resource diff fixture: the Deployment scales from
+// one to three replicas and the ConfigMap is unchanged.
+const (
+	deployLive = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: demo-deploy
+  namespace: default
+spec:
+  replicas: 1
+`
+	deployDesired = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: demo-deploy
+  namespace: default
+spec:
+  replicas: 3
+`
+	configMap = `apiVersion: v1
+data:
+  key: value
+kind: ConfigMap
+metadata:
+  name: demo-config
+  namespace: default
+`
+)
+
+var ansiPattern = regexp.MustCompile(`\x1b\[[0-9;]*m`)
+
+func plain(lines []string) []string {
+	out := make([]string, len(lines))
+	for i, l := range lines {
+		out[i] = strings.TrimRight(ansiPattern.ReplaceAllString(l, ""), " ")
+	}
+	return out
+}
+
+func fixtureSections() []Section {
+	return Compute([]Resource{
+		{Title: "Deployment default/demo-deploy", Left: deployLive, Right: deployDesired},
+		{Title: "ConfigMap default/demo-config", Left: configMap, Right: configMap},
+	})
+}
+
+func TestCompute_SkipsUnchangedResources(t *testing.T) {
+	sections := fixtureSections()
+	if len(sections) != 1 || sections[0].Title != "Deployment default/demo-deploy" {
+		t.Fatalf("Expected only the Deployment to change, got %+v", sections)
+	}
+	s := sections[0]
+	if s.Added != 1 || s.Removed != 1 || len(s.Hunks) != 1 {
+		t.Fatalf("Expected one hunk with +1 -1, got %+v", s)
+	}
+	if got := s.Hunks[0].Header(); got != "@@ -4,4 +4,4 @@" {
+		t.Errorf("Unexpected hunk header %q", got)
+	}
+}
+
+func TestUnified_FixtureOutput(t *testing.T) {
+	got := plain(Renderer{Palette: theme.Default()}.Unified(fixtureSections()).Lines)
+	want := []string{
+		"━━ Deployment default/demo-deploy +1 -1",
+		"@@ -4,4 +4,4 @@",
+		"   name: demo-deploy",
+		"   namespace: default",
+		" spec:",
+		"-  replicas: 1",
+		"+  replicas: 3",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Unexpected unified diff:\n%s", strings.Join(got, "\n"))
+	}
+}
+
+func TestSideBySide_PairsChanges(t *testing.T) {
+	rendered := Renderer{Palette: theme.Default(), Width: 63}.SideBySide(fixtureSections())
+	got := plain(rendered.Lines)
+	if len(got) != 6 {
+		t.Fatalf("Expected header, hunk and 4 rows, got:\n%s", strings.Join(got, "\n"))
+	}
+	row := got[5]
+	if !strings.HasPrefix(row, "   7   replicas: 1") || !strings.HasSuffix(row, "│    7   replicas: 3") {
+		t.Errorf("Expected the replica change on one row, got %q", row)
+	}
+}
+
+func TestLines_MinimalEditScript(t *testing.T) {
+	a := strings.Split("a b c a b b a", " ")
+	b := strings.Split("c b a b a c", " ")
+	var edits int
+	var left, right []string
+	for _, l := range Lines(a, b) {
+		switch l.Op {
+		case Equal:
+			left = append(left, l.Text)
+			right = append(right, l.Text)
+		case Delete:
+			edits++
+			left = append(left, l.Text)
+		case Insert:
+			edits++
+			right = append(right, l.Text)
+		}
+	}
+	if edits != 5 {
+		t.Errorf("Expected the 5-edit script from the Myers paper, got %d", edits)
+	}
+	if strings.Join(left, " ") != strings.Join(a, " ") || strings.Join(right, " ") != strings.Join(b, " ") {
+		t.Errorf("Edit script does not reproduce both inputs: %v / %v", left, right)
+	}
+}
+
+func TestHunks_SplitDistantChanges(t *testing.T) {
+	var a, b []string
+	for i := range 20 {
+		line := strings.Repeat("x", i+1)
+		a = append(a, line)
+		b = append(b, line)
+	}
+	b[1] = "changed"
+	b[18] = "changed"
+
+	rendered := Renderer{Palette: theme.Default()}.Unified([]Section{ComputeSection("doc", strings.Join(a, "\n"), strings.Join(b, "\n"))})
+	if len(rendered.Hunks) != 2 || len(rendered.Sections) != 1 {
+		t.Fatalf("Expected two hunks in one section, got %+v", rendered)
+	}
+	if got := plain(rendered.Lines)[rendered.Hunks[1]]; got != "@@ -16,5 +16,5 @@" {
+		t.Errorf("Unexpected second hunk header %q", got)
+	}
+}
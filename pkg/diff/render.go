@@ -0,0 +1,138 @@
+package diff
+
+import (
+	"fmt"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+	"github.com/darksworm/argonaut/pkg/theme"
+)
+
+// Rendered is a diff laid out as display lines, with the line indexes of the
+// section and hunk headers for navigation
+type Rendered struct {
+	Lines    []string
+	Sections []int
+	Hunks    []int
+}
+
+// Renderer styles diffs with the colors of a theme palette
+type Renderer struct {
+	Palette theme.Palette
+	Width   int // Total width available to the side-by-side layout
+}
+
+func (r Renderer) sectionHeader(s Section) string {
+	title := lipgloss.NewStyle().Foreground(r.Palette.Info).Bold(true).Render("━━ " + s.Title)
+	added := lipgloss.NewStyle().Foreground(r.Palette.Success).Render(fmt.Sprintf("+%d", s.Added))
+	removed := lipgloss.NewStyle().Foreground(r.Palette.Danger).Render(fmt.Sprintf("-%d", s.Removed))
+	return title + " " + added + " " + removed
+}
+
+func (r Renderer) hunkHeader(h Hunk) string {
+	return lipgloss.NewStyle().Foreground(r.Palette.Dim).Render(h.Header())
+}
+
+// Unified renders sections as a unified diff
+func (r Renderer) Unified(sections []Section) Rendered {
+	removed := lipgloss.NewStyle().Foreground(r.Palette.Danger)
+	added := lipgloss.NewStyle().Foreground(r.Palette.Success)
+	equal := lipgloss.NewStyle().Foreground(r.Palette.Text)
+
+	var out Rendered
+	for i, s := range sections {
+		if i > 0 {
+			out.Lines = append(out.Lines, "")
+		}
+		out.Sections = append(out.Sections, len(out.Lines))
+		out.Lines = append(out.Lines, r.sectionHeader(s))
+		for _, h := range s.Hunks {
+			out.Hunks = append(out.Hunks, len(out.Lines))
+			out.Lines = append(out.Lines, r.hunkHeader(h))
+			for _, l := range h.Lines {
+				switch l.Op {
+				case Delete:
+					out.Lines = append(out.Lines, removed.Render("-"+l.Text))
+				case Insert:
+					out.Lines = append(out.Lines, added.Render("+"+l.Text))
+				default:
+					out.Lines = append(out.Lines, equal.Render(" "+l.Text))
+				}
+			}
+		}
+	}
+	return out
+}
+
+// SideBySide renders sections with the old document on the left and the new
+// one on the right. Runs of deletions and insertions are paired row by row.
+func (r Renderer) SideBySide(sections []Section) Rendered {
+	const gutter = " │ "
+	half := max(10, (r.Width-len([]rune(gutter)))/2)
+	divider := lipgloss.NewStyle().Foreground(r.Palette.Border).Render(gutter)
+
+	var out Rendered
+	for i, s := range sections {
+		if i > 0 {
+			out.Lines = append(out.Lines, "")
+		}
+		out.Sections = append(out.Sections, len(out.Lines))
+		out.Lines = append(out.Lines, r.sectionHeader(s))
+		for _, h := range s.Hunks {
+			out.Hunks = append(out.Hunks, len(out.Lines))
+			out.Lines = append(out.Lines, r.hunkHeader(h))
+			for j := 0; j < len(h.Lines); {
+				if h.Lines[j].Op == Equal {
+					l := h.Lines[j]
+					out.Lines = append(out.Lines, r.cell(l.Left, l.Text, Equal, half)+divider+r.cell(l.Right, l.Text, Equal, half))
+					j++
+					continue
+				}
+				var dels, ins []Line
+				for ; j < len(h.Lines) && h.Lines[j].Op != Equal; j++ {
+					if h.Lines[j].Op == Delete {
+						dels = append(dels, h.Lines[j])
+					} else {
+						ins = append(ins, h.Lines[j])
+					}
+				}
+				for k := 0; k < max(len(dels), len(ins)); k++ {
+					left, right := strings.Repeat(" ", half), strings.Repeat(" ", half)
+					if k < len(dels) {
+						left = r.cell(dels[k].Left, dels[k].Text, Delete, half)
+					}
+					if k < len(ins) {
+						right = r.cell(ins[k].Right, ins[k].Text, Insert, half)
+					}
+					out.Lines = append(out.Lines, left+divider+right)
+				}
+			}
+		}
+	}
+	return out
+}
+
+// cell renders a line number and text clipped and padded to width
+func (r Renderer) cell(num int, text string, op Op, width int) string {
+	style := lipgloss.NewStyle().Foreground(r.Palette.Text)
+	switch op {
+	case Delete:
+		style = lipgloss.NewStyle().Foreground(r.Palette.Danger)
+	case Insert:
+		style = lipgloss.NewStyle().Foreground(r.Palette.Success)
+	}
+	number := lipgloss.NewStyle().Foreground(r.Palette.Dim).Render(fmt.Sprintf("%4d ", num))
+	return number + style.Render(fit(text, max(0, width-5)))
+}
+
+// fit clips s to width runes, marking the cut with an ellipsis, and pads it to width
+func fit(s string, width int) string {
+	runes := []rune(s)
+	if len(runes) > width {
+		if width == 0 {
+			return ""
+		}
+		return string(runes[:width-1]) + "…"
+	}
+	return s + strings.Repeat(" ", width-len(runes))
+}
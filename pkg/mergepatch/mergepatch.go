@@ -0,0 +1,60 @@
+// Package mergepatch computes JSON merge patches (RFC 7386) between two
+// versions of a Kubernetes object.
+package mergepatch
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// Create returns the JSON merge patch that turns original into modified.
+// Both inputs must be JSON objects. Lists are replaced as a whole, removed
+// keys are set to null. An empty object ("{}") means there is nothing to patch.
+func Create(original, modified []byte) ([]byte, error) {
+	var orig, mod map[string]interface{}
+	if err := json.Unmarshal(original, &orig); err != nil {
+		return nil, fmt.Errorf("failed to parse original object: %w", err)
+	}
+	if err := json.Unmarshal(modified, &mod); err != nil {
+		return nil, fmt.Errorf("failed to parse modified object: %w", err)
+	}
+	return json.Marshal(diffObjects(orig, mod))
+}
+
+// IsEmpty reports whether a merge patch contains no changes
+func IsEmpty(patch []byte) bool {
+	var obj map[string]interface{}
+	if err := json.Unmarshal(patch, &obj); err != nil {
+		return false
+	}
+	return len(obj) == 0
+}
+
+func diffObjects(orig, mod map[string]interface{}) map[string]interface{} {
+	patch := map[string]interface{}{}
+	for key, origVal := range orig {
+		modVal, ok := mod[key]
+		if !ok {
+			patch[key] = nil
+			continue
+		}
+		origMap, origIsMap := origVal.(map[string]interface{})
+		modMap, modIsMap := modVal.(map[string]interface{})
+		if origIsMap && modIsMap {
+			if nested := diffObjects(origMap, modMap); len(nested) > 0 {
+				patch[key] = nested
+			}
+			continue
+		}
+		if !reflect.DeepEqual(origVal, modVal) {
+			patch[key] = modVal
+		}
+	}
+	for key, modVal := range mod {
+		if _, ok := orig[key]; !ok {
+			patch[key] = modVal
+		}
+	}
+	return patch
+}
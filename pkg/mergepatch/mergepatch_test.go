@@ -0,0 +1,74 @@
+package mergepatch
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestCreate(t *testing.T) {
+	tests := []struct {
+		name     string
+		original string
+		modified string
+		want     string
+	}{
+		{
+			name:     "no changes",
+			original: `{"spec":{"replicas":2}}`,
+			modified: `{"spec":{"replicas":2}}`,
+			want:     `{}`,
+		},
+		{
+			name:     "nested scalar change",
+			original: `{"kind":"Deployment","spec":{"replicas":2,"paused":false}}`,
+			modified: `{"kind":"Deployment","spec":{"replicas":3,"paused":false}}`,
+			want:     `{"spec":{"replicas":3}}`,
+		},
+		{
+			name:     "added and removed keys",
+			original: `{"data":{"a":"1","b":"2"}}`,
+			modified: `{"data":{"a":"1","c":"3"}}`,
+			want:     `{"data":{"b":null,"c":"3"}}`,
+		},
+		{
+			name:     "lists are replaced",
+			original: `{"spec":{"ports":[{"port":80},{"port":443}]}}`,
+			modified: `{"spec":{"ports":[{"port":8080}]}}`,
+			want:     `{"spec":{"ports":[{"port":8080}]}}`,
+		},
+		{
+			name:     "object replaced by scalar",
+			original: `{"metadata":{"labels":{"app":"web"}}}`,
+			modified: `{"metadata":{"labels":null}}`,
+			want:     `{"metadata":{"labels":null}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Create([]byte(tt.original), []byte(tt.modified))
+			if err != nil {
+				t.Fatalf("Create() error = %v", err)
+			}
+			var gotObj, wantObj interface{}
+			_ = json.Unmarshal(got, &gotObj)
+			_ = json.Unmarshal([]byte(tt.want), &wantObj)
+			if !reflect.DeepEqual(gotObj, wantObj) {
+				t.Errorf("Create() = %s, want %s", got, tt.want)
+			}
+			if IsEmpty(got) != (tt.want == `{}`) {
+				t.Errorf("IsEmpty(%s) = %v", got, IsEmpty(got))
+			}
+		})
+	}
+}
+
+func TestCreate_InvalidInput(t *testing.T) {
+	if _, err := Create([]byte(`{`), []byte(`{}`)); err == nil {
+		t.Error("Expected error for invalid original")
+	}
+	if _, err := Create([]byte(`{}`), []byte(`[1]`)); err == nil {
+		t.Error("Expected error for non-object modified")
+	}
+}
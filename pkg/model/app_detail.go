@@ -0,0 +1,37 @@
+package model
+
+import "strings"
+
+// AppDetail is the full description of a single application shown in the app detail view
+type AppDetail struct {
+	App                  App            `json:"app"`
+	DestinationServer    string         `json:"destinationServer,omitempty"`
+	DestinationName      string         `json:"destinationName,omitempty"`
+	DestinationNamespace string         `json:"destinationNamespace,omitempty"`
+	Sources              []AppSource    `json:"sources,omitempty"`         // spec.source, or every entry of spec.sources
+	MultiSource          bool           `json:"multiSource,omitempty"`     // Sources come from spec.sources
+	SyncedRevisions      []string       `json:"syncedRevisions,omitempty"` // One per source for multi-source apps
+	HealthMessage        string         `json:"healthMessage,omitempty"`
+	Conditions           []AppCondition `json:"conditions,omitempty"`
+	SyncOptions          []string       `json:"syncOptions,omitempty"`
+	Operation            *SyncOperation `json:"operation,omitempty"` // Current or most recent operation
+}
+
+// AppSource is one source of an application
+type AppSource struct {
+	RepoURL        string `json:"repoURL"`
+	Path           string `json:"path,omitempty"`
+	TargetRevision string `json:"targetRevision,omitempty"`
+	Chart          string `json:"chart,omitempty"` // Set for Helm repository sources instead of Path
+}
+
+// AppCondition is an application condition such as ComparisonError, SyncError or OrphanedResourceWarning
+type AppCondition struct {
+	Type    string `json:"type"`
+	Message string `json:"message,omitempty"`
+}
+
+// IsError reports whether the condition is an error rather than a warning
+func (c AppCondition) IsError() bool {
+	return strings.HasSuffix(c.Type, "Error")
+}
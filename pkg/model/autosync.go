@@ -0,0 +1,110 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+)
+
+// AutoSync is an application's automated sync policy
+type AutoSync struct {
+	Prune      bool `json:"prune"`
+	SelfHeal   bool `json:"selfHeal"`
+	AllowEmpty bool `json:"allowEmpty"`
+}
+
+// AutoSyncFlags are the automated sync options that :autosync can turn on and off
+var AutoSyncFlags = []string{"selfheal", "prune", "allowempty"}
+
+// AutoSyncChange is a parsed :autosync command. Either Enable is set, turning automated sync
+// on or off, or Flag names an option of the automated policy to set to Value.
+type AutoSyncChange struct {
+	Enable *bool
+	Flag   string
+	Value  bool
+}
+
+// ParseAutoSyncArgs parses ":autosync on|off" and ":autosync selfheal|prune|allowempty on|off"
+func ParseAutoSyncArgs(args []string) (AutoSyncChange, error) {
+	switch len(args) {
+	case 1:
+		on, ok := parseOnOff(args[0])
+		if !ok {
+			return AutoSyncChange{}, fmt.Errorf("expected on or off, got %q", args[0])
+		}
+		return AutoSyncChange{Enable: &on}, nil
+	case 2:
+		flag := strings.ReplaceAll(strings.ToLower(args[0]), "-", "")
+		if !isAutoSyncFlag(flag) {
+			return AutoSyncChange{}, fmt.Errorf("unknown option %q, use %s", args[0], strings.Join(AutoSyncFlags, ", "))
+		}
+		on, ok := parseOnOff(args[1])
+		if !ok {
+			return AutoSyncChange{}, fmt.Errorf("expected on or off, got %q", args[1])
+		}
+		return AutoSyncChange{Flag: flag, Value: on}, nil
+	default:
+		return AutoSyncChange{}, fmt.Errorf("usage: :autosync on|off or :autosync %s on|off", strings.Join(AutoSyncFlags, "|"))
+	}
+}
+
+// Apply returns the policy after the change; nil means automated sync is disabled. Options
+// can only be changed while automated sync is enabled.
+func (c AutoSyncChange) Apply(current *AutoSync) (*AutoSync, error) {
+	if c.Enable != nil {
+		if !*c.Enable {
+			return nil, nil
+		}
+		if current != nil {
+			return current, nil
+		}
+		return &AutoSync{}, nil
+	}
+	if current == nil {
+		return nil, fmt.Errorf("automated sync is disabled")
+	}
+	next := *current
+	switch c.Flag {
+	case "selfheal":
+		next.SelfHeal = c.Value
+	case "prune":
+		next.Prune = c.Value
+	case "allowempty":
+		next.AllowEmpty = c.Value
+	}
+	return &next, nil
+}
+
+// DescribeAutoSync summarizes a policy, e.g. "enabled (prune on, self-heal off, allow-empty off)"
+func DescribeAutoSync(policy *AutoSync) string {
+	if policy == nil {
+		return "disabled"
+	}
+	return fmt.Sprintf("enabled (prune %s, self-heal %s, allow-empty %s)",
+		onOff(policy.Prune), onOff(policy.SelfHeal), onOff(policy.AllowEmpty))
+}
+
+func isAutoSyncFlag(s string) bool {
+	for _, f := range AutoSyncFlags {
+		if f == s {
+			return true
+		}
+	}
+	return false
+}
+
+func parseOnOff(s string) (bool, bool) {
+	switch strings.ToLower(s) {
+	case "on", "true", "enable", "enabled":
+		return true, true
+	case "off", "false", "disable", "disabled":
+		return false, true
+	}
+	return false, false
+}
+
+func onOff(b bool) string {
+	if b {
+		return "on"
+	}
+	return "off"
+}
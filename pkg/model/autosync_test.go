@@ -0,0 +1,41 @@
+package model
+
+import "testing"
+
+func TestParseAutoSyncArgs(t *testing.T) {
+	change, err := ParseAutoSyncArgs([]string{"off"})
+	if err != nil || change.Enable == nil || *change.Enable {
+		t.Fatalf("Expected disable, got %+v %v", change, err)
+	}
+	change, err = ParseAutoSyncArgs([]string{"Self-Heal", "on"})
+	if err != nil || change.Flag != "selfheal" || !change.Value {
+		t.Fatalf("Expected selfheal on, got %+v %v", change, err)
+	}
+	for _, args := range [][]string{{}, {"maybe"}, {"heal", "on"}, {"prune", "yes"}, {"prune", "on", "now"}} {
+		if _, err := ParseAutoSyncArgs(args); err == nil {
+			t.Errorf("Expected an error for %v", args)
+		}
+	}
+}
+
+func TestAutoSyncChange_Apply(t *testing.T) {
+	on, off := true, false
+	current := &AutoSync{Prune: true}
+
+	if got, err := (AutoSyncChange{Enable: &off}).Apply(current); err != nil || got != nil {
+		t.Errorf("Expected disabling to clear the policy, got %+v %v", got, err)
+	}
+	if got, err := (AutoSyncChange{Enable: &on}).Apply(current); err != nil || *got != *current {
+		t.Errorf("Expected enabling to keep the existing options, got %+v %v", got, err)
+	}
+	if got, err := (AutoSyncChange{Enable: &on}).Apply(nil); err != nil || got == nil || *got != (AutoSync{}) {
+		t.Errorf("Expected enabling with default options, got %+v %v", got, err)
+	}
+	got, err := (AutoSyncChange{Flag: "selfheal", Value: true}).Apply(current)
+	if err != nil || !got.SelfHeal || !got.Prune || current.SelfHeal {
+		t.Errorf("Expected self-heal on a copy of the policy, got %+v %v", got, err)
+	}
+	if _, err := (AutoSyncChange{Flag: "prune", Value: false}).Apply(nil); err == nil {
+		t.Error("Expected options to require automated sync")
+	}
+}
@@ -0,0 +1,59 @@
+package model
+
+import (
+	"net/url"
+	"time"
+)
+
+// inClusterServer is the server URL ArgoCD uses for the cluster it runs in
+const inClusterServer = "https://kubernetes.default.svc"
+
+// Cluster represents a destination cluster registered in ArgoCD
+type Cluster struct {
+	Server            string     `json:"server"`
+	Name              string     `json:"name"`
+	Version           string     `json:"version,omitempty"`
+	ConnectionStatus  string     `json:"connectionStatus,omitempty"` // Successful, Failed, Unknown
+	ConnectionMessage string     `json:"connectionMessage,omitempty"`
+	AttemptedAt       *time.Time `json:"attemptedAt,omitempty"`
+	ResourcesCount    int        `json:"resourcesCount,omitempty"`
+	APIsCount         int        `json:"apisCount,omitempty"`
+	CacheSyncedAt     *time.Time `json:"cacheSyncedAt,omitempty"`
+}
+
+// Failed reports whether ArgoCD could not connect to the cluster
+func (c Cluster) Failed() bool {
+	return c.ConnectionStatus == "Failed"
+}
+
+// Label returns the name the cluster is listed under, matching App.ClusterLabel of apps that
+// target it by name
+func (c Cluster) Label() string {
+	if c.Name != "" {
+		return c.Name
+	}
+	return ClusterLabelForServer(c.Server)
+}
+
+// Keys returns every App.ClusterLabel the cluster's apps can have: apps target a cluster either
+// by name or by server URL
+func (c Cluster) Keys() []string {
+	keys := []string{c.Label()}
+	if c.Server != "" {
+		if byServer := ClusterLabelForServer(c.Server); byServer != keys[0] {
+			keys = append(keys, byServer)
+		}
+	}
+	return keys
+}
+
+// ClusterLabelForServer returns the cluster label of apps that target a server URL
+func ClusterLabelForServer(server string) string {
+	if server == inClusterServer {
+		return "in-cluster"
+	}
+	if u, err := url.Parse(server); err == nil && u.Host != "" {
+		return u.Host
+	}
+	return server
+}
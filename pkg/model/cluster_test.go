@@ -0,0 +1,25 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestClusterKeys(t *testing.T) {
+	tests := []struct {
+		name    string
+		cluster Cluster
+		want    []string
+	}{
+		{"in-cluster", Cluster{Name: "in-cluster", Server: "https://kubernetes.default.svc"}, []string{"in-cluster"}},
+		{"named", Cluster{Name: "prod-eu", Server: "https://10.0.0.1:6443"}, []string{"prod-eu", "10.0.0.1:6443"}},
+		{"unnamed", Cluster{Server: "https://10.0.0.2:6443"}, []string{"10.0.0.2:6443"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cluster.Keys(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Keys() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package model
+
+import "strings"
+
+// AppColumn describes an optional column of the apps list
+type AppColumn struct {
+	Name    string
+	Aliases []string
+	Title   string
+	Width   int // Preferred width; the column is hidden when the terminal is too narrow for it
+}
+
+// labelColumnPrefix selects a column showing the value of one label, e.g. "label:team"
+const labelColumnPrefix = "label:"
+
+// AppColumns lists the optional columns of the apps list. NAME, SYNC and HEALTH are always shown.
+var AppColumns = []AppColumn{
+	{Name: "project", Aliases: []string{"proj"}, Title: "PROJECT", Width: 14},
+	{Name: "cluster", Aliases: []string{"cls"}, Title: "CLUSTER", Width: 14},
+	{Name: "namespace", Aliases: []string{"ns"}, Title: "NAMESPACE", Width: 14},
+	{Name: "repo", Aliases: []string{"repository"}, Title: "REPO", Width: 28},
+	{Name: "path", Title: "PATH", Width: 20},
+	{Name: "revision", Aliases: []string{"target", "targetrevision"}, Title: "TARGET", Width: 12},
+	{Name: "synced", Aliases: []string{"sha", "syncedrevision"}, Title: "REVISION", Width: 8},
+	{Name: "lastsync", Aliases: []string{"last-sync", "age"}, Title: "LAST SYNC", Width: 9},
+	{Name: "phase", Title: "PHASE", Width: 11},
+	{Name: "appset", Aliases: []string{"applicationset"}, Title: "APPSET", Width: 16},
+}
+
+// LookupAppColumn resolves a column name or alias. "label:<key>" selects the values of a label.
+func LookupAppColumn(name string) (AppColumn, bool) {
+	if prefix, key, ok := strings.Cut(name, ":"); ok && strings.EqualFold(prefix+":", labelColumnPrefix) {
+		if key == "" {
+			return AppColumn{}, false
+		}
+		return AppColumn{Name: labelColumnPrefix + key, Title: strings.ToUpper(key), Width: 12}, true
+	}
+	name = strings.ToLower(name)
+	for _, c := range AppColumns {
+		if c.Name == name {
+			return c, true
+		}
+		for _, alias := range c.Aliases {
+			if alias == name {
+				return c, true
+			}
+		}
+	}
+	return AppColumn{}, false
+}
+
+// LabelColumnKey returns the label key of a "label:<key>" column
+func LabelColumnKey(column string) (string, bool) {
+	return strings.CutPrefix(column, labelColumnPrefix)
+}
+
+// ParseAppColumns resolves column names to their canonical names, dropping duplicates.
+// Unknown names are returned separately.
+func ParseAppColumns(names []string) (columns, unknown []string) {
+	seen := make(map[string]bool)
+	for _, name := range names {
+		c, ok := LookupAppColumn(strings.TrimSpace(name))
+		if !ok {
+			unknown = append(unknown, name)
+			continue
+		}
+		if !seen[c.Name] {
+			seen[c.Name] = true
+			columns = append(columns, c.Name)
+		}
+	}
+	return columns, unknown
+}
+
+// ShortRevision shortens a git commit SHA to 7 characters; other revisions (Helm chart
+// versions, tags) are returned unchanged
+func ShortRevision(revision string) string {
+	if len(revision) != 40 {
+		return revision
+	}
+	for _, r := range revision {
+		if !strings.ContainsRune("0123456789abcdef", r) {
+			return revision
+		}
+	}
+	return revision[:7]
+}
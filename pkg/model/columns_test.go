@@ -0,0 +1,39 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseAppColumns(t *testing.T) {
+	columns, unknown := ParseAppColumns([]string{"proj", "SHA", "label:team", "project", "bogus", "label:"})
+	if want := []string{"project", "synced", "label:team"}; !reflect.DeepEqual(columns, want) {
+		t.Errorf("columns = %v, want %v", columns, want)
+	}
+	if want := []string{"bogus", "label:"}; !reflect.DeepEqual(unknown, want) {
+		t.Errorf("unknown = %v, want %v", unknown, want)
+	}
+
+	c, ok := LookupAppColumn("label:env")
+	if !ok || c.Title != "ENV" {
+		t.Errorf("LookupAppColumn(label:env) = %+v, %v", c, ok)
+	}
+	if key, ok := LabelColumnKey(c.Name); !ok || key != "env" {
+		t.Errorf("LabelColumnKey(%s) = %q, %v", c.Name, key, ok)
+	}
+}
+
+func TestShortRevision(t *testing.T) {
+	tests := map[string]string{
+		"0123456789abcdef0123456789abcdef01234567": "0123456",
+		"1.2.3": "1.2.3",
+		"HEAD":  "HEAD",
+		"":      "",
+		"0123456789ABCDEF0123456789ABCDEF0123456x": "0123456789ABCDEF0123456789ABCDEF0123456x",
+	}
+	for in, want := range tests {
+		if got := ShortRevision(in); got != want {
+			t.Errorf("ShortRevision(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
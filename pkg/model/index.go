@@ -10,12 +10,17 @@ type AppIndex struct {
 	Namespaces      []string
 	Projects        []string
 	ApplicationSets []string
+	Repos           []string // Source repository URLs as written in the apps, one per normalized URL
+	LabelKeys       []string
+	LabelValues     map[string][]string // Label key → sorted values
 
 	// Reverse mappings: dimension value → app indices in the Apps slice
 	ByCluster        map[string][]int
 	ByNamespace      map[string][]int
 	ByProject        map[string][]int
 	ByApplicationSet map[string][]int
+	ByRepo           map[string][]int // Keyed by NormalizeRepoURL
+	ByLabel          map[string][]int // Keyed by LabelSelector (key=value)
 
 	// App name → index in the Apps slice for O(1) upsert/delete
 	NameToIndex map[string]int
@@ -32,6 +37,9 @@ func BuildAppIndex(apps []App) *AppIndex {
 		ByNamespace:      make(map[string][]int),
 		ByProject:        make(map[string][]int),
 		ByApplicationSet: make(map[string][]int),
+		ByRepo:           make(map[string][]int),
+		ByLabel:          make(map[string][]int),
+		LabelValues:      make(map[string][]string),
 		NameToIndex:      make(map[string]int, len(apps)),
 		Total:            len(apps),
 	}
@@ -40,6 +48,9 @@ func BuildAppIndex(apps []App) *AppIndex {
 	nsSet := make(map[string]bool)
 	projSet := make(map[string]bool)
 	appsetSet := make(map[string]bool)
+	repoSet := make(map[string]bool)
+	repoNames := make(map[string]bool) // normalized URLs already listed in repoSet
+	labelSet := make(map[string]map[string]bool)
 
 	for i, app := range apps {
 		idx.NameToIndex[app.Name] = i
@@ -80,12 +91,43 @@ func BuildAppIndex(apps []App) *AppIndex {
 			appsetSet[as] = true
 			idx.ByApplicationSet[as] = append(idx.ByApplicationSet[as], i)
 		}
+
+		// Repositories (multi-source apps can reference the same repo more than once)
+		for _, repoURL := range app.RepoURLs {
+			key := NormalizeRepoURL(repoURL)
+			if key == "" {
+				continue
+			}
+			if !repoNames[key] {
+				repoNames[key] = true
+				repoSet[repoURL] = true
+			}
+			if rows := idx.ByRepo[key]; len(rows) == 0 || rows[len(rows)-1] != i {
+				idx.ByRepo[key] = append(rows, i)
+			}
+		}
+
+		// Labels
+		for key, value := range app.Labels {
+			if labelSet[key] == nil {
+				labelSet[key] = make(map[string]bool)
+			}
+			labelSet[key][value] = true
+			selector := LabelSelector(key, value)
+			idx.ByLabel[selector] = append(idx.ByLabel[selector], i)
+		}
 	}
 
 	idx.Clusters = sortedKeys(clusterSet)
 	idx.Namespaces = sortedKeys(nsSet)
 	idx.Projects = sortedKeys(projSet)
 	idx.ApplicationSets = sortedKeys(appsetSet)
+	idx.Repos = sortedKeys(repoSet)
+	for key, values := range labelSet {
+		idx.LabelKeys = append(idx.LabelKeys, key)
+		idx.LabelValues[key] = sortedKeys(values)
+	}
+	sort.Strings(idx.LabelKeys)
 
 	return idx
 }
@@ -140,7 +182,7 @@ func (idx *AppIndex) ScopedProjects(apps []App, clusterScope, nsScope map[string
 	}
 
 	// Build a set of in-scope app indices using bitwise intersection
-	inScope := idx.scopeFilter(clusterScope, nsScope, nil, nil)
+	inScope := idx.scopeFilter(clusterScope, nsScope, nil, nil, nil, nil)
 
 	seen := make(map[string]bool)
 	for _, i := range inScope {
@@ -166,12 +208,14 @@ func (idx *AppIndex) ScopedApps(apps []App, sel *SelectionState) []App {
 	hasNs := len(sel.ScopeNamespaces) > 0
 	hasProjects := len(sel.ScopeProjects) > 0
 	hasAppSets := len(sel.ScopeApplicationSets) > 0
+	hasRepos := len(sel.ScopeRepos) > 0
+	hasLabels := len(sel.ScopeLabels) > 0
 
-	if !hasClusters && !hasNs && !hasProjects && !hasAppSets {
+	if !hasClusters && !hasNs && !hasProjects && !hasAppSets && !hasRepos && !hasLabels {
 		return apps
 	}
 
-	indices := idx.scopeFilter(sel.ScopeClusters, sel.ScopeNamespaces, sel.ScopeProjects, sel.ScopeApplicationSets)
+	indices := idx.scopeFilter(sel.ScopeClusters, sel.ScopeNamespaces, sel.ScopeProjects, sel.ScopeApplicationSets, sel.ScopeRepos, sel.ScopeLabels)
 	result := make([]App, 0, len(indices))
 	for _, i := range indices {
 		if i < len(apps) {
@@ -182,7 +226,7 @@ func (idx *AppIndex) ScopedApps(apps []App, sel *SelectionState) []App {
 }
 
 // scopeFilter returns ordered app indices matching all non-empty scope filters.
-func (idx *AppIndex) scopeFilter(clusterScope, nsScope, projScope, appsetScope map[string]bool) []int {
+func (idx *AppIndex) scopeFilter(clusterScope, nsScope, projScope, appsetScope, repoScope, labelScope map[string]bool) []int {
 	// Start with all indices as a bitset
 	bits := make([]bool, idx.Total)
 	for i := range bits {
@@ -245,6 +289,34 @@ func (idx *AppIndex) scopeFilter(clusterScope, nsScope, projScope, appsetScope m
 		}
 	}
 
+	if len(repoScope) > 0 {
+		match := make([]bool, idx.Total)
+		for repoURL, ok := range repoScope {
+			if ok {
+				for _, i := range idx.ByRepo[NormalizeRepoURL(repoURL)] {
+					match[i] = true
+				}
+			}
+		}
+		for i := range bits {
+			bits[i] = bits[i] && match[i]
+		}
+	}
+
+	if len(labelScope) > 0 {
+		match := make([]bool, idx.Total)
+		for selector, ok := range labelScope {
+			if ok {
+				for _, i := range idx.ByLabel[selector] {
+					match[i] = true
+				}
+			}
+		}
+		for i := range bits {
+			bits[i] = bits[i] && match[i]
+		}
+	}
+
 	result := make([]int, 0)
 	for i, ok := range bits {
 		if ok {
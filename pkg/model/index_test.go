@@ -262,3 +262,54 @@ func TestScopedApps_NilIndex(t *testing.T) {
 		t.Errorf("expected passthrough from nil index, got %d apps", len(result))
 	}
 }
+
+func TestScopedApps_RepoScope(t *testing.T) {
+	apps := []App{
+		{Name: "a", RepoURLs: []string{"https://github.com/example/apps.git"}},
+		{Name: "b", RepoURLs: []string{"https://github.com/example/apps", "https://GitHub.com/example/apps.git/"}},
+		{Name: "c", RepoURLs: []string{"https://charts.example.com"}},
+		{Name: "d"}, // no source
+	}
+	idx := BuildAppIndex(apps)
+
+	if len(idx.Repos) != 2 {
+		t.Errorf("Repos = %v, want one entry per normalized URL", idx.Repos)
+	}
+	if got := idx.ByRepo["https://github.com/example/apps"]; len(got) != 2 || got[0] != 0 || got[1] != 1 {
+		t.Errorf("ByRepo = %v, want [0 1]", got)
+	}
+
+	sel := NewSelectionState()
+	sel.AddRepo("https://github.com/example/apps.git")
+	result := idx.ScopedApps(apps, sel)
+	if len(result) != 2 || result[0].Name != "a" || result[1].Name != "b" {
+		t.Errorf("ScopedApps(apps repo) got %v, want a and b", result)
+	}
+}
+
+func TestScopedApps_LabelScope(t *testing.T) {
+	apps := []App{
+		{Name: "a", Labels: map[string]string{"team": "core", "env": "prod"}},
+		{Name: "b", Labels: map[string]string{"team": "web"}},
+		{Name: "c", Labels: map[string]string{"team": "core"}},
+		{Name: "d"}, // no labels
+	}
+	idx := BuildAppIndex(apps)
+
+	if !reflect.DeepEqual(idx.LabelKeys, []string{"env", "team"}) {
+		t.Errorf("LabelKeys = %v, want [env team]", idx.LabelKeys)
+	}
+	if got := idx.LabelValues["team"]; !reflect.DeepEqual(got, []string{"core", "web"}) {
+		t.Errorf("LabelValues[team] = %v, want [core web]", got)
+	}
+	if got := idx.ByLabel[LabelSelector("team", "core")]; !reflect.DeepEqual(got, []int{0, 2}) {
+		t.Errorf("ByLabel[team=core] = %v, want [0 2]", got)
+	}
+
+	sel := NewSelectionState()
+	sel.AddLabel(LabelSelector("team", "core"))
+	result := idx.ScopedApps(apps, sel)
+	if len(result) != 2 || result[0].Name != "a" || result[1].Name != "c" {
+		t.Errorf("ScopedApps(team=core) got %v, want a and c", result)
+	}
+}
@@ -1,6 +1,8 @@
 package model
 
 import (
+	"time"
+
 	tea "charm.land/bubbletea/v2"
 	apperrors "github.com/darksworm/argonaut/pkg/errors"
 )
@@ -165,7 +167,8 @@ type AppsLoadedMsg struct {
 // AppUpdatedMsg is sent when an app is updated
 type AppUpdatedMsg struct {
 	App           App
-	ResourcesJSON []byte // JSON encoded []api.ResourceStatus for sync status updates
+	ResourcesJSON []byte         // JSON encoded []api.ResourceStatus for sync status updates
+	Operation     *SyncOperation // Current/last sync operation, nil if the app has none
 }
 
 // AppDeletedMsg is sent when an app is deleted (from watch stream)
@@ -253,6 +256,185 @@ type ResourceSyncErrorMsg struct {
 	Error string
 }
 
+// EventsLoadedMsg is sent when Kubernetes events for the events view have been loaded
+type EventsLoadedMsg struct {
+	Session     int
+	Events      []EventRow
+	Err         error
+	SwitchEpoch int // Context switch epoch for stale message gating
+}
+
+// ManifestLoadedMsg is sent when the live and desired manifests for the manifest viewer have been loaded
+type ManifestLoadedMsg struct {
+	Session     int
+	Live        string
+	Desired     string
+	Err         error
+	SwitchEpoch int // Context switch epoch for stale message gating
+}
+
+// ResourceEditedMsg is sent when the editor for a live resource has been closed
+type ResourceEditedMsg struct {
+	Session     int
+	Original    string // YAML opened in the editor
+	Edited      string // YAML saved from the editor
+	Err         error
+	SwitchEpoch int // Context switch epoch for stale message gating
+}
+
+// ResourcePatchedMsg is sent when an edited resource has been patched (or patching failed)
+type ResourcePatchedMsg struct {
+	Session     int
+	Err         error
+	SwitchEpoch int // Context switch epoch for stale message gating
+}
+
+// OperationTerminatedMsg is sent when terminating running operations has finished
+type OperationTerminatedMsg struct {
+	Terminated  []string // Apps whose operation was terminated
+	Errors      []string // One entry per app that could not be terminated
+	SwitchEpoch int      // Context switch epoch for stale message gating
+}
+
+// AutoSyncUpdatedMsg is sent when the automated sync policy of apps has been patched
+type AutoSyncUpdatedMsg struct {
+	Updated     map[string]*AutoSync // New policy per patched app; nil when auto-sync was disabled
+	Errors      []string             // One entry per app that could not be patched
+	SwitchEpoch int                  // Context switch epoch for stale message gating
+}
+
+// SyncProgressLoadedMsg is sent when the operation state for the sync progress view has been fetched
+type SyncProgressLoadedMsg struct {
+	Session     int
+	Operation   *SyncOperation // Nil if the app has no operation
+	Err         error
+	SwitchEpoch int // Context switch epoch for stale message gating
+}
+
+// ApplicationSetsLoadedMsg is sent when the ApplicationSets have been listed
+type ApplicationSetsLoadedMsg struct {
+	Session     int
+	Items       []ApplicationSet
+	Err         error
+	SwitchEpoch int // Context switch epoch for stale message gating
+}
+
+// ApplicationSetWatchMsg is sent for each ApplicationSet watch event, and once when the stream ends
+type ApplicationSetWatchMsg struct {
+	Session     int
+	Type        string // ADDED, MODIFIED, DELETED
+	Set         ApplicationSet
+	Done        bool // Stream ended; Err is set if it failed
+	Err         error
+	SwitchEpoch int // Context switch epoch for stale message gating
+}
+
+// ApplicationSetDeletedMsg is sent when deleting an ApplicationSet has finished
+type ApplicationSetDeletedMsg struct {
+	Name        string
+	Err         error
+	SwitchEpoch int // Context switch epoch for stale message gating
+}
+
+// RepositoriesLoadedMsg is sent when the repositories have been listed
+type RepositoriesLoadedMsg struct {
+	Session     int
+	Items       []Repository
+	Err         error
+	SwitchEpoch int // Context switch epoch for stale message gating
+}
+
+// RepositoryRefreshedMsg is sent when a repository's connection state has been re-checked
+type RepositoryRefreshedMsg struct {
+	URL         string
+	Repo        *Repository
+	Err         error
+	SwitchEpoch int // Context switch epoch for stale message gating
+}
+
+// LoginResultMsg is sent when a username/password login has finished
+type LoginResultMsg struct {
+	Username    string
+	Token       string
+	Err         error
+	SwitchEpoch int // Context switch epoch for stale message gating
+}
+
+// PermissionsLoadedMsg is sent when the can-i checks of the apps' projects have finished
+type PermissionsLoadedMsg struct {
+	Session     int
+	Permissions Permissions
+	Failures    []PermissionCheckError // Checks that failed; their operations stay unchecked
+	Err         error
+	SwitchEpoch int // Context switch epoch for stale message gating
+}
+
+// PermissionCheckError records a single can-i check that failed
+type PermissionCheckError struct {
+	Project string
+	App     string // Set when the check was for one app of the project
+	Action  string
+	Err     error
+}
+
+// ClustersLoadedMsg is sent when the clusters and their connection states have been refreshed
+type ClustersLoadedMsg struct {
+	Session     int
+	Clusters    []Cluster
+	Err         error
+	SwitchEpoch int // Context switch epoch for stale message gating
+}
+
+// ProjectsLoadedMsg is sent when the projects and their active sync windows have been refreshed
+type ProjectsLoadedMsg struct {
+	Session     int
+	Projects    []Project
+	Err         error
+	SwitchEpoch int // Context switch epoch for stale message gating
+}
+
+// ProjectDetailLoadedMsg is sent when the project for the project detail view has been fetched
+type ProjectDetailLoadedMsg struct {
+	Session     int
+	Project     *Project
+	Err         error
+	SwitchEpoch int // Context switch epoch for stale message gating
+}
+
+// AppDetailLoadedMsg is sent when the application for the app detail view has been fetched
+type AppDetailLoadedMsg struct {
+	Session     int
+	Detail      *AppDetail
+	Err         error
+	SwitchEpoch int // Context switch epoch for stale message gating
+}
+
+// ResourceDiffsLoadedMsg is sent when an app diff has been split into its changed resources
+type ResourceDiffsLoadedMsg struct {
+	AppName     string
+	Items       []ResourceDiffItem
+	SwitchEpoch int // Context switch epoch for stale message gating
+}
+
+// ResourceActionsLoadedMsg is sent when the available actions for a resource have been listed
+type ResourceActionsLoadedMsg struct {
+	Actions     []string
+	Object      string          // RBAC object of the resource's app, see AppRBACObject
+	Permitted   map[string]bool // Can-i results for actions not checked before, keyed by RBAC action
+	Error       string
+	SwitchEpoch int // Context switch epoch for stale message gating
+}
+
+// ResourceActionCompletedMsg is sent when a resource action has finished running
+type ResourceActionCompletedMsg struct {
+	AppName     string
+	Action      string
+	Kind        string
+	Name        string
+	Error       string
+	SwitchEpoch int // Context switch epoch for stale message gating
+}
+
 // AuthErrorMsg is sent when authentication is required
 type AuthErrorMsg struct {
 	Error       error
@@ -373,6 +555,9 @@ type SyncCompletedMsg struct {
 	AppName     string
 	Success     bool
 	SwitchEpoch int // Context switch epoch for stale message gating
+	// PreviousOperationAt is the app's LastSyncAt when the sync was requested,
+	// used to tell the new operation apart from the previous one
+	PreviousOperationAt *time.Time
 }
 
 // MultiSyncCompletedMsg indicates multiple app sync has completed
@@ -413,6 +598,7 @@ type RollbackHistoryLoadedMsg struct {
 	AppName         string
 	Rows            []RollbackRow
 	CurrentRevision string
+	AutoSync        *AutoSync // Automated sync policy; ArgoCD rejects rollbacks while it is set
 }
 
 // RollbackMetadataLoadedMsg is sent when git metadata is loaded for a revision
@@ -429,9 +615,10 @@ type RollbackMetadataErrorMsg struct {
 
 // RollbackExecutedMsg is sent when rollback is executed
 type RollbackExecutedMsg struct {
-	AppName string
-	Success bool
-	Watch   bool // Whether to start watching after rollback
+	AppName          string
+	Success          bool
+	Watch            bool // Whether to start watching after rollback
+	AutoSyncDisabled bool // Automated sync was disabled before rolling back
 }
 
 // RollbackNavigationMsg is sent to change rollback navigation
@@ -471,6 +658,22 @@ type ResourceTreeStreamMsg struct {
 	TreeJSON []byte
 }
 
+// PodLogLinesMsg delivers a batch of streamed container log lines
+type PodLogLinesMsg struct {
+	Session int // Logs session the lines belong to; stale sessions are dropped
+	Lines   []string
+	Err     error // Set when the stream terminated with an error
+	Done    bool  // Set when the stream has ended
+}
+
+// PodContainersLoadedMsg is sent when the container list of a pod has been loaded
+type PodContainersLoadedMsg struct {
+	Session    int
+	Pod        string
+	Containers []string
+	Err        error
+}
+
 // Update Messages - for version checking and updates
 
 // UpdateCheckCompletedMsg is sent when update check is completed
@@ -507,10 +710,11 @@ type ChangelogLoadedMsg struct {
 
 // ContextSwitchResultMsg is the result of performContextSwitch
 type ContextSwitchResultMsg struct {
-	Server       *Server
-	ContextName  string
-	ContextNames []string
-	Error        error
+	Server        *Server
+	ContextName   string
+	ContextNames  []string
+	ContextTokens map[string]TokenStatus
+	Error         error
 }
 
 // AuthValidationResultMsg is the result of validateAuthentication,
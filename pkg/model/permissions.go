@@ -0,0 +1,125 @@
+package model
+
+import (
+	"fmt"
+	"maps"
+)
+
+// AppOperation is an app operation that ArgoCD RBAC may deny
+type AppOperation string
+
+const (
+	OpSync           AppOperation = "sync"
+	OpRollback       AppOperation = "rollback"
+	OpDelete         AppOperation = "delete"
+	OpRefresh        AppOperation = "refresh"
+	OpUpdate         AppOperation = "update"
+	OpResourceAction AppOperation = "action"
+)
+
+// CheckedOperations are the operations checked per project when a context loads. Resource
+// actions are checked per action when their list is opened.
+var CheckedOperations = []AppOperation{OpSync, OpRollback, OpDelete, OpRefresh, OpUpdate}
+
+// RBACAction returns the ArgoCD RBAC action on applications that an operation needs
+func (o AppOperation) RBACAction() string {
+	switch o {
+	case OpSync, OpRollback:
+		// ArgoCD authorizes rollbacks as syncs
+		return "sync"
+	case OpRefresh:
+		// Refreshing only needs read access
+		return "get"
+	case OpResourceAction:
+		return "action/*"
+	default:
+		return string(o)
+	}
+}
+
+// ResourceActionRBAC returns the RBAC action for running a resource action, e.g. action/apps/Deployment/restart
+func ResourceActionRBAC(group, kind, action string) string {
+	return fmt.Sprintf("action/%s/%s/%s", group, kind, action)
+}
+
+// Permissions caches can-i results as RBAC object → RBAC action → allowed. Objects are a
+// project for the results of project/* checks, or AppRBACObject for the results of one app.
+// Anything not checked is allowed, so a failed check never locks the user out. A Permissions
+// stored in state is shared with background commands and never modified; new results replace
+// it via Merge.
+type Permissions map[string]map[string]bool
+
+// AppRBACObject returns the RBAC object of an app, project/app, under which its can-i results are kept
+func AppRBACObject(project, appName string) string {
+	return project + "/" + appName
+}
+
+// Merge returns a new Permissions with the results of other added to those of p
+func (p Permissions) Merge(other Permissions) Permissions {
+	merged := make(Permissions, len(p)+len(other))
+	for project, actions := range p {
+		merged[project] = maps.Clone(actions)
+	}
+	for project, actions := range other {
+		for action, allowed := range actions {
+			merged.Set(project, action, allowed)
+		}
+	}
+	return merged
+}
+
+// Set records the result of a can-i check
+func (p Permissions) Set(project, rbacAction string, allowed bool) {
+	if p[project] == nil {
+		p[project] = make(map[string]bool)
+	}
+	p[project][rbacAction] = allowed
+}
+
+// Checked reports whether a can-i result is cached
+func (p Permissions) Checked(project, rbacAction string) bool {
+	_, ok := p[project][rbacAction]
+	return ok
+}
+
+// Allowed reports whether an RBAC action is allowed in a project
+func (p Permissions) Allowed(project, rbacAction string) bool {
+	allowed, ok := p[project][rbacAction]
+	return !ok || allowed
+}
+
+// AllowedForApp reports whether an RBAC action is allowed on an app, preferring the app's own
+// result over that of its project
+func (p Permissions) AllowedForApp(project, appName, rbacAction string) bool {
+	if allowed, ok := p[AppRBACObject(project, appName)][rbacAction]; ok {
+		return allowed
+	}
+	return p.Allowed(project, rbacAction)
+}
+
+// OperationAllowed reports whether an operation is allowed on apps of a project
+func (p Permissions) OperationAllowed(project string, op AppOperation) bool {
+	return p.Allowed(project, op.RBACAction())
+}
+
+// OperationAllowedForApp reports whether an operation is allowed on an app
+func (p Permissions) OperationAllowedForApp(app App, op AppOperation) bool {
+	project := ""
+	if app.Project != nil {
+		project = *app.Project
+	}
+	return p.AllowedForApp(project, app.Name, op.RBACAction())
+}
+
+// OperationAvailable reports whether an operation is allowed on at least one of the apps
+func (p Permissions) OperationAvailable(apps []App, op AppOperation) bool {
+	if len(p) == 0 || len(apps) == 0 {
+		return true
+	}
+	for _, app := range apps {
+		if p.OperationAllowedForApp(app, op) {
+			return true
+		}
+	}
+	return false
+}
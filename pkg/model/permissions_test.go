@@ -0,0 +1,55 @@
+package model
+
+import "testing"
+
+func TestPermissions(t *testing.T) {
+	teamA, teamB := "team-a", "team-b"
+	apps := []App{{Name: "a", Project: &teamA}, {Name: "b", Project: &teamB}}
+
+	var unknown Permissions
+	if !unknown.OperationAllowed("team-a", OpSync) || !unknown.OperationAvailable(apps, OpDelete) {
+		t.Error("Expected unchecked operations to be allowed")
+	}
+
+	perms := make(Permissions)
+	perms.Set("team-a", "sync", false)
+	perms.Set("team-a", "delete", false)
+	perms.Set("team-b", "delete", false)
+	if perms.OperationAllowed("team-a", OpRollback) {
+		t.Error("Expected rollback to need sync")
+	}
+	if !perms.OperationAllowedForApp(apps[1], OpSync) {
+		t.Error("Expected sync to be allowed in team-b")
+	}
+	if !perms.OperationAvailable(apps, OpSync) || perms.OperationAvailable(apps, OpDelete) {
+		t.Error("Expected sync to be available in one project and delete in none")
+	}
+	if !perms.Checked("team-b", "delete") || perms.Checked("team-b", "sync") {
+		t.Error("Unexpected Checked result")
+	}
+}
+
+func TestPermissions_AppResultsWin(t *testing.T) {
+	teamA := "team-a"
+	app := App{Name: "web", Project: &teamA}
+	perms := Permissions{AppRBACObject("team-a", "web"): {"sync": true, "delete": false}, "team-a": {"sync": false}}
+
+	if !perms.OperationAllowedForApp(app, OpSync) || perms.OperationAllowedForApp(app, OpDelete) {
+		t.Errorf("Expected the app's own results to win, got %v", perms)
+	}
+	if perms.OperationAllowedForApp(App{Name: "api", Project: &teamA}, OpSync) {
+		t.Error("Expected other apps to fall back to the project result")
+	}
+}
+
+func TestPermissions_MergeCopies(t *testing.T) {
+	perms := Permissions{"team-a": {"sync": true}}
+	merged := perms.Merge(Permissions{"team-a": {"delete": false}, "team-b": {"sync": false}})
+
+	if perms.Checked("team-a", "delete") || perms.Checked("team-b", "sync") {
+		t.Errorf("Expected the original to be left untouched, got %v", perms)
+	}
+	if !merged.Allowed("team-a", "sync") || merged.Allowed("team-a", "delete") || merged.Allowed("team-b", "sync") {
+		t.Errorf("Unexpected merged permissions %v", merged)
+	}
+}
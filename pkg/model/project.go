@@ -0,0 +1,214 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Project represents an ArgoCD AppProject
+type Project struct {
+	Name                   string               `json:"name"`
+	Description            string               `json:"description,omitempty"`
+	SourceRepos            []string             `json:"sourceRepos,omitempty"`
+	Destinations           []ProjectDestination `json:"destinations,omitempty"`
+	ClusterResourceAllow   []string             `json:"clusterResourceAllow,omitempty"` // "group/kind" entries
+	ClusterResourceDeny    []string             `json:"clusterResourceDeny,omitempty"`
+	NamespaceResourceAllow []string             `json:"namespaceResourceAllow,omitempty"`
+	NamespaceResourceDeny  []string             `json:"namespaceResourceDeny,omitempty"`
+	Roles                  []ProjectRole        `json:"roles,omitempty"`
+	SyncWindows            []SyncWindow         `json:"syncWindows,omitempty"`   // Every window defined on the project
+	ActiveWindows          []SyncWindow         `json:"activeWindows,omitempty"` // Windows active when the project was fetched
+	// Why the active windows could not be fetched; the project's windows are then unknown
+	ActiveWindowsErr string `json:"activeWindowsErr,omitempty"`
+}
+
+// ProjectDestination is a cluster and namespace apps of a project may deploy to
+type ProjectDestination struct {
+	Server    string `json:"server,omitempty"`
+	Name      string `json:"name,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// ProjectRole is a project-scoped RBAC role
+type ProjectRole struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Policies    []string `json:"policies,omitempty"`
+	Groups      []string `json:"groups,omitempty"`
+}
+
+// SyncWindow is a time window in which syncs of matching apps are allowed or denied
+type SyncWindow struct {
+	Kind         string   `json:"kind"` // allow or deny
+	Schedule     string   `json:"schedule"`
+	Duration     string   `json:"duration"`
+	TimeZone     string   `json:"timeZone,omitempty"`
+	Applications []string `json:"applications,omitempty"`
+	Namespaces   []string `json:"namespaces,omitempty"`
+	Clusters     []string `json:"clusters,omitempty"`
+	ManualSync   bool     `json:"manualSync,omitempty"` // Manual syncs are still allowed while the window applies
+	AndOperator  bool     `json:"andOperator,omitempty"`
+}
+
+// Describe summarizes the window's timing, e.g. "deny 0 22 * * * for 8h"
+func (w SyncWindow) Describe() string {
+	s := fmt.Sprintf("%s %s for %s", w.Kind, w.Schedule, w.Duration)
+	if w.TimeZone != "" {
+		s += " (" + w.TimeZone + ")"
+	}
+	return s
+}
+
+// Matches reports whether the window applies to the app. Like ArgoCD, an app matches when its
+// name, destination namespace or destination cluster matches one of the window's patterns, or
+// all of the given criteria when the window uses the AND operator.
+func (w SyncWindow) Matches(app App) bool {
+	namespace := ""
+	if app.Namespace != nil {
+		namespace = *app.Namespace
+	}
+	// Clusters match the destination server URL or name exactly as the app sets them
+	var clusters []string
+	for _, c := range []string{app.DestinationServer, app.DestinationName} {
+		if c != "" {
+			clusters = append(clusters, c)
+		}
+	}
+
+	type criterion struct {
+		patterns []string
+		values   []string
+	}
+	criteria := []criterion{
+		{w.Applications, []string{app.Name}},
+		{w.Namespaces, []string{namespace}},
+		{w.Clusters, clusters},
+	}
+	matched, given := 0, 0
+	for _, c := range criteria {
+		if len(c.patterns) == 0 {
+			continue
+		}
+		given++
+		if matchesAnyPattern(c.patterns, c.values) {
+			matched++
+		}
+	}
+	if w.AndOperator {
+		return given > 0 && matched == given
+	}
+	return matched > 0
+}
+
+// SyncBlocked reports whether a manual sync of the app is blocked by the project's sync windows
+// right now, following ArgoCD's rules: an active deny window blocks unless every active deny
+// window allows manual syncs, and apps with allow windows may only sync while one is active.
+// The returned reason names the window responsible.
+func (p Project) SyncBlocked(app App) (bool, string) {
+	var denies, allows, inactiveAllows []SyncWindow
+	for _, w := range p.ActiveWindows {
+		if !w.Matches(app) {
+			continue
+		}
+		switch w.Kind {
+		case "deny":
+			denies = append(denies, w)
+		case "allow":
+			allows = append(allows, w)
+		}
+	}
+	if len(denies) > 0 {
+		for _, w := range denies {
+			if !w.ManualSync {
+				return true, "deny window " + w.Schedule + " for " + w.Duration + " is active"
+			}
+		}
+		return false, ""
+	}
+	if len(allows) > 0 {
+		return false, ""
+	}
+	for _, w := range p.SyncWindows {
+		if w.Kind == "allow" && w.Matches(app) && !p.IsActive(w) {
+			inactiveAllows = append(inactiveAllows, w)
+		}
+	}
+	for _, w := range inactiveAllows {
+		if w.ManualSync {
+			return false, ""
+		}
+	}
+	if len(inactiveAllows) > 0 {
+		return true, "outside allow window " + inactiveAllows[0].Schedule + " for " + inactiveAllows[0].Duration
+	}
+	return false, ""
+}
+
+// SyncBlock is the sync window state of an app, evaluated when projects are refreshed
+type SyncBlock struct {
+	Blocked bool   `json:"blocked,omitempty"`
+	Unknown bool   `json:"unknown,omitempty"` // The project's active windows could not be fetched
+	Reason  string `json:"reason,omitempty"`
+}
+
+// SyncBlockFor evaluates the project's sync windows for the app like SyncBlocked, reporting
+// the state as unknown when the active windows could not be fetched
+func (p Project) SyncBlockFor(app App) SyncBlock {
+	if p.ActiveWindowsErr != "" {
+		return SyncBlock{Unknown: true, Reason: "active sync windows of project " + p.Name + " could not be fetched: " + p.ActiveWindowsErr}
+	}
+	blocked, reason := p.SyncBlocked(app)
+	return SyncBlock{Blocked: blocked, Reason: reason}
+}
+
+// IsActive reports whether w is one of the project's active windows
+func (p Project) IsActive(w SyncWindow) bool {
+	for _, a := range p.ActiveWindows {
+		if a.Kind == w.Kind && a.Schedule == w.Schedule && a.Duration == w.Duration && a.TimeZone == w.TimeZone &&
+			strings.Join(a.Applications, ",") == strings.Join(w.Applications, ",") &&
+			strings.Join(a.Namespaces, ",") == strings.Join(w.Namespaces, ",") &&
+			strings.Join(a.Clusters, ",") == strings.Join(w.Clusters, ",") {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesAnyPattern reports whether any non-empty value matches any of the glob patterns
+func matchesAnyPattern(patterns, values []string) bool {
+	for _, p := range patterns {
+		for _, v := range values {
+			if v != "" && globMatch(p, v) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// globMatch matches s against a pattern where * matches any run of characters (including "/")
+// and ? matches a single character
+func globMatch(pattern, s string) bool {
+	for len(pattern) > 0 {
+		switch pattern[0] {
+		case '*':
+			for i := len(s); i >= 0; i-- {
+				if globMatch(pattern[1:], s[i:]) {
+					return true
+				}
+			}
+			return false
+		case '?':
+			if len(s) == 0 {
+				return false
+			}
+			pattern, s = pattern[1:], s[1:]
+		default:
+			if len(s) == 0 || pattern[0] != s[0] {
+				return false
+			}
+			pattern, s = pattern[1:], s[1:]
+		}
+	}
+	return len(s) == 0
+}
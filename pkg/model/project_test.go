@@ -0,0 +1,109 @@
+package model
+
+import "testing"
+
+func projectTestApp(name, namespace, server string) App {
+	host := "in-cluster"
+	return App{Name: name, Namespace: &namespace, ClusterID: &host, ClusterLabel: &host, DestinationServer: server}
+}
+
+func TestSyncWindowMatches(t *testing.T) {
+	app := projectTestApp("payments-api", "payments", "https://kubernetes.default.svc")
+	tests := []struct {
+		name   string
+		window SyncWindow
+		want   bool
+	}{
+		{"app glob", SyncWindow{Applications: []string{"payments-*"}}, true},
+		{"other app", SyncWindow{Applications: []string{"billing"}}, false},
+		{"namespace", SyncWindow{Namespaces: []string{"pay?ents"}}, true},
+		{"cluster server url", SyncWindow{Clusters: []string{"https://kubernetes.default.svc"}}, true},
+		{"cluster name not set on app", SyncWindow{Clusters: []string{"in-cluster"}}, false},
+		{"any criterion", SyncWindow{Applications: []string{"billing"}, Namespaces: []string{"payments"}}, true},
+		{"and operator", SyncWindow{Applications: []string{"billing"}, Namespaces: []string{"payments"}, AndOperator: true}, false},
+		{"no criteria", SyncWindow{}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.window.Matches(app); got != tt.want {
+				t.Errorf("Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSyncWindowMatchesDestination(t *testing.T) {
+	byServer := projectTestApp("orders", "orders", "https://10.0.0.1:6443")
+	byName := App{Name: "orders", DestinationName: "prod-eu"}
+	tests := []struct {
+		name   string
+		app    App
+		window SyncWindow
+		want   bool
+	}{
+		{"server with port", byServer, SyncWindow{Clusters: []string{"https://10.0.0.1:6443"}}, true},
+		{"server glob", byServer, SyncWindow{Clusters: []string{"https://10.0.0.*"}}, true},
+		{"server without port", byServer, SyncWindow{Clusters: []string{"https://10.0.0.1"}}, false},
+		{"destination name", byName, SyncWindow{Clusters: []string{"prod-*"}}, true},
+		{"name does not match a server", byName, SyncWindow{Clusters: []string{"https://prod-eu"}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.window.Matches(tt.app); got != tt.want {
+				t.Errorf("Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProjectSyncBlocked(t *testing.T) {
+	app := projectTestApp("payments-api", "payments", "https://kubernetes.default.svc")
+	deny := SyncWindow{Kind: "deny", Schedule: "0 22 * * *", Duration: "8h", Applications: []string{"*"}}
+	allow := SyncWindow{Kind: "allow", Schedule: "0 9 * * 1-5", Duration: "9h", Namespaces: []string{"payments"}}
+	manualDeny := deny
+	manualDeny.ManualSync = true
+	manualAllow := allow
+	manualAllow.ManualSync = true
+
+	tests := []struct {
+		name    string
+		project Project
+		want    bool
+	}{
+		{"no windows", Project{}, false},
+		{"active deny", Project{SyncWindows: []SyncWindow{deny}, ActiveWindows: []SyncWindow{deny}}, true},
+		{"active deny allowing manual sync", Project{SyncWindows: []SyncWindow{manualDeny}, ActiveWindows: []SyncWindow{manualDeny}}, false},
+		{"inactive deny", Project{SyncWindows: []SyncWindow{deny}}, false},
+		{"active allow", Project{SyncWindows: []SyncWindow{allow}, ActiveWindows: []SyncWindow{allow}}, false},
+		{"outside allow window", Project{SyncWindows: []SyncWindow{allow}}, true},
+		{"outside allow window allowing manual sync", Project{SyncWindows: []SyncWindow{manualAllow}}, false},
+		{"deny wins over allow", Project{SyncWindows: []SyncWindow{deny, allow}, ActiveWindows: []SyncWindow{deny, allow}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, reason := tt.project.SyncBlocked(app)
+			if got != tt.want {
+				t.Errorf("SyncBlocked() = %v (%q), want %v", got, reason, tt.want)
+			}
+			if got && reason == "" {
+				t.Error("Expected a reason for a blocked sync")
+			}
+		})
+	}
+}
+
+func TestProjectSyncBlockFor_UnknownWindows(t *testing.T) {
+	app := projectTestApp("payments-api", "payments", "https://kubernetes.default.svc")
+	allow := SyncWindow{Kind: "allow", Schedule: "0 9 * * 1-5", Duration: "9h", Namespaces: []string{"payments"}}
+
+	p := Project{Name: "payments", SyncWindows: []SyncWindow{allow}, ActiveWindowsErr: "permission denied"}
+	block := p.SyncBlockFor(app)
+	if block.Blocked || !block.Unknown || block.Reason == "" {
+		t.Fatalf("Expected unknown windows without a block, got %+v", block)
+	}
+
+	p.ActiveWindowsErr = ""
+	if block := p.SyncBlockFor(app); !block.Blocked || block.Unknown {
+		t.Fatalf("Expected the closed allow window to block, got %+v", block)
+	}
+}
@@ -0,0 +1,545 @@
+package model
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParsedAppQuery caches the parse of a search or filter text so it is only parsed when the
+// text changes
+type ParsedAppQuery struct {
+	Text  string
+	Query *AppQuery
+	Err   error
+}
+
+// QueryField describes a field accepted in app filter queries
+type QueryField struct {
+	Name    string
+	Aliases []string
+	Compare bool // Takes a comparison (age>7d) instead of a value (health:Degraded)
+}
+
+// QueryFields lists the fields of the app filter query language
+var QueryFields = []QueryField{
+	{Name: "name"},
+	{Name: "health"},
+	{Name: "sync"},
+	{Name: "project", Aliases: []string{"proj"}},
+	{Name: "cluster"},
+	{Name: "namespace", Aliases: []string{"ns"}},
+	{Name: "appset"},
+	{Name: "repo"},
+	{Name: "label"},
+	{Name: "phase"},
+	{Name: "age", Compare: true},
+}
+
+// LookupQueryField resolves a field name or alias
+func LookupQueryField(name string) (QueryField, bool) {
+	name = strings.ToLower(name)
+	for _, f := range QueryFields {
+		if f.Name == name {
+			return f, true
+		}
+		for _, alias := range f.Aliases {
+			if alias == name {
+				return f, true
+			}
+		}
+	}
+	return QueryField{}, false
+}
+
+// AppQuery is a parsed app filter expression such as
+// `health:Degraded sync:OutOfSync project:payments label:team=core repo:~charts age>7d`.
+//
+// Terms separated by spaces must all match; OR (or |) between terms matches either side and
+// binds looser than the implicit AND. A leading - or ! (or NOT) negates a term, and parentheses
+// group terms. Values are matched case-insensitively: `~text` matches a substring, * and ?
+// are globs, and commas list alternatives (health:Degraded,Missing). A field without a value
+// matches apps that have the field set. Words without a field match the name, sync and health
+// status, namespace or project like the plain search. age is the time since the last sync.
+//
+// Input without any field term is not parsed: it is matched as a whole, like the plain search,
+// so `my-app foo` and `-canary` keep working as literal text. Only known field names start a
+// field term; other words with a colon, such as `http://host` or `foo:bar`, are plain words.
+type AppQuery struct {
+	root queryNode
+}
+
+// queryNode is a node of the parsed expression tree
+type queryNode interface {
+	matches(app App, now time.Time) bool
+}
+
+type queryAnd []queryNode
+type queryOr []queryNode
+type queryNot struct{ node queryNode }
+
+func (q queryAnd) matches(app App, now time.Time) bool {
+	for _, n := range q {
+		if !n.matches(app, now) {
+			return false
+		}
+	}
+	return true
+}
+
+func (q queryOr) matches(app App, now time.Time) bool {
+	for _, n := range q {
+		if n.matches(app, now) {
+			return true
+		}
+	}
+	return false
+}
+
+func (q queryNot) matches(app App, now time.Time) bool {
+	return !q.node.matches(app, now)
+}
+
+// queryText matches a word without a field against the fields the plain search covers
+type queryText string
+
+func (q queryText) matches(app App, now time.Time) bool {
+	for _, v := range []string{app.Name, app.Sync, app.Health, derefString(app.Namespace), derefString(app.Project)} {
+		if strings.Contains(strings.ToLower(v), string(q)) {
+			return true
+		}
+	}
+	return false
+}
+
+// queryValue matches one or more alternatives against a field's values
+type queryValue []string
+
+func (q queryValue) matchesAny(values ...string) bool {
+	for _, v := range values {
+		v = strings.ToLower(v)
+		for _, want := range q {
+			switch {
+			case strings.HasPrefix(want, "~"):
+				if strings.Contains(v, want[1:]) {
+					return true
+				}
+			case strings.ContainsAny(want, "*?["):
+				if ok, _ := path.Match(want, v); ok {
+					return true
+				}
+			case v == want:
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// queryFieldTerm matches a field:value term
+type queryFieldTerm struct {
+	field string
+	value queryValue // Empty matches any non-empty value
+}
+
+func (q queryFieldTerm) matches(app App, now time.Time) bool {
+	var values []string
+	switch q.field {
+	case "name":
+		values = []string{app.Name}
+	case "health":
+		values = []string{app.Health}
+	case "sync":
+		values = []string{app.Sync}
+	case "project":
+		values = []string{derefString(app.Project)}
+	case "cluster":
+		values = []string{derefString(app.ClusterLabel)}
+	case "namespace":
+		values = []string{derefString(app.Namespace)}
+	case "appset":
+		values = []string{derefString(app.ApplicationSet)}
+	case "phase":
+		values = []string{app.OperationPhase}
+	case "repo":
+		for _, repoURL := range app.RepoURLs {
+			values = append(values, NormalizeRepoURL(repoURL))
+		}
+	}
+	if len(q.value) == 0 {
+		for _, v := range values {
+			if v != "" {
+				return true
+			}
+		}
+		return false
+	}
+	return q.value.matchesAny(values...)
+}
+
+// queryLabelTerm matches label:key or label:key=value
+type queryLabelTerm struct {
+	key   string
+	value queryValue // Nil matches any value of the key
+}
+
+func (q queryLabelTerm) matches(app App, now time.Time) bool {
+	for k, v := range app.Labels {
+		if !strings.EqualFold(k, q.key) {
+			continue
+		}
+		if q.value == nil || q.value.matchesAny(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// queryAgeTerm compares the time since the last sync; apps that never synced never match
+type queryAgeTerm struct {
+	op  string
+	age time.Duration
+}
+
+func (q queryAgeTerm) matches(app App, now time.Time) bool {
+	if app.LastSyncAt == nil {
+		return false
+	}
+	age := now.Sub(*app.LastSyncAt)
+	switch q.op {
+	case ">":
+		return age > q.age
+	case ">=":
+		return age >= q.age
+	case "<":
+		return age < q.age
+	case "<=":
+		return age <= q.age
+	default:
+		return false
+	}
+}
+
+// ParseAppQuery parses an app filter expression. An empty expression matches every app.
+func ParseAppQuery(expr string) (*AppQuery, error) {
+	if !hasFieldSyntax(expr) {
+		if strings.TrimSpace(expr) == "" {
+			return &AppQuery{root: queryAnd{}}, nil
+		}
+		return LiteralAppQuery(expr), nil
+	}
+	tokens, err := tokenizeQuery(expr)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return &AppQuery{root: queryAnd{}}, nil
+	}
+	p := &queryParser{tokens: tokens}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if tok, ok := p.peek(); ok {
+		return nil, fmt.Errorf("unexpected %q", tok.text)
+	}
+	return &AppQuery{root: root}, nil
+}
+
+// LiteralAppQuery matches text as a whole against the fields the plain search covers
+func LiteralAppQuery(text string) *AppQuery {
+	return &AppQuery{root: queryText(strings.ToLower(text))}
+}
+
+// hasFieldSyntax reports whether an expression has a field term such as health:Degraded or
+// age>7d, which makes it a query rather than literal search text. Words whose prefix is not a
+// known field, like http://host, do not count.
+func hasFieldSyntax(expr string) bool {
+	for _, word := range strings.Fields(expr) {
+		word = strings.TrimLeft(word, "-!(")
+		sep := strings.IndexAny(word, ":<>")
+		if sep <= 0 {
+			continue
+		}
+		if _, ok := LookupQueryField(word[:sep]); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Matches reports whether an app matches the query; now is used for age comparisons
+func (q *AppQuery) Matches(app App, now time.Time) bool {
+	return q.root.matches(app, now)
+}
+
+type queryTokenKind int
+
+const (
+	queryTokenTerm queryTokenKind = iota
+	queryTokenOr
+	queryTokenNot
+	queryTokenOpen
+	queryTokenClose
+)
+
+type queryToken struct {
+	kind queryTokenKind
+	text string
+}
+
+// tokenizeQuery splits an expression into terms, operators and parentheses. Double quotes
+// keep spaces inside a term: label:owner="platform team".
+func tokenizeQuery(expr string) ([]queryToken, error) {
+	var tokens []queryToken
+	runes := []rune(expr)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case r == ' ' || r == '\t':
+			i++
+			continue
+		case r == '(':
+			tokens = append(tokens, queryToken{kind: queryTokenOpen, text: "("})
+			i++
+			continue
+		case r == ')':
+			tokens = append(tokens, queryToken{kind: queryTokenClose, text: ")"})
+			i++
+			continue
+		case r == '|':
+			tokens = append(tokens, queryToken{kind: queryTokenOr, text: "|"})
+			i++
+			continue
+		case r == '-' || r == '!':
+			tokens = append(tokens, queryToken{kind: queryTokenNot, text: string(r)})
+			i++
+			continue
+		}
+
+		var b strings.Builder
+		inQuotes := false
+		for ; i < len(runes); i++ {
+			r := runes[i]
+			if r == '"' {
+				inQuotes = !inQuotes
+				continue
+			}
+			if !inQuotes && (r == ' ' || r == '\t' || r == '(' || r == ')' || r == '|') {
+				break
+			}
+			b.WriteRune(r)
+		}
+		if inQuotes {
+			return nil, fmt.Errorf("unterminated quote")
+		}
+		switch text := b.String(); text {
+		case "OR":
+			tokens = append(tokens, queryToken{kind: queryTokenOr, text: text})
+		case "NOT":
+			tokens = append(tokens, queryToken{kind: queryTokenNot, text: text})
+		default:
+			tokens = append(tokens, queryToken{kind: queryTokenTerm, text: text})
+		}
+	}
+	return tokens, nil
+}
+
+type queryParser struct {
+	tokens []queryToken
+	pos    int
+}
+
+func (p *queryParser) peek() (queryToken, bool) {
+	if p.pos >= len(p.tokens) {
+		return queryToken{}, false
+	}
+	return p.tokens[p.pos], true
+}
+
+// parseOr parses and-groups separated by OR
+func (p *queryParser) parseOr() (queryNode, error) {
+	var groups queryOr
+	for {
+		group, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, group)
+		tok, ok := p.peek()
+		if !ok || tok.kind != queryTokenOr {
+			break
+		}
+		p.pos++
+	}
+	if len(groups) == 1 {
+		return groups[0], nil
+	}
+	return groups, nil
+}
+
+// parseAnd parses terms up to the next OR, closing parenthesis or the end
+func (p *queryParser) parseAnd() (queryNode, error) {
+	var terms queryAnd
+	for {
+		tok, ok := p.peek()
+		if !ok || tok.kind == queryTokenOr || tok.kind == queryTokenClose {
+			break
+		}
+		term, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		terms = append(terms, term)
+	}
+	if len(terms) == 0 {
+		return nil, fmt.Errorf("expected a filter before and after OR")
+	}
+	if len(terms) == 1 {
+		return terms[0], nil
+	}
+	return terms, nil
+}
+
+// parseUnary parses a possibly negated term or parenthesized group
+func (p *queryParser) parseUnary() (queryNode, error) {
+	tok, ok := p.peek()
+	if !ok {
+		return nil, fmt.Errorf("expected a filter at the end")
+	}
+	p.pos++
+	switch tok.kind {
+	case queryTokenNot:
+		if next, ok := p.peek(); !ok || next.kind != queryTokenTerm && next.kind != queryTokenOpen && next.kind != queryTokenNot {
+			return nil, fmt.Errorf("expected a filter after %q", tok.text)
+		}
+		node, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return queryNot{node: node}, nil
+	case queryTokenOpen:
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if next, ok := p.peek(); !ok || next.kind != queryTokenClose {
+			return nil, fmt.Errorf("missing closing parenthesis")
+		}
+		p.pos++
+		return node, nil
+	case queryTokenTerm:
+		return parseQueryTerm(tok.text)
+	default:
+		return nil, fmt.Errorf("unexpected %q", tok.text)
+	}
+}
+
+// parseQueryTerm parses a single field:value, label:key=value, age>duration or plain word
+func parseQueryTerm(text string) (queryNode, error) {
+	sep := strings.IndexAny(text, ":<>=")
+	if sep <= 0 {
+		return queryText(strings.ToLower(text)), nil
+	}
+	name := text[:sep]
+	field, ok := LookupQueryField(name)
+	if !ok {
+		// e.g. a name search for "a=b" or "foo:bar"
+		return queryText(strings.ToLower(text)), nil
+	}
+
+	if field.Compare {
+		rest := text[sep:]
+		var op string
+		for _, candidate := range []string{">=", "<=", ">", "<"} {
+			if strings.HasPrefix(rest, candidate) {
+				op = candidate
+				break
+			}
+		}
+		if op == "" {
+			return nil, fmt.Errorf("%s needs a comparison, e.g. %s>7d", field.Name, field.Name)
+		}
+		age, err := ParseQueryDuration(rest[len(op):])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", field.Name, err)
+		}
+		return queryAgeTerm{op: op, age: age}, nil
+	}
+
+	if text[sep] != ':' {
+		return nil, fmt.Errorf("%s takes a value, e.g. %s:value", field.Name, field.Name)
+	}
+	value := text[sep+1:]
+	if field.Name == "label" {
+		key, labelValue, hasValue := strings.Cut(value, "=")
+		if key == "" {
+			return nil, fmt.Errorf("label needs a key, e.g. label:team=core")
+		}
+		term := queryLabelTerm{key: key}
+		if hasValue {
+			term.value = parseQueryValue(labelValue)
+			if term.value == nil {
+				term.value = queryValue{""}
+			}
+		}
+		return term, nil
+	}
+	if field.Name == "repo" {
+		return queryFieldTerm{field: field.Name, value: parseRepoQueryValue(value)}, nil
+	}
+	return queryFieldTerm{field: field.Name, value: parseQueryValue(value)}, nil
+}
+
+// parseQueryValue splits comma-separated alternatives and lowercases them
+func parseQueryValue(value string) queryValue {
+	var out queryValue
+	for _, alt := range strings.Split(value, ",") {
+		if alt = strings.TrimSpace(alt); alt != "" {
+			out = append(out, strings.ToLower(alt))
+		}
+	}
+	return out
+}
+
+// parseRepoQueryValue normalizes exact repository URLs like the index does, so
+// repo:https://github.com/org/charts.git matches apps using .../charts
+func parseRepoQueryValue(value string) queryValue {
+	out := parseQueryValue(value)
+	for i, alt := range out {
+		if !strings.HasPrefix(alt, "~") && !strings.ContainsAny(alt, "*?[") {
+			out[i] = NormalizeRepoURL(alt)
+		}
+	}
+	return out
+}
+
+// ParseQueryDuration parses durations like 30m, 12h, 7d or 2w
+func ParseQueryDuration(s string) (time.Duration, error) {
+	if s == "" {
+		return 0, fmt.Errorf("missing duration, e.g. 7d")
+	}
+	for suffix, unit := range map[string]time.Duration{"d": 24 * time.Hour, "w": 7 * 24 * time.Hour} {
+		if n, ok := strings.CutSuffix(s, suffix); ok {
+			v, err := strconv.Atoi(n)
+			if err != nil || v < 0 {
+				return 0, fmt.Errorf("invalid duration %q", s)
+			}
+			return time.Duration(v) * unit, nil
+		}
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("invalid duration %q", s)
+	}
+	return d, nil
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,137 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+func queryTestApps(now time.Time) []App {
+	str := func(s string) *string { return &s }
+	at := func(d time.Duration) *time.Time { t := now.Add(-d); return &t }
+	return []App{
+		{
+			Name: "payments-api", Sync: "OutOfSync", Health: "Degraded", Project: str("payments"),
+			ClusterLabel: str("prod"), Namespace: str("payments"), LastSyncAt: at(10 * 24 * time.Hour),
+			RepoURLs: []string{"https://github.com/acme/charts.git"}, Labels: map[string]string{"team": "core", "env": "prod"},
+		},
+		{
+			Name: "payments-worker", Sync: "Synced", Health: "Healthy", Project: str("payments"),
+			ClusterLabel: str("prod"), Namespace: str("payments"), LastSyncAt: at(time.Hour), OperationPhase: "Running",
+			RepoURLs: []string{"https://github.com/acme/payments"}, Labels: map[string]string{"team": "core"},
+		},
+		{
+			Name: "billing", Sync: "OutOfSync", Health: "Missing", Project: str("finance"),
+			ClusterLabel: str("staging"), ApplicationSet: str("billing-set"),
+			Labels: map[string]string{"team": "finance", "owner": "platform team"},
+		},
+	}
+}
+
+func TestParseAppQuery_Matches(t *testing.T) {
+	now := time.Date(2030, 1, 10, 12, 0, 0, 0, time.UTC)
+	apps := queryTestApps(now)
+	tests := []struct {
+		expr string
+		want []string
+	}{
+		{"", []string{"payments-api", "payments-worker", "billing"}},
+		{"health:Degraded sync:OutOfSync project:payments label:team=core repo:~charts age>7d", []string{"payments-api"}},
+		{"health:degraded", []string{"payments-api"}},
+		{"health:Degraded,Missing", []string{"payments-api", "billing"}},
+		{"sync:OutOfSync -health:Missing", []string{"payments-api"}},
+		{"!project:payments", []string{"billing"}},
+		{"NOT project:payments", []string{"billing"}},
+		{"health:Missing OR phase:Running", []string{"payments-worker", "billing"}},
+		{"health:Missing | label:env=prod", []string{"payments-api", "billing"}},
+		{"sync:OutOfSync project:finance OR health:Healthy", []string{"payments-worker", "billing"}},
+		{"(health:Missing OR health:Healthy) project:payments", []string{"payments-worker"}},
+		{"-(project:payments sync:Synced)", []string{"payments-api", "billing"}},
+		{"name:payments-*", []string{"payments-api", "payments-worker"}},
+		{"ns:payments cluster:prod", []string{"payments-api", "payments-worker"}},
+		{"appset:", []string{"billing"}},
+		{"appset:billing-set", []string{"billing"}},
+		{"label:env", []string{"payments-api"}},
+		{"label:team=fin*", []string{"billing"}},
+		{`label:owner="platform team"`, []string{"billing"}},
+		{"repo:https://github.com/acme/charts", []string{"payments-api"}},
+		{"repo:github.com/acme/*", []string{}},
+		{"repo:https://github.com/acme/*", []string{"payments-api", "payments-worker"}},
+		{"age<2h", []string{"payments-worker"}},
+		{"age>=1w", []string{"payments-api"}},
+		{"worker", []string{"payments-worker"}},
+		{"finance", []string{"billing"}},
+		{"-worker", []string{"payments-worker"}},
+		{"payments-w", []string{"payments-worker"}},
+		{"api OR worker", []string{}},
+		{"sync:OutOfSync -api", []string{"billing"}},
+		{"colour:red", []string{}},
+		{"http://payments", []string{}},
+		{"payments:api health:Degraded", []string{}},
+		{"fin:x OR health:Missing", []string{"billing"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			q, err := ParseAppQuery(tt.expr)
+			if err != nil {
+				t.Fatalf("ParseAppQuery(%q) error: %v", tt.expr, err)
+			}
+			var got []string
+			for _, app := range apps {
+				if q.Matches(app, now) {
+					got = append(got, app.Name)
+				}
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("ParseAppQuery(%q) matched %v, want %v", tt.expr, got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("ParseAppQuery(%q) matched %v, want %v", tt.expr, got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestParseAppQuery_Errors(t *testing.T) {
+	for _, expr := range []string{
+		"age>soon",
+		"age:7d",
+		"health>3",
+		"label:=core",
+		"(health:Degraded",
+		"health:Degraded)",
+		"OR sync:Synced",
+		"sync:Synced OR",
+		"health:Degraded -",
+		`label:owner="platform`,
+	} {
+		t.Run(expr, func(t *testing.T) {
+			if _, err := ParseAppQuery(expr); err == nil {
+				t.Errorf("Expected ParseAppQuery(%q) to fail", expr)
+			}
+		})
+	}
+}
+
+func TestParseQueryDuration(t *testing.T) {
+	tests := []struct {
+		in   string
+		want time.Duration
+	}{
+		{"30m", 30 * time.Minute},
+		{"12h", 12 * time.Hour},
+		{"7d", 7 * 24 * time.Hour},
+		{"2w", 14 * 24 * time.Hour},
+	}
+	for _, tt := range tests {
+		if got, err := ParseQueryDuration(tt.in); err != nil || got != tt.want {
+			t.Errorf("ParseQueryDuration(%q) = %s, %v; want %s", tt.in, got, err, tt.want)
+		}
+	}
+	for _, in := range []string{"", "d", "-1d", "soon"} {
+		if _, err := ParseQueryDuration(in); err == nil {
+			t.Errorf("Expected ParseQueryDuration(%q) to fail", in)
+		}
+	}
+}
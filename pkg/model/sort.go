@@ -1,12 +1,24 @@
 package model
 
+import (
+	"fmt"
+	"strings"
+)
+
 // SortField represents the field to sort applications by
 type SortField string
 
 const (
-	SortFieldName   SortField = "name"
-	SortFieldSync   SortField = "sync"
-	SortFieldHealth SortField = "health"
+	SortFieldName      SortField = "name"
+	SortFieldSync      SortField = "sync"
+	SortFieldHealth    SortField = "health"
+	SortFieldLastSync  SortField = "lastSync"
+	SortFieldProject   SortField = "project"
+	SortFieldCluster   SortField = "cluster"
+	SortFieldNamespace SortField = "namespace"
+	SortFieldAppSet    SortField = "appset"
+	SortFieldRepo      SortField = "repo"
+	SortFieldPhase     SortField = "phase"
 )
 
 // SortDirection represents the sort direction
@@ -17,10 +29,17 @@ const (
 	SortDesc SortDirection = "desc"
 )
 
+// SortKey is one field of a multi-key sort
+type SortKey struct {
+	Field     SortField     `json:"field"`
+	Direction SortDirection `json:"direction"`
+}
+
 // SortConfig holds the complete sort configuration
 type SortConfig struct {
 	Field     SortField     `json:"field"`
 	Direction SortDirection `json:"direction"`
+	Then      []SortKey     `json:"then,omitempty"` // Tie-breakers applied after Field, in order
 }
 
 // DefaultSortConfig returns the default sort configuration
@@ -31,9 +50,53 @@ func DefaultSortConfig() SortConfig {
 	}
 }
 
+// Keys returns the primary sort key followed by the tie-breakers
+func (c SortConfig) Keys() []SortKey {
+	return append([]SortKey{{Field: c.Field, Direction: c.Direction}}, c.Then...)
+}
+
+// String formats the sort like the :sort argument, e.g. "health desc, lastSync asc"
+func (c SortConfig) String() string {
+	keys := c.Keys()
+	parts := make([]string, len(keys))
+	for i, k := range keys {
+		parts[i] = string(k.Field) + " " + string(k.Direction)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// ParseSortSpec parses comma-separated "field direction" pairs such as
+// "health desc, lastSync asc". Field names are case-insensitive.
+func ParseSortSpec(spec string) (SortConfig, error) {
+	var keys []SortKey
+	for _, part := range strings.Split(spec, ",") {
+		words := strings.Fields(part)
+		if len(words) != 2 {
+			return SortConfig{}, fmt.Errorf("expected field and direction in %q", strings.TrimSpace(part))
+		}
+		field, ok := ParseSortField(words[0])
+		if !ok {
+			return SortConfig{}, fmt.Errorf("unknown sort field %q", words[0])
+		}
+		direction := SortDirection(strings.ToLower(words[1]))
+		if !IsValidSortDirection(string(direction)) {
+			return SortConfig{}, fmt.Errorf("invalid direction %q, use asc or desc", words[1])
+		}
+		keys = append(keys, SortKey{Field: field, Direction: direction})
+	}
+	config := SortConfig{Field: keys[0].Field, Direction: keys[0].Direction}
+	if len(keys) > 1 {
+		config.Then = keys[1:]
+	}
+	return config, nil
+}
+
 // ValidSortFields returns all valid sort field values
 func ValidSortFields() []SortField {
-	return []SortField{SortFieldName, SortFieldSync, SortFieldHealth}
+	return []SortField{
+		SortFieldName, SortFieldSync, SortFieldHealth, SortFieldLastSync, SortFieldProject,
+		SortFieldCluster, SortFieldNamespace, SortFieldAppSet, SortFieldRepo, SortFieldPhase,
+	}
 }
 
 // ValidSortDirections returns all valid sort direction values
@@ -41,14 +104,20 @@ func ValidSortDirections() []SortDirection {
 	return []SortDirection{SortAsc, SortDesc}
 }
 
-// IsValidSortField checks if a string is a valid sort field
-func IsValidSortField(s string) bool {
+// ParseSortField resolves a sort field name case-insensitively
+func ParseSortField(s string) (SortField, bool) {
 	for _, f := range ValidSortFields() {
-		if string(f) == s {
-			return true
+		if strings.EqualFold(string(f), s) {
+			return f, true
 		}
 	}
-	return false
+	return "", false
+}
+
+// IsValidSortField checks if a string is a valid sort field
+func IsValidSortField(s string) bool {
+	_, ok := ParseSortField(s)
+	return ok
 }
 
 // IsValidSortDirection checks if a string is a valid sort direction
@@ -0,0 +1,29 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseSortSpec(t *testing.T) {
+	got, err := ParseSortSpec("Health DESC, lastsync asc,project asc")
+	if err != nil {
+		t.Fatalf("ParseSortSpec: %v", err)
+	}
+	want := SortConfig{Field: SortFieldHealth, Direction: SortDesc, Then: []SortKey{
+		{Field: SortFieldLastSync, Direction: SortAsc},
+		{Field: SortFieldProject, Direction: SortAsc},
+	}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseSortSpec = %+v, want %+v", got, want)
+	}
+	if s := got.String(); s != "health desc, lastSync asc, project asc" {
+		t.Errorf("String() = %q", s)
+	}
+
+	for _, spec := range []string{"", "name", "name up", "color asc", "name asc,", "name asc, sync"} {
+		if _, err := ParseSortSpec(spec); err == nil {
+			t.Errorf("ParseSortSpec(%q) should fail", spec)
+		}
+	}
+}
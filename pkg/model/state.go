@@ -21,6 +21,9 @@ type SelectionState struct {
 	ScopeNamespaces      map[string]bool `json:"scopeNamespaces"`
 	ScopeProjects        map[string]bool `json:"scopeProjects"`
 	ScopeApplicationSets map[string]bool `json:"scopeApplicationSets"`
+	ScopeRepos           map[string]bool `json:"scopeRepos"`
+	ScopeLabels          map[string]bool `json:"scopeLabels"`        // key=value selectors
+	LabelKey             string          `json:"labelKey,omitempty"` // Label key whose values the labels view lists
 	SelectedApps         map[string]bool `json:"selectedApps"`
 }
 
@@ -31,6 +34,8 @@ func NewSelectionState() *SelectionState {
 		ScopeNamespaces:      NewStringSet(),
 		ScopeProjects:        NewStringSet(),
 		ScopeApplicationSets: NewStringSet(),
+		ScopeRepos:           NewStringSet(),
+		ScopeLabels:          NewStringSet(),
 		SelectedApps:         NewStringSet(),
 	}
 }
@@ -77,6 +82,26 @@ func (s *SelectionState) HasApplicationSet(appset string) bool {
 	return HasInStringSet(s.ScopeApplicationSets, appset)
 }
 
+// AddRepo adds a repository URL to the scope
+func (s *SelectionState) AddRepo(repoURL string) {
+	s.ScopeRepos = AddToStringSet(s.ScopeRepos, repoURL)
+}
+
+// HasRepo checks if a repository URL is in scope
+func (s *SelectionState) HasRepo(repoURL string) bool {
+	return HasInStringSet(s.ScopeRepos, repoURL)
+}
+
+// AddLabel adds a key=value label selector to the scope
+func (s *SelectionState) AddLabel(selector string) {
+	s.ScopeLabels = AddToStringSet(s.ScopeLabels, selector)
+}
+
+// HasLabel checks if a key=value label selector is in scope
+func (s *SelectionState) HasLabel(selector string) bool {
+	return HasInStringSet(s.ScopeLabels, selector)
+}
+
 // AddSelectedApp adds an app to the selected apps
 func (s *SelectionState) AddSelectedApp(app string) {
 	s.SelectedApps = AddToStringSet(s.SelectedApps, app)
@@ -111,11 +136,13 @@ type UIState struct {
 	ThemeOriginalName   string          `json:"themeOriginalName,omitempty"`
 	CommandInvalid      bool            `json:"commandInvalid"`
 	Sort                SortConfig      `json:"sort"`
+	Columns             []string        `json:"columns,omitempty"` // Optional apps list columns (see AppColumns)
 	ShowWhatsNew        bool            `json:"showWhatsNew"`
 	WhatsNewShownAt     *time.Time      `json:"whatsNewShownAt,omitempty"`
 	RefreshFlashApps    map[string]bool `json:"-"` // Apps to highlight after refresh (transient)
 	RefreshFlashTree    bool            `json:"-"` // Flash tree view after refresh (transient)
 	SelectionCopied     bool            `json:"-"` // Show "Copied!" message briefly (transient)
+	AppQuery            *ParsedAppQuery `json:"-"` // Last parsed apps search or filter (transient)
 }
 
 // ModalState holds modal-related state
@@ -127,6 +154,10 @@ type ModalState struct {
 	ConfirmSyncSelected int `json:"confirmSyncSelected"`
 	// When true, show a small syncing overlay instead of the confirm UI
 	ConfirmSyncLoading bool `json:"confirmSyncLoading"`
+	// Additional sync options (revision, server-side apply, retry, ...) for the pending sync
+	ConfirmSyncOptions SyncOptions `json:"confirmSyncOptions"`
+	// When true, keystrokes in the sync modal edit the revision field
+	ConfirmSyncEditingRevision bool `json:"confirmSyncEditingRevision"`
 	// When true, show initial loading modal overlay during app startup
 	InitialLoading  bool    `json:"initialLoading"`
 	RollbackAppName *string `json:"rollbackAppName,omitempty"`
@@ -161,6 +192,26 @@ type ModalState struct {
 	ResourceSyncError           *string              `json:"resourceSyncError,omitempty"`
 	ResourceSyncPrune           bool                 `json:"resourceSyncPrune"` // Prune option
 	ResourceSyncForce           bool                 `json:"resourceSyncForce"` // Force option
+	// Resource actions modal state
+	ResourceActionTarget          *ResourceActionTarget `json:"resourceActionTarget,omitempty"`
+	ResourceActions               []string              `json:"resourceActions,omitempty"`
+	ResourceActionsLoading        bool                  `json:"resourceActionsLoading"`
+	ResourceActionSelected        int                   `json:"resourceActionSelected"`
+	ResourceActionConfirm         bool                  `json:"resourceActionConfirm"`         // Confirmation step for the chosen action
+	ResourceActionConfirmSelected int                   `json:"resourceActionConfirmSelected"` // 0 = Run, 1 = Cancel
+	ResourceActionRunning         bool                  `json:"resourceActionRunning"`
+	ResourceActionError           *string               `json:"resourceActionError,omitempty"`
+	// Terminate operation confirmation modal state
+	TerminateApps     []string `json:"terminateApps,omitempty"` // Apps whose running operation will be terminated
+	TerminateSelected int      `json:"terminateSelected"`       // 0 = Yes, 1 = Cancel
+	TerminateLoading  bool     `json:"terminateLoading"`
+	TerminateError    *string  `json:"terminateError,omitempty"`
+	// ApplicationSet delete confirmation modal state
+	AppSetDeleteName      string  `json:"appSetDeleteName,omitempty"`
+	AppSetDeleteNamespace string  `json:"appSetDeleteNamespace,omitempty"`
+	AppSetDeleteSelected  int     `json:"appSetDeleteSelected"` // 0 = Delete, 1 = Cancel
+	AppSetDeleteLoading   bool    `json:"appSetDeleteLoading"`
+	AppSetDeleteError     *string `json:"appSetDeleteError,omitempty"`
 	// Changelog loading modal state
 	ChangelogLoading bool `json:"changelogLoading"`
 	// K9s error modal state
@@ -185,6 +236,36 @@ type AppState struct {
 	// Note: AbortController equivalent will use context.Context in Go services
 	Diff     *DiffState     `json:"diff,omitempty"`
 	Rollback *RollbackState `json:"rollback,omitempty"`
+	Logs     *LogsState     `json:"logs,omitempty"`
+	Events   *EventsState   `json:"events,omitempty"`
+	Manifest *ManifestState `json:"manifest,omitempty"`
+	// Resource edited in $EDITOR and waiting for confirmation
+	ResourceEdit *ResourceEditState `json:"resourceEdit,omitempty"`
+	// Sync operation progress for a single app
+	SyncProgress *SyncProgressState `json:"syncProgress,omitempty"`
+	// ApplicationSet objects from the API; nil until the ApplicationSets view is first opened
+	ApplicationSets *ApplicationSetsState `json:"applicationSets,omitempty"`
+	// Repositories registered in ArgoCD; nil until the repositories view is first opened
+	Repositories *RepositoriesState `json:"repositories,omitempty"`
+	// Clusters with their connection states keyed by label, refreshed in the background; nil until first loaded
+	Clusters map[string]Cluster `json:"clusters,omitempty"`
+	// Projects with their sync windows, refreshed in the background; nil until first loaded
+	Projects map[string]Project `json:"projects,omitempty"`
+	// Sync window state of the blocked apps and those whose windows are unknown, by app name;
+	// evaluated whenever projects are refreshed
+	SyncBlocks map[string]SyncBlock `json:"syncBlocks,omitempty"`
+	// Project shown in the project detail view
+	ProjectDetail *ProjectDetailState `json:"projectDetail,omitempty"`
+	// Application shown in the app detail view
+	AppDetail *AppDetailState `json:"appDetail,omitempty"`
+	// Changed resources of an app diff, browsed one resource at a time
+	ResourceDiffs *ResourceDiffsState `json:"resourceDiffs,omitempty"`
+	// Results of can-i checks for app operations per project; nil until first loaded
+	Permissions Permissions `json:"permissions,omitempty"`
+	// Auth token status of each ArgoCD context, shown in the contexts view
+	ContextTokens map[string]TokenStatus `json:"contextTokens,omitempty"`
+	// Login form shown in the auth-required view; nil until the view is first shown
+	Login *LoginState `json:"login,omitempty"`
 	// Store previous navigation state for restoration
 	SavedNavigation *NavigationState `json:"savedNavigation,omitempty"`
 	SavedSelections *SelectionState  `json:"savedSelections,omitempty"`
@@ -220,6 +301,173 @@ type DiffState struct {
 	Offset      int      `json:"offset"`
 	SearchQuery string   `json:"searchQuery"`
 	Loading     bool     `json:"loading"`
+	Hunks       []int    `json:"hunks"`    // Content indexes of hunk headers (built-in engine)
+	Sections    []int    `json:"sections"` // Content indexes of resource headers (built-in engine)
+}
+
+// ResourceDiffsState holds state for the per-resource diff navigator
+type ResourceDiffsState struct {
+	AppName     string             `json:"appName"`
+	Items       []ResourceDiffItem `json:"items"`
+	SelectedIdx int                `json:"selectedIdx"`
+}
+
+// ResourceDiffItem is one changed resource of an app diff
+type ResourceDiffItem struct {
+	Group     string `json:"group"`
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Added     int    `json:"added"`
+	Removed   int    `json:"removed"`
+	Live      string `json:"-"`      // Normalised live state YAML
+	Desired   string `json:"-"`      // Normalised predicted state YAML
+	Synced    bool   `json:"synced"` // A resource sync was started from the navigator
+}
+
+// EventsState holds state for the Kubernetes events view
+type EventsState struct {
+	AppName           string     `json:"appName"`
+	AppNamespace      *string    `json:"appNamespace,omitempty"`
+	Resource          string     `json:"resource"` // Kind/name when scoped to one resource; empty for the whole app
+	ResourceName      string     `json:"resourceName"`
+	ResourceNamespace string     `json:"resourceNamespace"`
+	ResourceUID       string     `json:"resourceUID"`
+	Events            []EventRow `json:"events"` // Sorted newest first
+	Offset            int        `json:"offset"`
+	Loading           bool       `json:"loading"`
+	Error             string     `json:"error"`
+	UpdatedAt         *time.Time `json:"updatedAt,omitempty"`
+	Session           int        `json:"session"` // Guards refresh ticks and loads from previous views
+}
+
+// ManifestState holds state for the live manifest viewer
+type ManifestState struct {
+	Target      ResourceActionTarget `json:"target"`
+	Live        string               `json:"live"`    // Live object as returned by the API (JSON)
+	Desired     string               `json:"desired"` // Target manifest from managed-resources; empty if not managed
+	ShowDesired bool                 `json:"showDesired"`
+	Neat        bool                 `json:"neat"`    // Show the kubectl-neat cleaned form instead of the raw object
+	Content     []string             `json:"content"` // Rendered YAML for the current toggles
+	Offset      int                  `json:"offset"`
+	Loading     bool                 `json:"loading"`
+	Error       string               `json:"error"`
+	Session     int                  `json:"session"` // Guards loads from previous viewers
+}
+
+// ResourceEditState holds a resource edited in $EDITOR while its patch is reviewed
+type ResourceEditState struct {
+	Target   ResourceActionTarget `json:"target"`
+	Original string               `json:"original"` // YAML opened in the editor
+	Edited   string               `json:"edited"`   // YAML saved from the editor
+	Patch    string               `json:"patch"`    // Merge patch to apply; empty if the edit is invalid
+	Diff     []string             `json:"diff"`     // Preview of the changes
+	Offset   int                  `json:"offset"`
+	Applying bool                 `json:"applying"`
+	Error    string               `json:"error"` // Validation or API error shown inline
+	Session  int                  `json:"session"`
+}
+
+// SyncProgressState holds state for the sync operation progress view
+type SyncProgressState struct {
+	AppName      string         `json:"appName"`
+	AppNamespace *string        `json:"appNamespace,omitempty"`
+	Operation    *SyncOperation `json:"operation,omitempty"` // Nil until the first load or watch update
+	// IgnoreBefore drops operations that started at or before this time (earlier syncs)
+	IgnoreBefore *time.Time     `json:"ignoreBefore,omitempty"`
+	Offset       int            `json:"offset"`
+	Loading      bool           `json:"loading"`
+	Error        string         `json:"error"`
+	Session      int            `json:"session"` // Guards loads and clock ticks from previous views
+}
+
+// Accepts reports whether op belongs to the operation followed by the view
+func (s *SyncProgressState) Accepts(op *SyncOperation) bool {
+	if op == nil {
+		return false
+	}
+	return s.IgnoreBefore == nil || op.StartedAt.After(*s.IgnoreBefore)
+}
+
+// ApplicationSetsState holds the ApplicationSets fetched for the ApplicationSets view
+type ApplicationSetsState struct {
+	Items    map[string]ApplicationSet `json:"items"` // Keyed by name
+	Loading  bool                      `json:"loading"`
+	Error    string                    `json:"error"`
+	Watching bool                      `json:"watching"` // Live updates from the watch stream
+	Polling  bool                      `json:"polling"`  // Periodic re-lists while the watch is unavailable
+	Session  int                       `json:"session"`
+}
+
+// RepositoriesState holds the repositories fetched for the repositories view
+type RepositoriesState struct {
+	Items      map[string]Repository `json:"items"`      // Keyed by NormalizeRepoURL
+	Refreshing map[string]bool       `json:"refreshing"` // Repositories whose connection is being re-checked
+	Loading    bool                  `json:"loading"`
+	Error      string                `json:"error"`
+	Session    int                   `json:"session"`
+}
+
+// ProjectDetailState holds state for the project detail view
+type ProjectDetailState struct {
+	Name    string   `json:"name"`
+	Project *Project `json:"project,omitempty"` // Nil until loaded
+	Offset  int      `json:"offset"`
+	Loading bool     `json:"loading"`
+	Error   string   `json:"error"`
+	Session int      `json:"session"` // Guards loads from previous views
+}
+
+// AppDetailState holds state for the app detail view
+type AppDetailState struct {
+	AppName      string     `json:"appName"`
+	AppNamespace *string    `json:"appNamespace,omitempty"`
+	Detail       *AppDetail `json:"detail,omitempty"` // Nil until loaded
+	Offset       int        `json:"offset"`
+	Loading      bool       `json:"loading"`
+	Error        string     `json:"error"`
+	Session      int        `json:"session"` // Guards loads from previous views
+}
+
+// LoginField identifies the focused field of the login form
+type LoginField int
+
+const (
+	LoginFieldUsername LoginField = iota
+	LoginFieldPassword
+)
+
+// LoginState holds state for the username/password login form
+type LoginState struct {
+	Server     *Server    `json:"-"` // Server to log in to; nil when the context has none
+	Username   string     `json:"username"`
+	Password   string     `json:"-"`
+	Field      LoginField `json:"field"`
+	Submitting bool       `json:"submitting"`
+	Error      string     `json:"error"`
+}
+
+// LogsState holds state for the pod logs pane
+type LogsState struct {
+	AppName      string   `json:"appName"`
+	AppNamespace *string  `json:"appNamespace,omitempty"`
+	Resource     string   `json:"resource"`     // Kind/name of the tree node the pane was opened for
+	Namespace    string   `json:"namespace"`    // Namespace of the pods
+	Pods         []string `json:"pods"`         // Candidate pods (more than one for workloads)
+	Pod          string   `json:"pod"`          // Pod currently streaming
+	Containers   []string `json:"containers"`   // Containers of the current pod
+	Container    string   `json:"container"`    // Container currently streaming
+	SinceSeconds int64    `json:"sinceSeconds"` // 0 = no limit
+	TailLines    int64    `json:"tailLines"`    // 0 = all lines
+	Follow       bool     `json:"follow"`       // Keep the view pinned to the newest line
+	Lines        []string `json:"lines"`
+	Offset       int      `json:"offset"`
+	SearchQuery  string   `json:"searchQuery"`
+	Streaming    bool     `json:"streaming"`
+	Error        string   `json:"error"`
+	Picker       string   `json:"picker"`    // "", "container" or "pod"
+	PickerIdx    int      `json:"pickerIdx"` // Cursor inside the open picker
+	Session      int      `json:"session"`   // Incremented on every stream restart
 }
 
 // SaveNavigationState saves current navigation and selection state
@@ -236,6 +484,9 @@ func (s *AppState) SaveNavigationState() {
 		ScopeNamespaces:      copyStringSet(s.Selections.ScopeNamespaces),
 		ScopeProjects:        copyStringSet(s.Selections.ScopeProjects),
 		ScopeApplicationSets: copyStringSet(s.Selections.ScopeApplicationSets),
+		ScopeRepos:           copyStringSet(s.Selections.ScopeRepos),
+		ScopeLabels:          copyStringSet(s.Selections.ScopeLabels),
+		LabelKey:             s.Selections.LabelKey,
 		SelectedApps:         copyStringSet(s.Selections.SelectedApps),
 	}
 }
@@ -0,0 +1,140 @@
+package model
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SyncOptions holds the optional sync settings chosen in the sync confirmation
+// modal or passed as :sync arguments. Prune is tracked separately in ModalState.
+type SyncOptions struct {
+	Revision                 string `json:"revision,omitempty"`
+	ApplyOutOfSyncOnly       bool   `json:"applyOutOfSyncOnly"`
+	ServerSideApply          bool   `json:"serverSideApply"`
+	Replace                  bool   `json:"replace"`
+	PruneLast                bool   `json:"pruneLast"`
+	RespectIgnoreDifferences bool   `json:"respectIgnoreDifferences"`
+	CreateNamespace          bool   `json:"createNamespace"`
+	// RetryLimit enables a retry strategy when non-zero (negative means unlimited)
+	RetryLimit int `json:"retryLimit"`
+	// Backoff settings only apply when RetryLimit is set; empty/zero uses ArgoCD defaults
+	RetryBackoffDuration    string `json:"retryBackoffDuration,omitempty"`
+	RetryBackoffFactor      int    `json:"retryBackoffFactor,omitempty"`
+	RetryBackoffMaxDuration string `json:"retryBackoffMaxDuration,omitempty"`
+}
+
+// SyncArgs is the parsed form of :sync arguments
+type SyncArgs struct {
+	App     string
+	Prune   bool
+	Options SyncOptions
+}
+
+// SyncFlag describes a flag accepted by the :sync command
+type SyncFlag struct {
+	Name       string // including the leading "--"
+	TakesValue bool
+}
+
+// SyncFlags lists the flags accepted by :sync. Names follow `argocd app sync` where it has an equivalent.
+var SyncFlags = []SyncFlag{
+	{Name: "--revision", TakesValue: true},
+	{Name: "--prune"},
+	{Name: "--apply-out-of-sync-only"},
+	{Name: "--server-side"},
+	{Name: "--replace"},
+	{Name: "--prune-last"},
+	{Name: "--respect-ignore-differences"},
+	{Name: "--create-namespace"},
+	{Name: "--retry-limit", TakesValue: true},
+	{Name: "--retry-backoff-duration", TakesValue: true},
+	{Name: "--retry-backoff-factor", TakesValue: true},
+	{Name: "--retry-backoff-max-duration", TakesValue: true},
+}
+
+// ParseSyncArgs parses :sync arguments: an optional app name followed by flags.
+// Flag values may be given as --flag=value or --flag value.
+func ParseSyncArgs(args []string) (SyncArgs, error) {
+	var out SyncArgs
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if !strings.HasPrefix(arg, "-") {
+			if out.App != "" {
+				return out, fmt.Errorf("unexpected argument: %s", arg)
+			}
+			out.App = arg
+			continue
+		}
+
+		name, value, hasValue := strings.Cut(arg, "=")
+		flag, ok := lookupSyncFlag(name)
+		if !ok {
+			return out, fmt.Errorf("unknown sync flag: %s", name)
+		}
+		if flag.TakesValue && !hasValue {
+			if i+1 >= len(args) || strings.HasPrefix(args[i+1], "-") {
+				return out, fmt.Errorf("%s requires a value", name)
+			}
+			i++
+			value = args[i]
+		} else if !flag.TakesValue && hasValue {
+			return out, fmt.Errorf("%s does not take a value", name)
+		}
+		if flag.TakesValue && value == "" {
+			return out, fmt.Errorf("%s requires a value", name)
+		}
+
+		switch name {
+		case "--revision":
+			out.Options.Revision = value
+		case "--prune":
+			out.Prune = true
+		case "--apply-out-of-sync-only":
+			out.Options.ApplyOutOfSyncOnly = true
+		case "--server-side":
+			out.Options.ServerSideApply = true
+		case "--replace":
+			out.Options.Replace = true
+		case "--prune-last":
+			out.Options.PruneLast = true
+		case "--respect-ignore-differences":
+			out.Options.RespectIgnoreDifferences = true
+		case "--create-namespace":
+			out.Options.CreateNamespace = true
+		case "--retry-limit":
+			n, err := strconv.Atoi(value)
+			if err != nil {
+				return out, fmt.Errorf("invalid retry limit: %s", value)
+			}
+			out.Options.RetryLimit = n
+		case "--retry-backoff-duration":
+			if _, err := time.ParseDuration(value); err != nil {
+				return out, fmt.Errorf("invalid retry backoff duration: %s", value)
+			}
+			out.Options.RetryBackoffDuration = value
+		case "--retry-backoff-factor":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 {
+				return out, fmt.Errorf("invalid retry backoff factor: %s", value)
+			}
+			out.Options.RetryBackoffFactor = n
+		case "--retry-backoff-max-duration":
+			if _, err := time.ParseDuration(value); err != nil {
+				return out, fmt.Errorf("invalid retry backoff max duration: %s", value)
+			}
+			out.Options.RetryBackoffMaxDuration = value
+		}
+	}
+	return out, nil
+}
+
+func lookupSyncFlag(name string) (SyncFlag, bool) {
+	for _, f := range SyncFlags {
+		if f.Name == name {
+			return f, true
+		}
+	}
+	return SyncFlag{}, false
+}
@@ -0,0 +1,71 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseSyncArgs(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want SyncArgs
+	}{
+		{name: "empty", args: nil, want: SyncArgs{}},
+		{name: "app only", args: []string{"web"}, want: SyncArgs{App: "web"}},
+		{
+			name: "boolean flags",
+			args: []string{"--server-side", "--apply-out-of-sync-only", "--replace", "--prune", "--prune-last", "--respect-ignore-differences", "--create-namespace"},
+			want: SyncArgs{Prune: true, Options: SyncOptions{
+				ServerSideApply:          true,
+				ApplyOutOfSyncOnly:       true,
+				Replace:                  true,
+				PruneLast:                true,
+				RespectIgnoreDifferences: true,
+				CreateNamespace:          true,
+			}},
+		},
+		{
+			name: "values with equals and space",
+			args: []string{"web", "--revision=v1.2.0", "--retry-limit", "3", "--retry-backoff-duration=10s", "--retry-backoff-factor=3", "--retry-backoff-max-duration", "5m"},
+			want: SyncArgs{App: "web", Options: SyncOptions{
+				Revision:                "v1.2.0",
+				RetryLimit:              3,
+				RetryBackoffDuration:    "10s",
+				RetryBackoffFactor:      3,
+				RetryBackoffMaxDuration: "5m",
+			}},
+		},
+		{name: "app after flags", args: []string{"--server-side", "web"}, want: SyncArgs{App: "web", Options: SyncOptions{ServerSideApply: true}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseSyncArgs(tt.args)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseSyncArgs(%v) = %+v, want %+v", tt.args, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseSyncArgs_Errors(t *testing.T) {
+	tests := [][]string{
+		{"--bogus"},
+		{"web", "other"},
+		{"--revision"},
+		{"--revision", "--prune"},
+		{"--revision="},
+		{"--prune=true"},
+		{"--retry-limit=abc"},
+		{"--retry-backoff-duration=soon"},
+		{"--retry-backoff-factor=0"},
+	}
+	for _, args := range tests {
+		if _, err := ParseSyncArgs(args); err == nil {
+			t.Errorf("ParseSyncArgs(%v) expected error", args)
+		}
+	}
+}
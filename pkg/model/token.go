@@ -0,0 +1,53 @@
+package model
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// TokenExpiry returns the expiry of a JWT from its exp claim. The signature is not verified;
+// the expiry is only used to warn before the server starts rejecting the token.
+func TokenExpiry(token string) (time.Time, bool) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}, false
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return time.Time{}, false
+	}
+	var claims struct {
+		Exp float64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Exp <= 0 {
+		return time.Time{}, false
+	}
+	return time.Unix(int64(claims.Exp), 0), true
+}
+
+// TokenStatus describes the auth token stored for an ArgoCD context
+type TokenStatus struct {
+	HasToken  bool
+	ExpiresAt time.Time // Zero when the token has no expiry or is not a JWT
+	Exec      bool      // Tokens come from an [auth.exec] credential command instead
+}
+
+// TokenStatusOf returns the status of a token
+func TokenStatusOf(token string) TokenStatus {
+	status := TokenStatus{HasToken: token != ""}
+	if exp, ok := TokenExpiry(token); ok {
+		status.ExpiresAt = exp
+	}
+	return status
+}
+
+// Expired reports whether the token is missing or past its expiry. Contexts with a credential
+// command never are, since the command is asked for a new token.
+func (s TokenStatus) Expired(now time.Time) bool {
+	if s.Exec {
+		return false
+	}
+	return !s.HasToken || (!s.ExpiresAt.IsZero() && !now.Before(s.ExpiresAt))
+}
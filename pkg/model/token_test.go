@@ -0,0 +1,60 @@
+package model
+
+import (
+	"encoding/base64"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func testJWT(exp time.Time) string {
+	payload := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"sub":"admin","exp":%d}`, exp.Unix())))
+	return "eyJhbGciOiJIUzI1NiJ9." + payload + ".signature"
+}
+
+func TestTokenExpiry(t *testing.T) {
+	exp := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	tests := []struct {
+		name   string
+		token  string
+		wantOK bool
+	}{
+		{"jwt", testJWT(exp), true},
+		{"opaque token", "opaque-token", false},
+		{"bad payload", "a.!!!.c", false},
+		{"no exp claim", "a." + base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"admin"}`)) + ".c", false},
+		{"empty", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := TokenExpiry(tt.token)
+			if ok != tt.wantOK {
+				t.Fatalf("TokenExpiry() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if ok && !got.Equal(exp) {
+				t.Errorf("TokenExpiry() = %s, want %s", got, exp)
+			}
+		})
+	}
+}
+
+func TestTokenStatusExpired(t *testing.T) {
+	now := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		status TokenStatus
+		want   bool
+	}{
+		{"no token", TokenStatusOf(""), true},
+		{"no expiry", TokenStatusOf("opaque-token"), false},
+		{"valid", TokenStatusOf(testJWT(now.Add(time.Hour))), false},
+		{"expired", TokenStatusOf(testJWT(now.Add(-time.Hour))), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.status.Expired(now); got != tt.want {
+				t.Errorf("Expired() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package model
 
 import (
+	"strings"
 	"time"
 )
 
@@ -14,6 +15,8 @@ const (
 	ViewApps            View = "apps"
 	ViewTree            View = "tree"
 	ViewApplicationSets View = "applicationsets"
+	ViewRepositories    View = "repositories"
+	ViewLabels          View = "labels"
 	ViewContexts        View = "contexts"
 )
 
@@ -46,30 +49,134 @@ const (
 	ModeK9sError              Mode = "k9s-error"
 	ModeConfirmResourceSync   Mode = "confirm-resource-sync"
 	ModeDefaultViewWarning    Mode = "default-view-warning"
+	ModeLogs                  Mode = "logs"
+	ModeResourceActions       Mode = "resource-actions"
+	ModeEvents                Mode = "events"
+	ModeManifest              Mode = "manifest"
+	ModeResourceEdit          Mode = "resource-edit"
+	ModeConfirmTerminate      Mode = "confirm-terminate"
+	ModeSyncProgress          Mode = "sync-progress"
+	ModeConfirmAppSetDelete   Mode = "confirm-appset-delete"
+	ModeProjectDetail         Mode = "project-detail"
+	ModeAppDetail             Mode = "app-detail"
+	ModeResourceDiffs         Mode = "resource-diffs"
 )
 
 // App represents an ArgoCD application
 type App struct {
-	Name           string     `json:"name"`
-	Sync           string     `json:"sync"`
-	Health         string     `json:"health"`
-	LastSyncAt     *time.Time `json:"lastSyncAt,omitempty"`
-	Project        *string    `json:"project,omitempty"`
-	ClusterID      *string    `json:"clusterId,omitempty"`
-	ClusterLabel   *string    `json:"clusterLabel,omitempty"`
-	Namespace      *string    `json:"namespace,omitempty"`
-	AppNamespace   *string    `json:"appNamespace,omitempty"`
-	ApplicationSet *string    `json:"applicationSet,omitempty"`
+	Name           string            `json:"name"`
+	Sync           string            `json:"sync"`
+	Health         string            `json:"health"`
+	LastSyncAt     *time.Time        `json:"lastSyncAt,omitempty"`
+	Project        *string           `json:"project,omitempty"`
+	ClusterID      *string           `json:"clusterId,omitempty"`
+	ClusterLabel   *string           `json:"clusterLabel,omitempty"`
+	Namespace      *string           `json:"namespace,omitempty"`
+	AppNamespace   *string           `json:"appNamespace,omitempty"`
+	ApplicationSet *string           `json:"applicationSet,omitempty"`
+	OperationPhase string            `json:"operationPhase,omitempty"` // Phase of the current or last operation (e.g. Running, Succeeded)
+	RepoURLs       []string          `json:"repoURLs,omitempty"`       // Source repositories (spec.source or spec.sources)
+	SourcePath     string            `json:"sourcePath,omitempty"`     // Path of the (first) source
+	TargetRevision string            `json:"targetRevision,omitempty"` // Target revision of the (first) source
+	SyncedRevision string            `json:"syncedRevision,omitempty"` // Revision the app was last compared/synced at
+	AutoSync       *AutoSync         `json:"autoSync,omitempty"`       // Automated sync policy; nil when disabled
+	Labels         map[string]string `json:"labels,omitempty"`
+	Annotations    map[string]string `json:"annotations,omitempty"`
+
+	// spec.destination as set on the app; sync windows match clusters against these like ArgoCD
+	DestinationServer string `json:"destinationServer,omitempty"`
+	DestinationName   string `json:"destinationName,omitempty"`
+}
+
+// LabelSelector returns the key=value form used to scope apps by a label
+func LabelSelector(key, value string) string {
+	return key + "=" + value
+}
+
+// HasRunningOperation reports whether the app has an operation (e.g. a sync) in progress
+func (a App) HasRunningOperation() bool {
+	return a.OperationPhase == "Running" || a.OperationPhase == "Terminating"
+}
+
+// NormalizeRepoURL returns the form used to compare repository URLs: lower case, without
+// a trailing slash or ".git" suffix, so an app's repoURL matches its registered repository
+func NormalizeRepoURL(repoURL string) string {
+	u := strings.ToLower(strings.TrimSpace(repoURL))
+	u = strings.TrimSuffix(u, "/")
+	return strings.TrimSuffix(u, ".git")
+}
+
+// Repository represents a repository registered in ArgoCD
+type Repository struct {
+	URL               string     `json:"url"`
+	Type              string     `json:"type"` // git, helm or oci
+	Name              string     `json:"name,omitempty"`
+	Project           string     `json:"project,omitempty"` // Set for project-scoped repositories
+	ConnectionStatus  string     `json:"connectionStatus"`  // Successful, Failed or Unknown
+	ConnectionMessage string     `json:"connectionMessage,omitempty"`
+	AttemptedAt       *time.Time `json:"attemptedAt,omitempty"`
+}
+
+// ApplicationSet represents an ArgoCD ApplicationSet
+type ApplicationSet struct {
+	Name                        string                    `json:"name"`
+	Namespace                   string                    `json:"namespace,omitempty"`
+	Generators                  []string                  `json:"generators,omitempty"` // Summaries such as "list(3)" or "matrix(git(dirs)+clusters)"
+	SyncPolicy                  string                    `json:"syncPolicy"`           // applicationsSync policy; "sync" when unset
+	PreserveResourcesOnDeletion bool                      `json:"preserveResourcesOnDeletion,omitempty"`
+	Strategy                    string                    `json:"strategy,omitempty"` // Progressive sync strategy, empty for all at once
+	Conditions                  []ApplicationSetCondition `json:"conditions,omitempty"`
+	Resources                   []ApplicationSetResource  `json:"resources,omitempty"` // Generated applications from status.resources
+}
+
+// ApplicationSetCondition is a status condition of an ApplicationSet
+type ApplicationSetCondition struct {
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// ApplicationSetResource is an application generated by an ApplicationSet
+type ApplicationSetResource struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+	Sync      string `json:"sync,omitempty"`
+	Health    string `json:"health,omitempty"`
+}
+
+// Condition returns the condition of the given type, or nil if the controller has not reported it
+func (a ApplicationSet) Condition(condType string) *ApplicationSetCondition {
+	for i := range a.Conditions {
+		if a.Conditions[i].Type == condType {
+			return &a.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// HasError reports whether the controller failed to generate or update the set's applications
+func (a ApplicationSet) HasError() bool {
+	c := a.Condition("ErrorOccurred")
+	return c != nil && c.Status == "True"
 }
 
 // Server represents an ArgoCD server configuration
 type Server struct {
-	BaseURL         string `json:"baseUrl"`
-	Token           string `json:"token"`
-	Username        string `json:"username,omitempty"`
-	Password        string `json:"password,omitempty"`
-	Insecure        bool   `json:"insecure,omitempty"`
-	GrpcWebRootPath string `json:"grpcWebRootPath,omitempty"`
+	BaseURL         string    `json:"baseUrl"`
+	Token           string    `json:"token"`
+	Username        string    `json:"username,omitempty"`
+	Password        string    `json:"password,omitempty"`
+	Insecure        bool      `json:"insecure,omitempty"`
+	GrpcWebRootPath string    `json:"grpcWebRootPath,omitempty"`
+	ExecAuth        *ExecAuth `json:"-"` // Get tokens from a command instead of using Token
+}
+
+// ExecAuth configures a command that prints a token for the server
+type ExecAuth struct {
+	Command string            `json:"command"`
+	Args    []string          `json:"args,omitempty"`
+	Env     map[string]string `json:"env,omitempty"`
 }
 
 // TerminalState represents terminal dimensions
@@ -194,6 +301,9 @@ type RollbackState struct {
 	Watch           bool          `json:"watch"`           // Watch option after rollback
 	DryRun          bool          `json:"dryRun"`          // Dry run option (not shown in confirm view)
 	ConfirmSelected int           `json:"confirmSelected"` // 0 = Yes, 1 = No/Cancel
+	AutoSync        *AutoSync     `json:"autoSync"`        // Automated sync policy when loaded; nil when disabled
+	DisableAutoSync bool          `json:"disableAutoSync"` // Disable automated sync before rolling back
+	Marked          []int         `json:"marked"`          // Deployment IDs marked for comparison (at most two)
 }
 
 // RevisionMetadata represents git commit metadata for a revision
@@ -249,6 +359,77 @@ type ResourceDeleteTarget struct {
 	Version   string `json:"version"`
 }
 
+// EventRow is a Kubernetes event prepared for display in the events view
+type EventRow struct {
+	Type     string    `json:"type"`   // Normal or Warning
+	Reason   string    `json:"reason"` // e.g. FailedScheduling, BackOff
+	Message  string    `json:"message"`
+	Object   string    `json:"object"` // Kind/name of the involved object
+	Count    int       `json:"count"`
+	LastSeen time.Time `json:"lastSeen"`
+}
+
+// SyncOperation is an application's current or most recent sync operation as
+// reported by status.operationState
+type SyncOperation struct {
+	Phase      string               `json:"phase"` // Running, Terminating, Succeeded, Failed, Error
+	Message    string               `json:"message"`
+	Revision   string               `json:"revision"`
+	StartedAt  time.Time            `json:"startedAt"`
+	FinishedAt time.Time            `json:"finishedAt"` // Zero while the operation is running
+	Resources  []SyncResourceResult `json:"resources"`  // In the order ArgoCD applied them
+	// InitiatedBy is the user who started the operation; empty with Automated set when
+	// the controller started it
+	InitiatedBy string `json:"initiatedBy,omitempty"`
+	Automated   bool   `json:"automated,omitempty"`
+}
+
+// Elapsed returns how long the operation ran, or has been running as of now
+func (o SyncOperation) Elapsed(now time.Time) time.Duration {
+	if o.StartedAt.IsZero() {
+		return 0
+	}
+	if !o.FinishedAt.IsZero() {
+		return o.FinishedAt.Sub(o.StartedAt)
+	}
+	return now.Sub(o.StartedAt)
+}
+
+// Completed reports whether the operation has reached a final phase
+func (o SyncOperation) Completed() bool {
+	switch o.Phase {
+	case "Succeeded", "Failed", "Error":
+		return true
+	}
+	return false
+}
+
+// SyncResourceResult is the sync result of a single resource within an operation
+type SyncResourceResult struct {
+	Group     string `json:"group"`
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	SyncPhase string `json:"syncPhase"` // PreSync, Sync, PostSync, SyncFail
+	Wave      int64  `json:"wave"`
+	HookType  string `json:"hookType"`  // Empty for regular resources
+	HookPhase string `json:"hookPhase"` // Running, Succeeded, Failed... for hooks
+	Status    string `json:"status"`    // Synced, SyncFailed, Pruned, PruneSkipped
+	Message   string `json:"message"`
+}
+
+// ResourceActionTarget identifies a single resource in an application tree
+// (the target of resource actions, the manifest viewer and resource edits)
+type ResourceActionTarget struct {
+	AppName      string  `json:"appName"`
+	AppNamespace *string `json:"appNamespace,omitempty"`
+	Group        string  `json:"group"`
+	Version      string  `json:"version"`
+	Kind         string  `json:"kind"`
+	Namespace    string  `json:"namespace"`
+	Name         string  `json:"name"`
+}
+
 // ResourceSyncTarget represents a resource to be synced
 type ResourceSyncTarget struct {
 	AppName   string `json:"appName"`
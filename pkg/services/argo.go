@@ -29,8 +29,8 @@ type ArgoApiService interface {
 	// WatchApplicationsWithOptions starts watching with configurable options
 	WatchApplicationsWithOptions(ctx context.Context, server *model.Server, opts *api.WatchOptions) (<-chan ArgoApiEvent, func(), error)
 
-	// SyncApplication syncs a specific application
-	SyncApplication(ctx context.Context, server *model.Server, appName string, prune bool) error
+	// SyncApplication syncs a specific application with the given options (nil for defaults)
+	SyncApplication(ctx context.Context, server *model.Server, appName string, opts *api.SyncOptions) error
 
 	// GetResourceDiffs gets resource diffs for an application
 	GetResourceDiffs(ctx context.Context, server *model.Server, appName string) ([]ResourceDiff, error)
@@ -53,6 +53,13 @@ type ArgoApiService interface {
 	// WatchResourceTree streams resource tree updates for an application
 	WatchResourceTree(ctx context.Context, server *model.Server, appName string, appNamespace string) (<-chan *api.ResourceTree, func(), error)
 
+	// StreamPodLogs streams container logs for a pod managed by an application
+	// Returns a channel for log events and a cleanup function
+	StreamPodLogs(ctx context.Context, server *model.Server, req api.PodLogsRequest) (<-chan PodLogEvent, func(), error)
+
+	// ListPodContainers lists the container names of a pod managed by an application
+	ListPodContainers(ctx context.Context, server *model.Server, appName string, appNamespace *string, namespace, podName string) ([]string, error)
+
 	// Cleanup stops all watchers and cleans up resources
 	Cleanup()
 }
@@ -66,6 +73,14 @@ type ArgoApiEvent struct {
 	Error     error                `json:"error,omitempty"`
 	Status    string               `json:"status,omitempty"`
 	Resources []api.ResourceStatus `json:"resources,omitempty"` // Resource sync statuses for tree view
+	Operation *model.SyncOperation `json:"operation,omitempty"` // Current/last sync operation for the progress view
+}
+
+// PodLogEvent is a single item from a pod logs stream.
+// Err is set on the final event when the stream terminated with an error.
+type PodLogEvent struct {
+	Entry api.PodLogEntry
+	Err   error
 }
 
 // ResourceDiff represents a resource difference
@@ -192,7 +207,7 @@ func (s *ArgoApiServiceImpl) WatchApplicationsWithOptions(ctx context.Context, s
 }
 
 // SyncApplication implements ArgoApiService.SyncApplication
-func (s *ArgoApiServiceImpl) SyncApplication(ctx context.Context, server *model.Server, appName string, prune bool) error {
+func (s *ArgoApiServiceImpl) SyncApplication(ctx context.Context, server *model.Server, appName string, opts *api.SyncOptions) error {
 	if server == nil {
 		return apperrors.ConfigError("SERVER_MISSING",
 			"Server configuration is required").
@@ -212,8 +227,8 @@ func (s *ArgoApiServiceImpl) SyncApplication(ctx context.Context, server *model.
 	ctx, cancel := appcontext.WithSyncTimeout(ctx)
 	defer cancel()
 
-	opts := &api.SyncOptions{
-		Prune: prune,
+	if opts == nil {
+		opts = &api.SyncOptions{}
 	}
 
 	// Use retry mechanism for sync operations
@@ -226,14 +241,14 @@ func (s *ArgoApiServiceImpl) SyncApplication(ctx context.Context, server *model.
 		if argErr, ok := err.(*apperrors.ArgonautError); ok {
 			return argErr.WithContext("operation", "SyncApplication").
 				WithContext("appName", appName).
-				WithContext("prune", prune)
+				WithContext("prune", opts.Prune)
 		}
 
 		return apperrors.Wrap(err, apperrors.ErrorAPI, "SYNC_FAILED",
 			"Failed to sync application").
 			WithContext("server", server.BaseURL).
 			WithContext("appName", appName).
-			WithContext("prune", prune).
+			WithContext("prune", opts.Prune).
 			AsRecoverable().
 			WithUserAction("Check the application status and try syncing again")
 	}
@@ -408,6 +423,7 @@ func (s *ArgoApiServiceImpl) handleWatchEvent(event api.ApplicationWatchEvent, e
 			Type:      "app-updated",
 			App:       &app,
 			Resources: event.Application.Status.Resources,
+			Operation: s.appService.ConvertSyncOperation(event.Application),
 		}
 	}
 }
@@ -527,6 +543,97 @@ func (s *ArgoApiServiceImpl) WatchResourceTree(ctx context.Context, server *mode
 	return out, cleanup, nil
 }
 
+// StreamPodLogs implements ArgoApiService.StreamPodLogs
+func (s *ArgoApiServiceImpl) StreamPodLogs(ctx context.Context, server *model.Server, req api.PodLogsRequest) (<-chan PodLogEvent, func(), error) {
+	if server == nil {
+		return nil, nil, apperrors.ConfigError("SERVER_MISSING",
+			"Server configuration is required").
+			WithUserAction("Please run 'argocd login' to configure the server")
+	}
+	if req.AppName == "" {
+		return nil, nil, apperrors.ValidationError("APP_NAME_MISSING",
+			"Application name is required").
+			WithUserAction("Specify an application name to stream pod logs")
+	}
+	if req.PodName == "" {
+		return nil, nil, apperrors.ValidationError("POD_NAME_MISSING",
+			"Pod name is required").
+			WithUserAction("Select a pod to stream its logs")
+	}
+	if s.appService == nil {
+		s.appService = api.NewApplicationService(server)
+	}
+
+	cblog.With("component", "services").Info("Starting pod logs stream", "app", req.AppName, "pod", req.PodName, "container", req.Container)
+	out := make(chan PodLogEvent, 256)
+	streamCtx, cancel := appcontext.WithCancel(ctx)
+
+	go func() {
+		defer close(out)
+		ch := make(chan api.PodLogEntry, 256)
+		errCh := make(chan error, 1)
+		go func() {
+			defer close(ch)
+			errCh <- s.appService.StreamPodLogs(streamCtx, req, ch)
+		}()
+		for {
+			select {
+			case <-streamCtx.Done():
+				return
+			case entry, ok := <-ch:
+				if !ok {
+					if err := <-errCh; err != nil && streamCtx.Err() == nil {
+						cblog.With("component", "services").Error("StreamPodLogs error", "err", err, "pod", req.PodName)
+						select {
+						case out <- PodLogEvent{Err: err}:
+						case <-streamCtx.Done():
+						}
+					}
+					return
+				}
+				select {
+				case out <- PodLogEvent{Entry: entry}:
+				case <-streamCtx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	cleanup := func() { cancel() }
+	return out, cleanup, nil
+}
+
+// ListPodContainers implements ArgoApiService.ListPodContainers
+func (s *ArgoApiServiceImpl) ListPodContainers(ctx context.Context, server *model.Server, appName string, appNamespace *string, namespace, podName string) ([]string, error) {
+	if server == nil {
+		return nil, apperrors.ConfigError("SERVER_MISSING",
+			"Server configuration is required").
+			WithUserAction("Please run 'argocd login' to configure the server")
+	}
+	if s.appService == nil {
+		s.appService = api.NewApplicationService(server)
+	}
+
+	ctx, cancel := appcontext.WithAPITimeout(ctx)
+	defer cancel()
+
+	containers, err := s.appService.ListPodContainers(ctx, appName, appNamespace, namespace, podName)
+	if err != nil {
+		if argErr, ok := err.(*apperrors.ArgonautError); ok {
+			return nil, argErr.WithContext("operation", "ListPodContainers").
+				WithContext("pod", podName)
+		}
+		return nil, apperrors.Wrap(err, apperrors.ErrorAPI, "LIST_CONTAINERS_FAILED",
+			"Failed to list pod containers").
+			WithContext("appName", appName).
+			WithContext("pod", podName).
+			AsRecoverable().
+			WithUserAction("Check the pod still exists and try again")
+	}
+	return containers, nil
+}
+
 // isAuthError checks if an error indicates authentication issues
 func isAuthError(errMsg string) bool {
 	authIndicators := []string{
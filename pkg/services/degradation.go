@@ -1,6 +1,7 @@
 package services
 
 import (
+	"fmt"
 	"sync"
 	"time"
 
@@ -45,6 +46,7 @@ type GracefulDegradationManager struct {
 	healthCheckTicker *time.Ticker
 	shutdown          chan struct{}
 	callbacks         []DegradationCallback
+	permissions       model.Permissions
 }
 
 // DegradationCallback is called when degradation mode changes
@@ -212,6 +214,47 @@ func (m *GracefulDegradationManager) CanPerformOperation(operation string) (bool
 	}
 }
 
+// operationPermissions maps operations to the app operations ArgoCD RBAC checks for them
+var operationPermissions = map[string]model.AppOperation{
+	"SyncApplication":     model.OpSync,
+	"RollbackApplication": model.OpRollback,
+	"DeleteApplication":   model.OpDelete,
+	"RefreshApplication":  model.OpRefresh,
+	"PatchApplication":    model.OpUpdate,
+	"RunResourceAction":   model.OpResourceAction,
+}
+
+// SetPermissions sets the can-i results used by CanPerformOperationInProject
+func (m *GracefulDegradationManager) SetPermissions(perms model.Permissions) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.permissions = perms
+}
+
+// CanPerformOperationInProject checks if an operation is allowed in the current degradation mode
+// and by the user's RBAC permissions for the project
+func (m *GracefulDegradationManager) CanPerformOperationInProject(operation, project string) (bool, *apperrors.ArgonautError) {
+	if allowed, err := m.CanPerformOperation(operation); !allowed {
+		return false, err
+	}
+
+	op, ok := operationPermissions[operation]
+	if !ok {
+		return true, nil
+	}
+	m.mu.RLock()
+	allowed := m.permissions.OperationAllowed(project, op)
+	m.mu.RUnlock()
+	if !allowed {
+		return false, apperrors.New(apperrors.ErrorPermission, "PERMISSION_DENIED",
+			fmt.Sprintf("Your role does not allow %s in project %s", op, project)).
+			WithContext("operation", operation).
+			WithContext("project", project).
+			WithUserAction("Ask an ArgoCD admin for the permission or use an account that has it")
+	}
+	return true, nil
+}
+
 // startHealthMonitoring starts periodic health checks
 func (m *GracefulDegradationManager) startHealthMonitoring() {
 	m.healthCheckTicker = time.NewTicker(30 * time.Second)
@@ -0,0 +1,28 @@
+package services
+
+import (
+	"testing"
+
+	apperrors "github.com/darksworm/argonaut/pkg/errors"
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+func TestCanPerformOperationInProject(t *testing.T) {
+	mgr := NewGracefulDegradationManager()
+
+	if allowed, _ := mgr.CanPerformOperationInProject("SyncApplication", "team-a"); !allowed {
+		t.Fatal("Expected operations to be allowed without can-i results")
+	}
+
+	mgr.SetPermissions(model.Permissions{"team-a": {"sync": false}})
+	allowed, err := mgr.CanPerformOperationInProject("RollbackApplication", "team-a")
+	if allowed || err == nil || err.Category != apperrors.ErrorPermission {
+		t.Fatalf("Expected rollback in team-a to be denied, got %v (%v)", allowed, err)
+	}
+	if allowed, _ := mgr.CanPerformOperationInProject("SyncApplication", "team-b"); !allowed {
+		t.Error("Expected sync in team-b to be allowed")
+	}
+	if allowed, _ := mgr.CanPerformOperationInProject("ListApplications", "team-a"); !allowed {
+		t.Error("Expected operations without a permission to be allowed")
+	}
+}
@@ -19,6 +19,7 @@ type EnhancedArgoApiService struct {
 	mu              sync.RWMutex
 	recoveryManager *StreamRecoveryManager
 	degradationMgr  *GracefulDegradationManager
+	appProjects     map[string]string // App name → project, for permission checks
 }
 
 // NewEnhancedArgoApiService creates a new enhanced ArgoApiService implementation
@@ -39,8 +40,16 @@ func NewEnhancedArgoApiService(server *model.Server) *EnhancedArgoApiService {
 	return impl
 }
 
+// SetPermissions makes operations check the user's can-i results for the project of each app
+func (s *EnhancedArgoApiService) SetPermissions(perms model.Permissions, appProjects map[string]string) {
+	s.degradationMgr.SetPermissions(perms)
+	s.mu.Lock()
+	s.appProjects = appProjects
+	s.mu.Unlock()
+}
+
 // SyncApplication implements ArgoApiService.SyncApplication with degradation check
-func (s *EnhancedArgoApiService) SyncApplication(ctx context.Context, server *model.Server, appName string, prune bool) error {
+func (s *EnhancedArgoApiService) SyncApplication(ctx context.Context, server *model.Server, appName string, opts *api.SyncOptions) error {
 	if server == nil {
 		return apperrors.ConfigError("SERVER_MISSING",
 			"Server configuration is required").
@@ -52,8 +61,11 @@ func (s *EnhancedArgoApiService) SyncApplication(ctx context.Context, server *mo
 			WithUserAction("Specify an application name for the sync operation")
 	}
 
-	// Check if operation is allowed in current degradation mode
-	if allowed, err := s.degradationMgr.CanPerformOperation("SyncApplication"); !allowed {
+	// Check if operation is allowed in current degradation mode and by the user's role
+	s.mu.RLock()
+	project := s.appProjects[appName]
+	s.mu.RUnlock()
+	if allowed, err := s.degradationMgr.CanPerformOperationInProject("SyncApplication", project); !allowed {
 		return err
 	}
 
@@ -65,8 +77,8 @@ func (s *EnhancedArgoApiService) SyncApplication(ctx context.Context, server *mo
 	ctx, cancel := appcontext.WithSyncTimeout(ctx)
 	defer cancel()
 
-	opts := &api.SyncOptions{
-		Prune: prune,
+	if opts == nil {
+		opts = &api.SyncOptions{}
 	}
 
 	// Use retry mechanism for sync operations
@@ -82,14 +94,14 @@ func (s *EnhancedArgoApiService) SyncApplication(ctx context.Context, server *mo
 		if argErr, ok := err.(*apperrors.ArgonautError); ok {
 			return argErr.WithContext("operation", "SyncApplication").
 				WithContext("appName", appName).
-				WithContext("prune", prune)
+				WithContext("prune", opts.Prune)
 		}
 
 		return apperrors.Wrap(err, apperrors.ErrorAPI, "SYNC_FAILED",
 			"Failed to sync application").
 			WithContext("server", server.BaseURL).
 			WithContext("appName", appName).
-			WithContext("prune", prune).
+			WithContext("prune", opts.Prune).
 			AsRecoverable().
 			WithUserAction("Check the application status and try syncing again")
 	}
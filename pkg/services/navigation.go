@@ -45,6 +45,8 @@ type NavigationUpdate struct {
 	ScopeNamespaces                 map[string]bool `json:"scopeNamespaces,omitempty"`
 	ScopeProjects                   map[string]bool `json:"scopeProjects,omitempty"`
 	ScopeApplicationSets            map[string]bool `json:"scopeApplicationSets,omitempty"`
+	ScopeRepos                      map[string]bool `json:"scopeRepos,omitempty"`
+	ScopeLabels                     map[string]bool `json:"scopeLabels,omitempty"`
 	SelectedApps                    map[string]bool `json:"selectedApps,omitempty"`
 	ShouldResetNavigation           bool            `json:"shouldResetNavigation"`
 	ShouldClearLowerLevelSelections bool            `json:"shouldClearLowerLevelSelections"`
@@ -99,6 +101,15 @@ func (s *NavigationServiceImpl) DrillDown(currentView model.View, selectedItem i
 		newView := model.ViewApps
 		result.NewView = &newView
 		result.ScopeApplicationSets = next
+	case model.ViewRepositories:
+		newView := model.ViewApps
+		result.NewView = &newView
+		result.ScopeRepos = next
+	case model.ViewLabels:
+		// Items are key=value selectors
+		newView := model.ViewApps
+		result.NewView = &newView
+		result.ScopeLabels = next
 	default:
 		return nil // Can't drill down from apps view
 	}
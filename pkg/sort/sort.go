@@ -23,6 +23,16 @@ var healthStatusOrder = map[string]int{
 	"Healthy":     5,
 }
 
+// Semantic ordering for operation phases (problems first when ascending, apps without an
+// operation last)
+var phaseOrder = map[string]int{
+	"Failed":      0,
+	"Error":       1,
+	"Running":     2,
+	"Terminating": 3,
+	"Succeeded":   4,
+}
+
 // SortApps sorts apps according to the provided configuration using insertion sort.
 // Uses semantic ordering for sync/health statuses and falls back to name for stability.
 func SortApps(apps []model.App, config model.SortConfig) {
@@ -42,21 +52,26 @@ func SortApps(apps []model.App, config model.SortConfig) {
 	}
 }
 
-// comparator returns a less function based on sort config
+// comparator returns a less function based on sort config, comparing key by key
 func comparator(config model.SortConfig) func(a, b model.App) bool {
+	keys := config.Keys()
 	return func(a, b model.App) bool {
-		cmp := compareByField(a, b, config.Field)
-
-		// If primary field is equal, fall back to name for stability
-		if cmp == 0 && config.Field != model.SortFieldName {
-			cmp = strings.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name))
+		for _, key := range keys {
+			cmp := compareByField(a, b, key.Field)
+			if cmp == 0 {
+				continue
+			}
+			// Apply direction
+			if key.Direction == model.SortDesc {
+				return cmp > 0
+			}
+			return cmp < 0
 		}
-
-		// Apply direction
-		if config.Direction == model.SortDesc {
-			return cmp > 0
+		// If every key is equal, fall back to name (in the last key's direction) for stability
+		if keys[len(keys)-1].Direction == model.SortDesc {
+			return compareStrings(a.Name, b.Name) > 0
 		}
-		return cmp < 0
+		return compareStrings(a.Name, b.Name) < 0
 	}
 }
 
@@ -68,9 +83,56 @@ func compareByField(a, b model.App, field model.SortField) int {
 		return compareSyncStatus(a.Sync, b.Sync)
 	case model.SortFieldHealth:
 		return compareHealthStatus(a.Health, b.Health)
+	case model.SortFieldLastSync:
+		return compareLastSync(a, b)
+	case model.SortFieldProject:
+		return compareStrings(deref(a.Project), deref(b.Project))
+	case model.SortFieldCluster:
+		return compareStrings(deref(a.ClusterLabel), deref(b.ClusterLabel))
+	case model.SortFieldNamespace:
+		return compareStrings(deref(a.Namespace), deref(b.Namespace))
+	case model.SortFieldAppSet:
+		return compareStrings(deref(a.ApplicationSet), deref(b.ApplicationSet))
+	case model.SortFieldRepo:
+		return compareStrings(firstRepo(a), firstRepo(b))
+	case model.SortFieldPhase:
+		return getStatusOrder(phaseOrder, a.OperationPhase, len(phaseOrder)) - getStatusOrder(phaseOrder, b.OperationPhase, len(phaseOrder))
 	default: // name
-		return strings.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name))
+		return compareStrings(a.Name, b.Name)
+	}
+}
+
+// compareLastSync orders by last sync time, oldest first; apps that never synced come first
+func compareLastSync(a, b model.App) int {
+	switch {
+	case a.LastSyncAt == nil && b.LastSyncAt == nil:
+		return 0
+	case a.LastSyncAt == nil:
+		return -1
+	case b.LastSyncAt == nil:
+		return 1
+	}
+	return a.LastSyncAt.Compare(*b.LastSyncAt)
+}
+
+// compareStrings compares case-insensitively
+func compareStrings(a, b string) int {
+	return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// firstRepo returns the repository of an app's first source, normalized for comparison
+func firstRepo(app model.App) string {
+	if len(app.RepoURLs) == 0 {
+		return ""
 	}
+	return model.NormalizeRepoURL(app.RepoURLs[0])
 }
 
 // compareSyncStatus compares sync statuses using semantic ordering
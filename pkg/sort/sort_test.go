@@ -0,0 +1,59 @@
+package sort
+
+import (
+	"testing"
+	"time"
+
+	"github.com/darksworm/argonaut/pkg/model"
+)
+
+func names(apps []model.App) []string {
+	out := make([]string, len(apps))
+	for i, a := range apps {
+		out[i] = a.Name
+	}
+	return out
+}
+
+func TestSortApps_MultiKey(t *testing.T) {
+	now := time.Now()
+	ago := func(d time.Duration) *time.Time { t := now.Add(-d); return &t }
+	apps := []model.App{
+		{Name: "a", Health: "Healthy", LastSyncAt: ago(time.Hour)},
+		{Name: "b", Health: "Degraded", LastSyncAt: ago(2 * time.Hour)},
+		{Name: "c", Health: "Degraded", LastSyncAt: ago(time.Minute)},
+		{Name: "d", Health: "Healthy"}, // never synced
+	}
+
+	SortApps(apps, model.SortConfig{Field: model.SortFieldHealth, Direction: model.SortAsc,
+		Then: []model.SortKey{{Field: model.SortFieldLastSync, Direction: model.SortDesc}}})
+	if got := names(apps); got[0] != "c" || got[1] != "b" || got[2] != "a" || got[3] != "d" {
+		t.Errorf("health asc, lastSync desc = %v, want [c b a d]", got)
+	}
+
+	SortApps(apps, model.SortConfig{Field: model.SortFieldLastSync, Direction: model.SortDesc})
+	if got := names(apps); got[0] != "c" || got[3] != "d" {
+		t.Errorf("lastSync desc = %v, want most recent first and never synced last", got)
+	}
+}
+
+func TestSortApps_PhaseAndStrings(t *testing.T) {
+	proj := func(s string) *string { return &s }
+	apps := []model.App{
+		{Name: "a", OperationPhase: "Succeeded", Project: proj("web")},
+		{Name: "b", Project: proj("Core")},
+		{Name: "c", OperationPhase: "Failed", Project: proj("api")},
+		{Name: "d", OperationPhase: "Running", Project: proj("core")},
+	}
+
+	SortApps(apps, model.SortConfig{Field: model.SortFieldPhase, Direction: model.SortAsc})
+	if got := names(apps); got[0] != "c" || got[1] != "d" || got[2] != "a" || got[3] != "b" {
+		t.Errorf("phase asc = %v, want [c d a b]", got)
+	}
+
+	// Case-insensitive with the name as final tie-breaker
+	SortApps(apps, model.SortConfig{Field: model.SortFieldProject, Direction: model.SortAsc})
+	if got := names(apps); got[0] != "c" || got[1] != "b" || got[2] != "d" || got[3] != "a" {
+		t.Errorf("project asc = %v, want [c b d a]", got)
+	}
+}
@@ -59,6 +59,7 @@ type TreeView struct {
 // ResourceSelection represents a selected resource for deletion
 type ResourceSelection struct {
 	AppName   string
+	UID       string // Kubernetes UID of the resource (without the app prefix)
 	Group     string
 	Version   string
 	Kind      string
@@ -945,6 +946,68 @@ func (v *TreeView) GetSelectedResources() []ResourceSelection {
 	return result
 }
 
+// CurrentResource returns the resource under the cursor, ignoring explicit selections.
+// Returns ok=false for Application root nodes or when nothing is under the cursor.
+func (v *TreeView) CurrentResource() (ResourceSelection, bool) {
+	if v.selIdx < 0 || v.selIdx >= len(v.order) {
+		return ResourceSelection{}, false
+	}
+	node := v.order[v.selIdx]
+	if node == nil || node.kind == "Application" {
+		return ResourceSelection{}, false
+	}
+	appName, uid := v.appName, node.uid
+	if idx := strings.Index(node.uid, "::"); idx > 0 {
+		appName, uid = node.uid[:idx], node.uid[idx+2:]
+	}
+	return ResourceSelection{
+		AppName:   appName,
+		UID:       uid,
+		Group:     node.group,
+		Version:   node.version,
+		Kind:      node.kind,
+		Namespace: node.namespace,
+		Name:      node.name,
+		Status:    node.status,
+		Health:    node.health,
+	}, true
+}
+
+// SelectedPods returns the Pod under the cursor, or every Pod below it when the
+// cursor is on a workload such as a Deployment or Job. Pods are sorted by name.
+func (v *TreeView) SelectedPods() []ResourceSelection {
+	if v.selIdx < 0 || v.selIdx >= len(v.order) {
+		return nil
+	}
+	var pods []ResourceSelection
+	var walk func(n *treeNode)
+	walk = func(n *treeNode) {
+		if n.kind == "Pod" {
+			appName := v.appName
+			if idx := strings.Index(n.uid, "::"); idx > 0 {
+				appName = n.uid[:idx]
+			}
+			pods = append(pods, ResourceSelection{
+				AppName:   appName,
+				Group:     n.group,
+				Version:   n.version,
+				Kind:      n.kind,
+				Namespace: n.namespace,
+				Name:      n.name,
+				Status:    n.status,
+				Health:    n.health,
+			})
+			return
+		}
+		for _, c := range n.children {
+			walk(c)
+		}
+	}
+	walk(v.order[v.selIdx])
+	sort.Slice(pods, func(i, j int) bool { return pods[i].Name < pods[j].Name })
+	return pods
+}
+
 // ClearSelection clears all resource selections.
 func (v *TreeView) ClearSelection() {
 	v.selectedUIDs = make(map[string]bool)
@@ -450,6 +450,97 @@ func TestUpsertAppTree_DifferentApplicationNodePreserved(t *testing.T) {
 	}
 }
 
+// TestSelectedPods verifies that pods are collected from the cursor node and its descendants.
+func TestSelectedPods(t *testing.T) {
+	v := NewTreeView(100, 20)
+	v.ApplyTheme(theme.Default())
+	v.SetAppMeta("my-app", "Healthy", "Synced")
+
+	ns := "default"
+	tree := &api.ResourceTree{
+		Nodes: []api.ResourceNode{
+			{UID: "deploy-uid", Group: "apps", Version: "v1", Kind: "Deployment", Name: "web", Namespace: &ns},
+			{UID: "rs-uid", Group: "apps", Version: "v1", Kind: "ReplicaSet", Name: "web-abc", Namespace: &ns, ParentRefs: []api.ResourceRef{{UID: "deploy-uid"}}},
+			{UID: "pod-b", Version: "v1", Kind: "Pod", Name: "web-abc-2", Namespace: &ns, ParentRefs: []api.ResourceRef{{UID: "rs-uid"}}},
+			{UID: "pod-a", Version: "v1", Kind: "Pod", Name: "web-abc-1", Namespace: &ns, ParentRefs: []api.ResourceRef{{UID: "rs-uid"}}},
+			{UID: "svc-uid", Version: "v1", Kind: "Service", Name: "web", Namespace: &ns},
+		},
+	}
+	v.UpsertAppTree("my-app", tree)
+
+	find := func(kind, name string) int {
+		for i, n := range v.order {
+			if n.kind == kind && n.name == name {
+				return i
+			}
+		}
+		t.Fatalf("%s/%s not visible", kind, name)
+		return -1
+	}
+
+	v.SetSelectedIndex(find("Deployment", "web"))
+	pods := v.SelectedPods()
+	if len(pods) != 2 {
+		t.Fatalf("expected 2 pods under deployment, got %d", len(pods))
+	}
+	if pods[0].Name != "web-abc-1" || pods[1].Name != "web-abc-2" {
+		t.Errorf("expected pods sorted by name, got %s, %s", pods[0].Name, pods[1].Name)
+	}
+	if pods[0].AppName != "my-app" || pods[0].Namespace != "default" {
+		t.Errorf("unexpected pod metadata: %+v", pods[0])
+	}
+
+	v.SetSelectedIndex(find("Pod", "web-abc-2"))
+	pods = v.SelectedPods()
+	if len(pods) != 1 || pods[0].Name != "web-abc-2" {
+		t.Errorf("expected only the selected pod, got %+v", pods)
+	}
+
+	v.SetSelectedIndex(find("Service", "web"))
+	if pods := v.SelectedPods(); len(pods) != 0 {
+		t.Errorf("expected no pods under service, got %d", len(pods))
+	}
+}
+
+func TestCurrentResource(t *testing.T) {
+	v := NewTreeView(100, 20)
+	v.ApplyTheme(theme.Default())
+	v.SetAppMeta("my-app", "Healthy", "Synced")
+
+	ns := "default"
+	tree := &api.ResourceTree{
+		Nodes: []api.ResourceNode{
+			{UID: "deploy-uid", Group: "apps", Version: "v1", Kind: "Deployment", Name: "web", Namespace: &ns},
+			{UID: "svc-uid", Version: "v1", Kind: "Service", Name: "web", Namespace: &ns},
+		},
+	}
+	v.UpsertAppTree("my-app", tree)
+
+	// Cursor starts on the synthetic Application root
+	v.SetSelectedIndex(0)
+	if _, ok := v.CurrentResource(); ok {
+		t.Error("expected no current resource on the Application root")
+	}
+
+	for i, n := range v.order {
+		if n.kind == "Deployment" {
+			v.SetSelectedIndex(i)
+		}
+	}
+	// Explicit selections must not change what is under the cursor
+	v.selectedUIDs["my-app::svc-uid"] = true
+	res, ok := v.CurrentResource()
+	if !ok {
+		t.Fatal("expected a current resource")
+	}
+	if res.UID != "deploy-uid" {
+		t.Errorf("expected UID without app prefix, got %q", res.UID)
+	}
+	if res.AppName != "my-app" || res.Kind != "Deployment" || res.Group != "apps" || res.Version != "v1" || res.Name != "web" {
+		t.Errorf("unexpected current resource: %+v", res)
+	}
+}
+
 // stripANSI removes ANSI escape codes from a string for easier testing
 func stripANSI(s string) string {
 	var result strings.Builder